@@ -23,6 +23,7 @@ func (r *Routes) RegisterRoutes(router *gin.RouterGroup, resolver serverDomain.M
 		resolver.Get("auth"),
 		resolver.Get("org_context"),
 		resolver.Get("subscription"),
+		resolver.Get("rate_limit"),
 	)
 	{
 		// Chat endpoint
@@ -1,34 +1,43 @@
 package documents
 
 import (
+	stderrors "errors"
 	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/moasq/backend/app/example_documents/app/services"
+	"github.com/moasq/backend/app/example_documents/domain"
 	"github.com/moasq/backend/pkg/auth"
 	"github.com/moasq/backend/pkg/common/errors"
 )
 
 type Handler struct {
-	service services.DocumentService
+	service      services.DocumentService
+	fieldService services.DocumentFieldService
 }
 
-func NewHandler(service services.DocumentService) *Handler {
-	return &Handler{service: service}
+func NewHandler(service services.DocumentService, fieldService services.DocumentFieldService) *Handler {
+	return &Handler{service: service, fieldService: fieldService}
 }
 
-// UploadDocument uploads a new PDF document
-// @Summary Upload PDF document
-// @Description Uploads a PDF document, extracts text, and creates embeddings
+// UploadDocument uploads a new document
+// @Summary Upload document
+// @Description Uploads a document, extracts text, and creates embeddings. Accepts PDF, PNG, JPEG, WebP and TIFF, which are read with OCR, and DOCX, XLSX, CSV and TXT, which are read directly with tables kept as markdown. The type follows the file extension and must match the file content
 // @Tags Documents
 // @Accept multipart/form-data
 // @Produce json
-// @Param file formData file true "PDF file to upload"
+// @Param file formData file true "File to upload (.pdf, .png, .jpg, .jpeg, .webp, .tif, .tiff, .docx, .xlsx, .csv, .txt)"
 // @Param title formData string true "Document title"
+// @Param team_id formData int false "Team that owns the document; omit to share it with the whole organization"
+// @Param Idempotency-Key header string false "Unique key that makes retries return the original response instead of uploading again"
 // @Success 201 {object} github_com_moasq_backend_app_example_documents_domain.Document
 // @Failure 400 {object} errors.HTTPError
+// @Failure 403 {object} errors.HTTPError
+// @Failure 404 {object} errors.HTTPError
+// @Failure 409 {object} map[string]any "A request with this Idempotency-Key is still in progress"
+// @Failure 422 {object} map[string]any "Idempotency-Key was already used for a different request"
 // @Failure 500 {object} errors.HTTPError
 // @Router /example_documents/upload [post]
 func (h *Handler) UploadDocument(c *gin.Context) {
@@ -68,9 +77,47 @@ func (h *Handler) UploadDocument(c *gin.Context) {
 		FileSize:    header.Size,
 	}
 
+	// Optional owning team
+	if teamParam := c.PostForm("team_id"); teamParam != "" {
+		var teamID int32
+		if _, err := fmt.Sscanf(teamParam, "%d", &teamID); err != nil {
+			c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+				http.StatusBadRequest,
+				"invalid_team_id",
+				"Team ID must be a valid number",
+			))
+			return
+		}
+		req.TeamID = &teamID
+	}
+
 	// Upload document
 	document, err := h.service.UploadDocument(c.Request.Context(), reqCtx.OrganizationID, req, file)
 	if err != nil {
+		if stderrors.Is(err, domain.ErrInvalidFileType) {
+			c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+				http.StatusBadRequest,
+				"invalid_file_type",
+				err.Error(),
+			))
+			return
+		}
+		if stderrors.Is(err, auth.ErrTeamNotFound) {
+			c.JSON(http.StatusNotFound, errors.NewHTTPError(
+				http.StatusNotFound,
+				"team_not_found",
+				"Team not found",
+			))
+			return
+		}
+		if stderrors.Is(err, auth.ErrForbidden) {
+			c.JSON(http.StatusForbidden, errors.NewHTTPError(
+				http.StatusForbidden,
+				"forbidden",
+				"Documents can only be assigned to your own teams",
+			))
+			return
+		}
 		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
 			http.StatusInternalServerError,
 			"upload_failed",
@@ -130,11 +177,13 @@ func (h *Handler) ListDocuments(c *gin.Context) {
 }
 
 // @Summary Delete document
-// @Description Deletes a document and its associated file
+// @Description Deletes a document and its associated file. When the organization enables edit_own_resources_only, only the uploader or an org manager may delete it
 // @Tags Documents
 // @Param id path int true "Document ID"
 // @Success 204
 // @Failure 400 {object} errors.HTTPError
+// @Failure 403 {object} map[string]any "Denied by the resource policy, with the reason"
+// @Failure 404 {object} errors.HTTPError
 // @Failure 500 {object} errors.HTTPError
 // @Router /example_documents/{id} [delete]
 func (h *Handler) DeleteDocument(c *gin.Context) {
@@ -160,6 +209,14 @@ func (h *Handler) DeleteDocument(c *gin.Context) {
 	}
 
 	if err := h.service.DeleteDocument(c.Request.Context(), reqCtx.OrganizationID, docID); err != nil {
+		if stderrors.Is(err, domain.ErrDocumentNotFound) {
+			c.JSON(http.StatusNotFound, errors.NewHTTPError(
+				http.StatusNotFound,
+				"not_found",
+				"Document not found",
+			))
+			return
+		}
 		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
 			http.StatusInternalServerError,
 			"delete_failed",
@@ -170,3 +227,289 @@ func (h *Handler) DeleteDocument(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// ReprocessDocument queues a document for text extraction again
+// @Summary Reprocess document
+// @Description Queues a document for text extraction again, e.g. after a failure. Processing runs in the background; poll the returned job for progress. When the organization enables edit_own_resources_only, only the uploader or an org manager may reprocess it
+// @Tags Documents
+// @Produce json
+// @Param id path int true "Document ID"
+// @Success 202 {object} github_com_moasq_backend_app_example_documents_domain.DocumentJob
+// @Failure 400 {object} errors.HTTPError
+// @Failure 403 {object} map[string]any "Denied by the resource policy, with the reason"
+// @Failure 404 {object} errors.HTTPError
+// @Failure 409 {object} errors.HTTPError "The document is already queued or processing"
+// @Failure 500 {object} errors.HTTPError
+// @Router /example_documents/{id}/reprocess [post]
+func (h *Handler) ReprocessDocument(c *gin.Context) {
+	docID, ok := parseID(c, "id", "Document ID must be a valid number")
+	if !ok {
+		return
+	}
+
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"missing_context",
+			"Organization context is required",
+		))
+		return
+	}
+
+	job, err := h.service.ReprocessDocument(c.Request.Context(), reqCtx.OrganizationID, docID)
+	if err != nil {
+		if stderrors.Is(err, domain.ErrDocumentNotFound) {
+			c.JSON(http.StatusNotFound, errors.NewHTTPError(
+				http.StatusNotFound,
+				"not_found",
+				"Document not found",
+			))
+			return
+		}
+		if stderrors.Is(err, domain.ErrDocumentJobActive) {
+			c.JSON(http.StatusConflict, errors.NewHTTPError(
+				http.StatusConflict,
+				"already_queued",
+				"Document is already queued or processing",
+			))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
+			http.StatusInternalServerError,
+			"reprocess_failed",
+			"Failed to queue document: "+err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, job)
+}
+
+// ListDocumentJobs lists the processing jobs of a document
+// @Summary List document jobs
+// @Description Lists the most recent processing jobs of a document, newest first
+// @Tags Documents
+// @Produce json
+// @Param id path int true "Document ID"
+// @Success 200 {object} github_com_moasq_backend_app_example_documents_app_services.DocumentJobsResponse
+// @Failure 400 {object} errors.HTTPError
+// @Failure 404 {object} errors.HTTPError
+// @Failure 500 {object} errors.HTTPError
+// @Router /example_documents/{id}/jobs [get]
+func (h *Handler) ListDocumentJobs(c *gin.Context) {
+	docID, ok := parseID(c, "id", "Document ID must be a valid number")
+	if !ok {
+		return
+	}
+
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"missing_context",
+			"Organization context is required",
+		))
+		return
+	}
+
+	jobs, err := h.service.ListDocumentJobs(c.Request.Context(), reqCtx.OrganizationID, docID)
+	if err != nil {
+		if stderrors.Is(err, domain.ErrDocumentNotFound) {
+			c.JSON(http.StatusNotFound, errors.NewHTTPError(
+				http.StatusNotFound,
+				"not_found",
+				"Document not found",
+			))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
+			http.StatusInternalServerError,
+			"list_failed",
+			"Failed to list document jobs: "+err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, services.DocumentJobsResponse{Jobs: jobs})
+}
+
+// ListDocumentFields lists the fields extracted from a document
+// @Summary List document fields
+// @Description Lists the structured fields extracted from a document, such as the vendor, dates, totals and line items of an invoice. Fields follow the extraction schema of the document's type; each has the extractor's confidence and the page it was read from. The outcome of the extraction is in the document's metadata.field_extraction
+// @Tags Documents
+// @Produce json
+// @Param id path int true "Document ID"
+// @Success 200 {object} github_com_moasq_backend_app_example_documents_app_services.DocumentFieldsResponse
+// @Failure 400 {object} errors.HTTPError
+// @Failure 404 {object} errors.HTTPError
+// @Failure 500 {object} errors.HTTPError
+// @Router /example_documents/{id}/fields [get]
+func (h *Handler) ListDocumentFields(c *gin.Context) {
+	docID, ok := parseID(c, "id", "Document ID must be a valid number")
+	if !ok {
+		return
+	}
+
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"missing_context",
+			"Organization context is required",
+		))
+		return
+	}
+
+	fields, err := h.fieldService.ListDocumentFields(c.Request.Context(), reqCtx.OrganizationID, docID)
+	if err != nil {
+		if stderrors.Is(err, domain.ErrDocumentNotFound) {
+			c.JSON(http.StatusNotFound, errors.NewHTTPError(
+				http.StatusNotFound,
+				"not_found",
+				"Document not found",
+			))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
+			http.StatusInternalServerError,
+			"list_failed",
+			"Failed to list document fields: "+err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, services.DocumentFieldsResponse{Fields: fields})
+}
+
+// GetDocumentPage retrieves one page of a document
+// @Summary Get document page
+// @Description Retrieves the extracted text, layout blocks and tables of one page of a processed document. Bounding boxes are fractions (0-1) of the page size with the origin at the top-left corner.
+// @Tags Documents
+// @Produce json
+// @Param id path int true "Document ID"
+// @Param n path int true "Page number (1-based)"
+// @Success 200 {object} github_com_moasq_backend_app_example_documents_app_services.DocumentPageResponse
+// @Failure 400 {object} errors.HTTPError
+// @Failure 404 {object} errors.HTTPError
+// @Failure 500 {object} errors.HTTPError
+// @Router /example_documents/{id}/pages/{n} [get]
+func (h *Handler) GetDocumentPage(c *gin.Context) {
+	docID, ok := parseID(c, "id", "Document ID must be a valid number")
+	if !ok {
+		return
+	}
+
+	pageNumber, ok := parseID(c, "n", "Page number must be a valid number")
+	if !ok {
+		return
+	}
+	if pageNumber < 1 {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"invalid_id",
+			"Page number must be 1 or greater",
+		))
+		return
+	}
+
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"missing_context",
+			"Organization context is required",
+		))
+		return
+	}
+
+	page, err := h.service.GetDocumentPage(c.Request.Context(), reqCtx.OrganizationID, docID, pageNumber)
+	if err != nil {
+		if stderrors.Is(err, domain.ErrDocumentNotFound) {
+			c.JSON(http.StatusNotFound, errors.NewHTTPError(
+				http.StatusNotFound,
+				"not_found",
+				"Document not found",
+			))
+			return
+		}
+		if stderrors.Is(err, domain.ErrDocumentPageNotFound) {
+			c.JSON(http.StatusNotFound, errors.NewHTTPError(
+				http.StatusNotFound,
+				"not_found",
+				"Document page not found",
+			))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
+			http.StatusInternalServerError,
+			"get_failed",
+			"Failed to get document page: "+err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// GetDocumentJob returns the status of a processing job
+// @Summary Get document job
+// @Description Returns the status, attempt count, and last error of a document processing job
+// @Tags Documents
+// @Produce json
+// @Param job_id path int true "Job ID"
+// @Success 200 {object} github_com_moasq_backend_app_example_documents_domain.DocumentJob
+// @Failure 400 {object} errors.HTTPError
+// @Failure 404 {object} errors.HTTPError
+// @Failure 500 {object} errors.HTTPError
+// @Router /example_documents/jobs/{job_id} [get]
+func (h *Handler) GetDocumentJob(c *gin.Context) {
+	jobID, ok := parseID(c, "job_id", "Job ID must be a valid number")
+	if !ok {
+		return
+	}
+
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"missing_context",
+			"Organization context is required",
+		))
+		return
+	}
+
+	job, err := h.service.GetDocumentJob(c.Request.Context(), reqCtx.OrganizationID, jobID)
+	if err != nil {
+		if stderrors.Is(err, domain.ErrDocumentJobNotFound) {
+			c.JSON(http.StatusNotFound, errors.NewHTTPError(
+				http.StatusNotFound,
+				"not_found",
+				"Job not found",
+			))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
+			http.StatusInternalServerError,
+			"get_failed",
+			"Failed to get document job: "+err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
+
+// parseID reads a numeric path parameter, writing a 400 response when it is invalid
+func parseID(c *gin.Context, param, message string) (int32, bool) {
+	var id int32
+	if _, err := fmt.Sscanf(c.Param(param), "%d", &id); err != nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"invalid_id",
+			message,
+		))
+		return 0, false
+	}
+	return id, true
+}
@@ -18,6 +18,11 @@ func (p *Provider) RegisterDependencies() error {
 		return err
 	}
 
+	// Register document type handler
+	if err := p.container.Provide(NewTypeHandler); err != nil {
+		return err
+	}
+
 	// Register routes
 	if err := p.container.Provide(NewRoutes); err != nil {
 		return err
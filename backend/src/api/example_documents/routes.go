@@ -3,17 +3,22 @@ package documents
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/moasq/backend/app/example_documents/app/services"
 	"github.com/moasq/backend/pkg/auth"
 	serverDomain "github.com/moasq/backend/server/domain"
 )
 
 type Routes struct {
-	handler *Handler
+	handler     *Handler
+	typeHandler *TypeHandler
+	policies    *auth.PolicyEngine
 }
 
-func NewRoutes(handler *Handler) *Routes {
+func NewRoutes(handler *Handler, typeHandler *TypeHandler, policies *auth.PolicyEngine) *Routes {
 	return &Routes{
-		handler: handler,
+		handler:     handler,
+		typeHandler: typeHandler,
+		policies:    policies,
 	}
 }
 
@@ -23,11 +28,13 @@ func (r *Routes) RegisterRoutes(router *gin.RouterGroup, resolver serverDomain.M
 		resolver.Get("auth"),
 		resolver.Get("org_context"),
 		resolver.Get("subscription"),
+		resolver.Get("rate_limit"),
 	)
 	{
 		// Upload document
 		docsGroup.POST("/upload",
 			auth.RequirePermissionFunc("resource", "create"),
+			resolver.Get("idempotency"),
 			r.handler.UploadDocument)
 
 		// List documents
@@ -35,10 +42,49 @@ func (r *Routes) RegisterRoutes(router *gin.RouterGroup, resolver serverDomain.M
 			auth.RequirePermissionFunc("resource", "view"),
 			r.handler.ListDocuments)
 
-		// Delete document
+		// Delete document (owner only when the org sets edit_own_resources_only)
 		docsGroup.DELETE("/:id",
 			auth.RequirePermissionFunc("resource", "delete"),
+			r.policies.RequireResourcePolicy(services.AuthDocumentType, "delete", "id"),
 			r.handler.DeleteDocument)
+
+		// Queue a document for processing again (an edit, under the same policy)
+		docsGroup.POST("/:id/reprocess",
+			auth.RequirePermissionFunc("resource", "edit"),
+			r.policies.RequireResourcePolicy(services.AuthDocumentType, "edit", "id"),
+			r.handler.ReprocessDocument)
+
+		// Extracted pages
+		docsGroup.GET("/:id/pages/:n",
+			auth.RequirePermissionFunc("resource", "view"),
+			r.handler.GetDocumentPage)
+
+		// Extracted fields
+		docsGroup.GET("/:id/fields",
+			auth.RequirePermissionFunc("resource", "view"),
+			r.handler.ListDocumentFields)
+
+		// Processing jobs
+		docsGroup.GET("/:id/jobs",
+			auth.RequirePermissionFunc("resource", "view"),
+			r.handler.ListDocumentJobs)
+		docsGroup.GET("/jobs/:job_id",
+			auth.RequirePermissionFunc("resource", "view"),
+			r.handler.GetDocumentJob)
+
+		// Document types
+		docsGroup.GET("/types",
+			auth.RequirePermissionFunc("resource", "view"),
+			r.typeHandler.ListDocumentTypes)
+		docsGroup.POST("/types",
+			auth.RequirePermissionFunc("org", "manage"),
+			r.typeHandler.CreateDocumentType)
+		docsGroup.PUT("/types/:type_id",
+			auth.RequirePermissionFunc("org", "manage"),
+			r.typeHandler.UpdateDocumentType)
+		docsGroup.DELETE("/types/:type_id",
+			auth.RequirePermissionFunc("org", "manage"),
+			r.typeHandler.DeleteDocumentType)
 	}
 }
 
@@ -0,0 +1,212 @@
+package documents
+
+import (
+	stderrors "errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moasq/backend/app/example_documents/app/services"
+	"github.com/moasq/backend/app/example_documents/domain"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/common/errors"
+)
+
+type TypeHandler struct {
+	service services.DocumentTypeService
+}
+
+func NewTypeHandler(service services.DocumentTypeService) *TypeHandler {
+	return &TypeHandler{service: service}
+}
+
+// ListDocumentTypes lists the document types of the organization
+// @Summary List document types
+// @Description Lists the types documents are classified into: the built-in types (invoice, receipt, contract, report, other) followed by the organization's custom types
+// @Tags Documents
+// @Produce json
+// @Success 200 {object} github_com_moasq_backend_app_example_documents_app_services.DocumentTypesResponse
+// @Failure 400 {object} errors.HTTPError
+// @Failure 500 {object} errors.HTTPError
+// @Router /example_documents/types [get]
+func (h *TypeHandler) ListDocumentTypes(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"missing_context",
+			"Organization context is required",
+		))
+		return
+	}
+
+	types, err := h.service.ListDocumentTypes(c.Request.Context(), reqCtx.OrganizationID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
+			http.StatusInternalServerError,
+			"list_failed",
+			"Failed to list document types: "+err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, services.DocumentTypesResponse{Types: types})
+}
+
+// CreateDocumentType adds a custom document type
+// @Summary Create document type
+// @Description Adds a custom document type. The description is shown to the classifier, so it should say what sets these documents apart. An optional extraction schema (a JSON schema object whose properties are the fields) makes documents of the type have their fields extracted. Requires org:manage
+// @Tags Documents
+// @Accept json
+// @Produce json
+// @Param request body github_com_moasq_backend_app_example_documents_domain.CreateDocumentTypeRequest true "Document type"
+// @Success 201 {object} github_com_moasq_backend_app_example_documents_domain.DocumentType
+// @Failure 400 {object} errors.HTTPError
+// @Failure 409 {object} errors.HTTPError "A type with this name already exists"
+// @Failure 500 {object} errors.HTTPError
+// @Router /example_documents/types [post]
+func (h *TypeHandler) CreateDocumentType(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"missing_context",
+			"Organization context is required",
+		))
+		return
+	}
+
+	var req domain.CreateDocumentTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"invalid_request",
+			"Invalid request body: "+err.Error(),
+		))
+		return
+	}
+
+	docType, err := h.service.CreateDocumentType(c.Request.Context(), reqCtx.OrganizationID, &req)
+	if err != nil {
+		writeDocumentTypeError(c, err, "create_failed", "Failed to create document type: ")
+		return
+	}
+
+	c.JSON(http.StatusCreated, docType)
+}
+
+// UpdateDocumentType replaces the description and extraction schema of a custom document type
+// @Summary Update document type
+// @Description Replaces the description and extraction schema of a custom document type; omitting the schema removes it. Names cannot change because classified documents refer to them. Requires org:manage
+// @Tags Documents
+// @Accept json
+// @Produce json
+// @Param type_id path int true "Document type ID"
+// @Param request body github_com_moasq_backend_app_example_documents_domain.UpdateDocumentTypeRequest true "Document type"
+// @Success 200 {object} github_com_moasq_backend_app_example_documents_domain.DocumentType
+// @Failure 400 {object} errors.HTTPError
+// @Failure 404 {object} errors.HTTPError
+// @Failure 500 {object} errors.HTTPError
+// @Router /example_documents/types/{type_id} [put]
+func (h *TypeHandler) UpdateDocumentType(c *gin.Context) {
+	typeID, ok := parseID(c, "type_id", "Document type ID must be a valid number")
+	if !ok {
+		return
+	}
+
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"missing_context",
+			"Organization context is required",
+		))
+		return
+	}
+
+	var req domain.UpdateDocumentTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"invalid_request",
+			"Invalid request body: "+err.Error(),
+		))
+		return
+	}
+
+	docType, err := h.service.UpdateDocumentType(c.Request.Context(), reqCtx.OrganizationID, typeID, &req)
+	if err != nil {
+		writeDocumentTypeError(c, err, "update_failed", "Failed to update document type: ")
+		return
+	}
+
+	c.JSON(http.StatusOK, docType)
+}
+
+// DeleteDocumentType removes a custom document type
+// @Summary Delete document type
+// @Description Removes a custom document type. Documents already classified as this type keep their classification. Requires org:manage
+// @Tags Documents
+// @Param type_id path int true "Document type ID"
+// @Success 204
+// @Failure 400 {object} errors.HTTPError
+// @Failure 404 {object} errors.HTTPError
+// @Failure 500 {object} errors.HTTPError
+// @Router /example_documents/types/{type_id} [delete]
+func (h *TypeHandler) DeleteDocumentType(c *gin.Context) {
+	typeID, ok := parseID(c, "type_id", "Document type ID must be a valid number")
+	if !ok {
+		return
+	}
+
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"missing_context",
+			"Organization context is required",
+		))
+		return
+	}
+
+	if err := h.service.DeleteDocumentType(c.Request.Context(), reqCtx.OrganizationID, typeID); err != nil {
+		writeDocumentTypeError(c, err, "delete_failed", "Failed to delete document type: ")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// writeDocumentTypeError maps document type errors to HTTP responses
+func writeDocumentTypeError(c *gin.Context, err error, code, message string) {
+	switch {
+	case stderrors.Is(err, domain.ErrDocumentTypeNotFound):
+		c.JSON(http.StatusNotFound, errors.NewHTTPError(
+			http.StatusNotFound,
+			"not_found",
+			"Document type not found",
+		))
+	case stderrors.Is(err, domain.ErrDocumentTypeExists):
+		c.JSON(http.StatusConflict, errors.NewHTTPError(
+			http.StatusConflict,
+			"already_exists",
+			err.Error(),
+		))
+	case stderrors.Is(err, domain.ErrInvalidDocumentTypeName),
+		stderrors.Is(err, domain.ErrInvalidExtractionSchema),
+		stderrors.Is(err, domain.ErrDocumentTypeReserved),
+		stderrors.Is(err, domain.ErrDocumentTypeDescriptionRequired),
+		stderrors.Is(err, domain.ErrDocumentTypeDescriptionTooLong),
+		stderrors.Is(err, domain.ErrDocumentTypeLimitReached):
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"invalid_document_type",
+			err.Error(),
+		))
+	default:
+		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
+			http.StatusInternalServerError,
+			code,
+			message+err.Error(),
+		))
+	}
+}
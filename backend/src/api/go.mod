@@ -11,7 +11,11 @@ require (
 	github.com/moasq/backend/pkg/api v0.0.0
 	github.com/moasq/backend/pkg/auth v0.0.0
 	github.com/moasq/backend/pkg/common v0.0.0
+	github.com/moasq/backend/pkg/file_manager v0.0.0-00010101000000-000000000000
 	github.com/moasq/backend/pkg/logger v0.0.0
+	github.com/moasq/backend/pkg/metrics v0.0.0
+	github.com/moasq/backend/pkg/ocr v0.0.0-00010101000000-000000000000
+	github.com/moasq/backend/pkg/stytch v0.0.0
 	github.com/moasq/backend/server v0.0.0-00010101000000-000000000000
 	go.uber.org/dig v1.19.0
 )
@@ -45,7 +49,6 @@ require (
 	github.com/mitchellh/mapstructure v1.5.0 // indirect
 	github.com/moasq/backend/pkg/db v0.0.0 // indirect
 	github.com/moasq/backend/pkg/eventbus v0.0.0-00010101000000-000000000000 // indirect
-	github.com/moasq/backend/pkg/file_manager v0.0.0-00010101000000-000000000000 // indirect
 	github.com/moasq/backend/pkg/polar v0.0.0 // indirect
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
 	github.com/modern-go/reflect2 v1.0.2 // indirect
@@ -100,6 +103,8 @@ replace github.com/moasq/backend/pkg/llm => ../pkg/llm
 
 replace github.com/moasq/backend/pkg/logger => ../pkg/logger
 
+replace github.com/moasq/backend/pkg/metrics => ../pkg/metrics
+
 replace github.com/moasq/backend/pkg/stytch => ../pkg/stytch
 
 replace github.com/moasq/backend/server => ../pkg/server
@@ -110,6 +115,8 @@ replace github.com/moasq/backend/pkg/auth => ../pkg/auth
 
 replace github.com/moasq/backend/pkg/paywall => ../pkg/paywall
 
+replace github.com/moasq/backend/pkg/ocr => ../pkg/ocr
+
 replace github.com/moasq/backend/pkg/polar => ../pkg/polar
 
 replace github.com/moasq/backend/pkg/redis => ../pkg/redis
@@ -0,0 +1,295 @@
+package ocr
+
+import (
+	"bytes"
+	"encoding/base64"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	billingServices "github.com/moasq/backend/app/billing/app/services"
+	billingDomain "github.com/moasq/backend/app/billing/domain"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/common/errors"
+	filemanager "github.com/moasq/backend/pkg/file_manager"
+	fileDomain "github.com/moasq/backend/pkg/file_manager/domain"
+	ocrDomain "github.com/moasq/backend/pkg/ocr/domain"
+)
+
+type Handler struct {
+	service ocrDomain.AsyncOCRService
+	billing billingServices.BillingService
+}
+
+func NewHandler(service ocrDomain.AsyncOCRService, billing billingServices.BillingService) *Handler {
+	return &Handler{service: service, billing: billing}
+}
+
+// SubmitJob starts extracting a file in the background. The file gets the
+// same size and content checks as a file manager upload, and a job consumes
+// one unit of the organization's processing quota.
+// @Summary Submit OCR job
+// @Description Starts extracting the text of a file in the background and returns the queued job. Poll the job, or pass a callback URL to receive it once it succeeds, fails or is canceled. Callback hosts must be listed in OCR_JOB_CALLBACK_ALLOWED_HOSTS. The callback is signed with the callback_secret returned here, which is not shown again: X-OCR-Signature is "sha256=" and the hex HMAC-SHA256 of "{X-OCR-Timestamp}.{body}". The type follows the file extension and must match the file content. Each job consumes one unit of the processing quota
+// @Tags OCR
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "File to extract (.pdf, .png, .jpg, .jpeg, .webp, .tif, .tiff, .docx, .xlsx, .csv, .txt)"
+// @Param callback_url formData string false "URL that receives a POST with the finished job"
+// @Param Idempotency-Key header string false "Unique key that makes retries return the original response instead of submitting again"
+// @Success 202 {object} github_com_moasq_backend_pkg_ocr_domain.Job
+// @Failure 400 {object} errors.HTTPError
+// @Failure 402 {object} errors.HTTPError "The processing quota is used up"
+// @Failure 409 {object} map[string]any "A request with this Idempotency-Key is still in progress"
+// @Failure 413 {object} errors.HTTPError "The file exceeds the size limit of its type"
+// @Failure 422 {object} map[string]any "Idempotency-Key was already used for a different request"
+// @Failure 429 {object} errors.HTTPError "Too many OCR jobs are queued or running"
+// @Failure 500 {object} errors.HTTPError
+// @Failure 503 {object} errors.HTTPError "The instance is shutting down"
+// @Router /ocr/jobs [post]
+func (h *Handler) SubmitJob(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"missing_context",
+			"Organization context is required",
+		))
+		return
+	}
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"invalid_file",
+			"Failed to read file: "+err.Error(),
+		))
+		return
+	}
+	defer file.Close()
+
+	mimeType, ok := ocrDomain.MimeTypeForFileName(header.Filename)
+	if !ok {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"invalid_file_type",
+			"Unsupported file type",
+		))
+		return
+	}
+
+	// Same per-type size limit as the file manager. The read is bounded too,
+	// since the declared size comes from the client.
+	maxSize := filemanager.GetMaxFileSize(filemanager.GetFileCategory(header.Filename))
+	data, err := io.ReadAll(io.LimitReader(file, maxSize+1))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"invalid_file",
+			"Failed to read file: "+err.Error(),
+		))
+		return
+	}
+	if header.Size > maxSize || int64(len(data)) > maxSize {
+		c.JSON(http.StatusRequestEntityTooLarge, errors.NewHTTPError(
+			http.StatusRequestEntityTooLarge,
+			"file_too_large",
+			fmt.Sprintf("File exceeds the %d byte limit for its type", maxSize),
+		))
+		return
+	}
+
+	// SECURITY: the content must match the extension (magic bytes)
+	if err := fileDomain.ValidateFileContent(bytes.NewReader(data), header.Filename); err != nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"invalid_file_type",
+			err.Error(),
+		))
+		return
+	}
+
+	// Check the quota before storing the job, so a rejected request costs
+	// nothing
+	if _, err := h.billing.CheckQuotaAvailability(c.Request.Context(), reqCtx.OrganizationID); err != nil {
+		if stderrors.Is(err, billingDomain.ErrQuotaExceeded) || stderrors.Is(err, billingDomain.ErrSubscriptionNotFound) {
+			c.JSON(http.StatusPaymentRequired, errors.NewHTTPError(
+				http.StatusPaymentRequired,
+				"quota_exceeded",
+				"The processing quota is used up",
+			))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
+			http.StatusInternalServerError,
+			"quota_check_failed",
+			"Failed to check quota: "+err.Error(),
+		))
+		return
+	}
+
+	job, err := h.service.SubmitJob(c.Request.Context(), ocrDomain.JobRequest{
+		OrganizationID: reqCtx.OrganizationID,
+		Base64File:     base64.StdEncoding.EncodeToString(data),
+		MimeType:       mimeType,
+		CallbackURL:    c.PostForm("callback_url"),
+	})
+	if err != nil {
+		if stderrors.Is(err, ocrDomain.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+				http.StatusBadRequest,
+				"invalid_request",
+				err.Error(),
+			))
+			return
+		}
+		if stderrors.Is(err, ocrDomain.ErrJobLimitReached) {
+			c.JSON(http.StatusTooManyRequests, errors.NewHTTPError(
+				http.StatusTooManyRequests,
+				"too_many_jobs",
+				"Too many OCR jobs are queued or running, retry later",
+			))
+			return
+		}
+		if stderrors.Is(err, ocrDomain.ErrProviderUnavailable) {
+			c.JSON(http.StatusServiceUnavailable, errors.NewHTTPError(
+				http.StatusServiceUnavailable,
+				"unavailable",
+				"OCR jobs are not accepted right now, retry later",
+			))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
+			http.StatusInternalServerError,
+			"submit_failed",
+			"Failed to submit OCR job: "+err.Error(),
+		))
+		return
+	}
+
+	// Consume the quota before accepting the job; a job that cannot be paid
+	// for is canceled
+	if _, err := h.billing.ConsumeInvoiceQuota(c.Request.Context(), reqCtx.OrganizationID); err != nil {
+		if cancelErr := h.service.CancelJob(c.Request.Context(), reqCtx.OrganizationID, job.ID); cancelErr != nil {
+			err = stderrors.Join(err, cancelErr)
+		}
+		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
+			http.StatusInternalServerError,
+			"quota_failed",
+			"Failed to consume quota: "+err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, job)
+}
+
+// GetJob returns the state of an OCR job
+// @Summary Get OCR job
+// @Description Returns the status and page progress of an OCR job of the organization, with the extracted text once it has succeeded
+// @Tags OCR
+// @Produce json
+// @Param job_id path int true "Job ID"
+// @Success 200 {object} github_com_moasq_backend_pkg_ocr_domain.Job
+// @Failure 400 {object} errors.HTTPError
+// @Failure 404 {object} errors.HTTPError
+// @Failure 500 {object} errors.HTTPError
+// @Router /ocr/jobs/{job_id} [get]
+func (h *Handler) GetJob(c *gin.Context) {
+	jobID, ok := parseID(c, "job_id", "Job ID must be a valid number")
+	if !ok {
+		return
+	}
+
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"missing_context",
+			"Organization context is required",
+		))
+		return
+	}
+
+	job, err := h.service.GetJob(c.Request.Context(), reqCtx.OrganizationID, jobID)
+	if err != nil {
+		if stderrors.Is(err, ocrDomain.ErrJobNotFound) {
+			c.JSON(http.StatusNotFound, errors.NewHTTPError(
+				http.StatusNotFound,
+				"not_found",
+				"Job not found",
+			))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
+			http.StatusInternalServerError,
+			"get_failed",
+			"Failed to get OCR job: "+err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
+
+// CancelJob stops a queued or running OCR job
+// @Summary Cancel OCR job
+// @Description Stops a queued or running OCR job of the organization. Canceling a finished job does nothing
+// @Tags OCR
+// @Param job_id path int true "Job ID"
+// @Success 204
+// @Failure 400 {object} errors.HTTPError
+// @Failure 404 {object} errors.HTTPError
+// @Failure 500 {object} errors.HTTPError
+// @Router /ocr/jobs/{job_id} [delete]
+func (h *Handler) CancelJob(c *gin.Context) {
+	jobID, ok := parseID(c, "job_id", "Job ID must be a valid number")
+	if !ok {
+		return
+	}
+
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"missing_context",
+			"Organization context is required",
+		))
+		return
+	}
+
+	if err := h.service.CancelJob(c.Request.Context(), reqCtx.OrganizationID, jobID); err != nil {
+		if stderrors.Is(err, ocrDomain.ErrJobNotFound) {
+			c.JSON(http.StatusNotFound, errors.NewHTTPError(
+				http.StatusNotFound,
+				"not_found",
+				"Job not found",
+			))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, errors.NewHTTPError(
+			http.StatusInternalServerError,
+			"cancel_failed",
+			"Failed to cancel OCR job: "+err.Error(),
+		))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// parseID reads a numeric path parameter, writing a 400 response when it is invalid
+func parseID(c *gin.Context, param, message string) (int32, bool) {
+	var id int32
+	if _, err := fmt.Sscanf(c.Param(param), "%d", &id); err != nil {
+		c.JSON(http.StatusBadRequest, errors.NewHTTPError(
+			http.StatusBadRequest,
+			"invalid_id",
+			message,
+		))
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,48 @@
+package ocr
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/moasq/backend/pkg/auth"
+	serverDomain "github.com/moasq/backend/server/domain"
+)
+
+type Routes struct {
+	handler *Handler
+}
+
+func NewRoutes(handler *Handler) *Routes {
+	return &Routes{handler: handler}
+}
+
+func (r *Routes) RegisterRoutes(router *gin.RouterGroup, resolver serverDomain.MiddlewareResolver) {
+	jobsGroup := router.Group("/ocr/jobs")
+	jobsGroup.Use(
+		resolver.Get("auth"),
+		resolver.Get("org_context"),
+		resolver.Get("subscription"),
+		resolver.Get("rate_limit"),
+	)
+	{
+		// Submit a file for asynchronous extraction
+		jobsGroup.POST("",
+			auth.RequirePermissionFunc("resource", "create"),
+			resolver.Get("idempotency"),
+			r.handler.SubmitJob)
+
+		// Poll a job of the organization
+		jobsGroup.GET("/:job_id",
+			auth.RequirePermissionFunc("resource", "view"),
+			r.handler.GetJob)
+
+		// Cancel a queued or running job
+		jobsGroup.DELETE("/:job_id",
+			auth.RequirePermissionFunc("resource", "edit"),
+			r.handler.CancelJob)
+	}
+}
+
+// Routes returns a RouteRegistrar function compatible with the server interface
+func (r *Routes) Routes(router *gin.RouterGroup, resolver serverDomain.MiddlewareResolver) {
+	r.RegisterRoutes(router, resolver)
+}
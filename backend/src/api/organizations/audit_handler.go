@@ -0,0 +1,267 @@
+package organizations
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	auditServices "github.com/moasq/backend/app/audit/app/services"
+	auditDomain "github.com/moasq/backend/app/audit/domain"
+	"github.com/moasq/backend/pkg/api/response"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/logger"
+)
+
+// Audit log export formats
+const (
+	auditFormatJSON  = "json"
+	auditFormatCSV   = "csv"
+	auditFormatJSONL = "jsonl"
+)
+
+var auditCSVHeader = []string{
+	"id", "sequence", "occurred_at", "actor_type", "actor_account_id", "actor_email",
+	"action", "target_type", "target_id", "ip_address", "user_agent", "request_id",
+	"changes", "metadata", "prev_hash", "hash",
+}
+
+type AuditHandler struct {
+	auditService auditServices.AuditService
+	logger       logger.Logger
+}
+
+func NewAuditHandler(
+	auditService auditServices.AuditService,
+	logger logger.Logger,
+) *AuditHandler {
+	return &AuditHandler{
+		auditService: auditService,
+		logger:       logger,
+	}
+}
+
+// AuditLogQuery holds the audit log filters
+type AuditLogQuery struct {
+	Action         string `form:"action"`
+	ActorAccountID int32  `form:"actor_account_id"`
+	TargetType     string `form:"target_type"`
+	TargetID       string `form:"target_id"`
+	From           string `form:"from"`
+	To             string `form:"to"`
+	Cursor         int64  `form:"cursor" binding:"omitempty,min=1"`
+	Limit          int32  `form:"limit" binding:"omitempty,min=1,max=200"`
+	Format         string `form:"format" binding:"omitempty,oneof=json csv jsonl"`
+}
+
+// GetAuditLog lists or exports the current organization's audit log.
+// @Summary Get organization audit log
+// @Description Lists audit events newest first. Use next_cursor as cursor to get the next page. With format=csv or format=jsonl every matching event is streamed as a file download and cursor/limit are ignored.
+// @Tags organizations
+// @Produce json
+// @Produce text/csv
+// @Param Authorization header string true "Bearer JWT token"
+// @Param action query string false "Action, or prefix ending in * (e.g. member.*)"
+// @Param actor_account_id query int false "Actor account ID"
+// @Param target_type query string false "Target type"
+// @Param target_id query string false "Target ID"
+// @Param from query string false "Occurred at or after (RFC3339)"
+// @Param to query string false "Occurred before (RFC3339)"
+// @Param cursor query int false "Return events before this sequence"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Param format query string false "json (default), csv, or jsonl"
+// @Success 200 {object} github_com_moasq_backend_app_audit_domain.EventPage
+// @Failure 400 {object} map[string]any "Invalid filters"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 500 {object} map[string]any "Failed to get audit log"
+// @Router /organizations/audit-log [get]
+func (h *AuditHandler) GetAuditLog(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	var query AuditLogQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid query parameters", err)
+		return
+	}
+
+	filter, err := query.toFilter(reqCtx.OrganizationID)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+
+	switch query.Format {
+	case auditFormatCSV, auditFormatJSONL:
+		h.exportAuditLog(c, filter, query.Format)
+		return
+	}
+
+	page, err := h.auditService.ListEvents(c.Request.Context(), filter)
+	if err != nil {
+		h.logger.Error("failed to list audit events", map[string]any{
+			"organization_id": reqCtx.OrganizationID,
+			"error":           err.Error(),
+		})
+		response.Error(c, http.StatusInternalServerError, "failed to get audit log", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, page)
+}
+
+// VerifyAuditLog recomputes the organization's audit hash chain.
+// @Summary Verify organization audit log
+// @Description Recomputes every event hash in order and reports the first event whose hash or link to the previous event does not match.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Success 200 {object} github_com_moasq_backend_app_audit_domain.ChainVerification
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 500 {object} map[string]any "Failed to verify audit log"
+// @Router /organizations/audit-log/verify [get]
+func (h *AuditHandler) VerifyAuditLog(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	result, err := h.auditService.VerifyChain(c.Request.Context(), reqCtx.OrganizationID)
+	if err != nil {
+		h.logger.Error("failed to verify audit chain", map[string]any{
+			"organization_id": reqCtx.OrganizationID,
+			"error":           err.Error(),
+		})
+		response.Error(c, http.StatusInternalServerError, "failed to verify audit log", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, result)
+}
+
+// exportAuditLog streams matching events as a CSV or JSONL download. The
+// response status is sent before the first event, so later errors can only be logged.
+func (h *AuditHandler) exportAuditLog(c *gin.Context, filter auditDomain.Filter, format string) {
+	filename := fmt.Sprintf("audit-log-%d-%s.%s", filter.OrganizationID, time.Now().UTC().Format("20060102T150405Z"), format)
+	if format == auditFormatCSV {
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+	} else {
+		c.Header("Content-Type", "application/x-ndjson")
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Status(http.StatusOK)
+
+	csvWriter := csv.NewWriter(c.Writer)
+	jsonEncoder := json.NewEncoder(c.Writer)
+
+	var err error
+	if format == auditFormatCSV {
+		err = csvWriter.Write(auditCSVHeader)
+	}
+	if err == nil {
+		err = h.auditService.ExportEvents(c.Request.Context(), filter, func(event *auditDomain.Event) error {
+			if format == auditFormatJSONL {
+				return jsonEncoder.Encode(event)
+			}
+			record, err := auditCSVRecord(event)
+			if err != nil {
+				return err
+			}
+			return csvWriter.Write(record)
+		})
+	}
+	csvWriter.Flush()
+
+	if err != nil {
+		h.logger.Error("failed to export audit events", map[string]any{
+			"organization_id": filter.OrganizationID,
+			"format":          format,
+			"error":           err.Error(),
+		})
+		c.Abort()
+	}
+}
+
+func (q *AuditLogQuery) toFilter(orgID int32) (auditDomain.Filter, error) {
+	filter := auditDomain.Filter{
+		OrganizationID: orgID,
+		Action:         strings.TrimSpace(q.Action),
+		ActorAccountID: q.ActorAccountID,
+		TargetType:     q.TargetType,
+		TargetID:       q.TargetID,
+		BeforeSequence: q.Cursor,
+		Limit:          q.Limit,
+	}
+
+	if q.From != "" {
+		from, err := time.Parse(time.RFC3339, q.From)
+		if err != nil {
+			return filter, fmt.Errorf("invalid from: must be RFC3339")
+		}
+		filter.From = &from
+	}
+	if q.To != "" {
+		to, err := time.Parse(time.RFC3339, q.To)
+		if err != nil {
+			return filter, fmt.Errorf("invalid to: must be RFC3339")
+		}
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, fmt.Errorf("invalid range: from must be before to")
+	}
+
+	return filter, nil
+}
+
+func auditCSVRecord(event *auditDomain.Event) ([]string, error) {
+	changes, err := json.Marshal(event.Changes)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := json.Marshal(event.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	actorAccountID := ""
+	if event.ActorAccountID != nil {
+		actorAccountID = strconv.FormatInt(int64(*event.ActorAccountID), 10)
+	}
+
+	return []string{
+		strconv.FormatInt(event.ID, 10),
+		strconv.FormatInt(event.Sequence, 10),
+		event.OccurredAt.Format(time.RFC3339Nano),
+		event.ActorType,
+		actorAccountID,
+		csvSafe(event.ActorEmail),
+		csvSafe(event.Action),
+		csvSafe(event.TargetType),
+		csvSafe(event.TargetID),
+		csvSafe(event.IPAddress),
+		csvSafe(event.UserAgent),
+		csvSafe(event.RequestID),
+		string(changes),
+		string(metadata),
+		event.PrevHash,
+		event.Hash,
+	}, nil
+}
+
+// csvSafe prefixes values that spreadsheet applications would evaluate as formulas.
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
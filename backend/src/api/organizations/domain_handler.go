@@ -0,0 +1,237 @@
+package organizations
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moasq/backend/app/organizations/app/services"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/api/response"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/logger"
+)
+
+type DomainHandler struct {
+	domainService services.DomainService
+	logger        logger.Logger
+}
+
+func NewDomainHandler(
+	domainService services.DomainService,
+	logger logger.Logger,
+) *DomainHandler {
+	return &DomainHandler{
+		domainService: domainService,
+		logger:        logger,
+	}
+}
+
+// ListDomains returns the current organization's claimed email domains.
+// @Summary List organization domains
+// @Description Returns the email domains the organization has claimed, with their verification status, DNS verification record, and JIT provisioning settings.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Success 200 {array} github_com_moasq_backend_app_organizations_domain.OrganizationDomain
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 500 {object} map[string]any "Failed to list domains"
+// @Router /organizations/domains [get]
+func (h *DomainHandler) ListDomains(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	domains, err := h.domainService.ListDomains(c.Request.Context(), reqCtx.OrganizationID)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to list domains", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, domains)
+}
+
+// ClaimDomain claims an email domain for the current organization.
+// @Summary Claim organization domain
+// @Description Claims an email domain. Publish the returned verification_record as a DNS TXT record, then call the verify endpoint. Public email providers cannot be claimed.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param request body github_com_moasq_backend_app_organizations_domain.ClaimDomainRequest true "Domain"
+// @Success 201 {object} github_com_moasq_backend_app_organizations_domain.OrganizationDomain
+// @Failure 400 {object} map[string]any "Invalid domain"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 409 {object} map[string]any "Domain already claimed or verified by another organization"
+// @Failure 500 {object} map[string]any "Failed to claim domain"
+// @Router /organizations/domains [post]
+func (h *DomainHandler) ClaimDomain(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	var req domain.ClaimDomainRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request payload", err)
+		return
+	}
+
+	claimed, err := h.domainService.ClaimDomain(c.Request.Context(), reqCtx.OrganizationID, &req)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to claim domain", err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, claimed)
+}
+
+// VerifyDomain checks a claimed domain's DNS TXT record.
+// @Summary Verify organization domain
+// @Description Looks up the domain's DNS TXT verification record and marks the domain verified when it carries the organization's token.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path int true "Domain ID"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.OrganizationDomain
+// @Failure 400 {object} map[string]any "Invalid domain ID"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 404 {object} map[string]any "Domain not found"
+// @Failure 409 {object} map[string]any "Domain verified by another organization"
+// @Failure 422 {object} map[string]any "Verification record not found"
+// @Failure 500 {object} map[string]any "Failed to verify domain"
+// @Router /organizations/domains/{id}/verify [post]
+func (h *DomainHandler) VerifyDomain(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	domainID, ok := h.domainID(c)
+	if !ok {
+		return
+	}
+
+	verified, err := h.domainService.VerifyDomain(c.Request.Context(), reqCtx.OrganizationID, domainID)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to verify domain", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, verified)
+}
+
+// UpdateDomainJIT configures just-in-time provisioning for a domain.
+// @Summary Update domain JIT provisioning
+// @Description Turns just-in-time provisioning on or off for a verified domain. Users from the domain who authenticate are added with default_role (member or approver) while the organization has free seats.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path int true "Domain ID"
+// @Param request body github_com_moasq_backend_app_organizations_domain.UpdateDomainJITRequest true "JIT settings"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.OrganizationDomain
+// @Failure 400 {object} map[string]any "Invalid request"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 404 {object} map[string]any "Domain not found"
+// @Failure 409 {object} map[string]any "Domain is not verified"
+// @Failure 500 {object} map[string]any "Failed to update domain"
+// @Router /organizations/domains/{id} [put]
+func (h *DomainHandler) UpdateDomainJIT(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	domainID, ok := h.domainID(c)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateDomainJITRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request payload", err)
+		return
+	}
+
+	updated, err := h.domainService.UpdateJIT(c.Request.Context(), reqCtx.OrganizationID, domainID, &req)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to update domain", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, updated)
+}
+
+// RemoveDomain removes a claimed domain.
+// @Summary Remove organization domain
+// @Description Removes the domain claim and stops JIT provisioning for it. Existing members are not affected.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path int true "Domain ID"
+// @Success 200 {object} map[string]any "Domain removed"
+// @Failure 400 {object} map[string]any "Invalid domain ID"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 404 {object} map[string]any "Domain not found"
+// @Failure 500 {object} map[string]any "Failed to remove domain"
+// @Router /organizations/domains/{id} [delete]
+func (h *DomainHandler) RemoveDomain(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	domainID, ok := h.domainID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.domainService.RemoveDomain(c.Request.Context(), reqCtx.OrganizationID, domainID); err != nil {
+		h.handleError(c, reqCtx, "failed to remove domain", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "domain removed"})
+}
+
+// domainID parses the :id path parameter, writing a 400 response when it is invalid.
+func (h *DomainHandler) domainID(c *gin.Context) (int32, bool) {
+	var domainID int32
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &domainID); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid domain ID", err)
+		return 0, false
+	}
+	return domainID, true
+}
+
+func (h *DomainHandler) handleError(c *gin.Context, reqCtx *auth.RequestContext, msg string, err error) {
+	switch {
+	case errors.Is(err, domain.ErrDomainNotFound):
+		response.Error(c, http.StatusNotFound, "domain not found", err)
+	case errors.Is(err, domain.ErrInvalidDomain),
+		errors.Is(err, domain.ErrPublicEmailDomain),
+		errors.Is(err, domain.ErrInvalidJITRole):
+		response.Error(c, http.StatusBadRequest, err.Error(), err)
+	case errors.Is(err, domain.ErrDomainAlreadyClaimed),
+		errors.Is(err, domain.ErrDomainOwnedElsewhere),
+		errors.Is(err, domain.ErrDomainNotVerified):
+		response.Error(c, http.StatusConflict, err.Error(), err)
+	case errors.Is(err, domain.ErrDomainVerificationFailed):
+		response.Error(c, http.StatusUnprocessableEntity, err.Error(), err)
+	default:
+		h.logger.Error(msg, map[string]any{
+			"organization_id": reqCtx.OrganizationID,
+			"error":           err.Error(),
+		})
+		response.Error(c, http.StatusInternalServerError, msg, err)
+	}
+}
@@ -14,15 +14,18 @@ import (
 
 type MemberHandler struct {
 	memberService services.MemberService
+	domainService services.DomainService
 	logger        logger.Logger
 }
 
 func NewMemberHandler(
 	memberService services.MemberService,
+	domainService services.DomainService,
 	logger logger.Logger,
 ) *MemberHandler {
 	return &MemberHandler{
 		memberService: memberService,
+		domainService: domainService,
 		logger:        logger,
 	}
 }
@@ -33,9 +36,12 @@ func NewMemberHandler(
 // @Tags auth
 // @Accept json
 // @Produce json
+// @Param Idempotency-Key header string false "Unique key that makes retries return the original response instead of creating another organization"
 // @Param request body github_com_moasq_backend_app_organizations_app_services.BootstrapOrganizationRequest true "Organization bootstrap request (passwordless - no password required)"
 // @Success 201 {object} github_com_moasq_backend_app_organizations_app_services.BootstrapOrganizationResponse
 // @Failure 400 {object} map[string]any "Invalid request payload"
+// @Failure 409 {object} map[string]any "A request with this Idempotency-Key is still in progress"
+// @Failure 422 {object} map[string]any "Idempotency-Key was already used for a different request"
 // @Failure 500 {object} map[string]any "Failed to bootstrap organization"
 // @Router /auth/signup [post]
 func (h *MemberHandler) BootstrapOrganization(c *gin.Context) {
@@ -287,12 +293,12 @@ func (h *MemberHandler) DeleteMember(c *gin.Context) {
 }
 
 // @Summary Check if email exists
-// @Description Checks if an email exists in any organization. Returns 200 OK (empty response) if exists, 404 Not Found if doesn't exist. This is a public endpoint used during login flow.
+// @Description Checks if an email exists in any organization and which organization verified its domain. Returns 200 OK if the email exists or its domain belongs to an organization (domain_organization.jit_enabled tells whether signing in joins it automatically), 404 Not Found otherwise. This is a public endpoint used during login flow.
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param email query string true "Email address to check"
-// @Success 200 "Email exists"
+// @Success 200 {object} services.CheckEmailResponse "Email exists or its domain is claimed"
 // @Failure 400 {object} map[string]any "Invalid email format"
 // @Failure 404 {object} map[string]any "Email not found"
 // @Failure 500 {object} map[string]any "Internal server error"
@@ -321,8 +327,19 @@ func (h *MemberHandler) CheckEmail(c *gin.Context) {
 		return
 	}
 
-	// Return 404 if email doesn't exist
-	if !exists {
+	// Report the organization that verified the email's domain, if any
+	owner, err := h.domainService.FindDomainOwner(c.Request.Context(), email)
+	if err != nil {
+		h.logger.Error("failed to look up email domain owner", map[string]any{
+			"email": email,
+			"error": err.Error(),
+		})
+		response.Error(c, http.StatusInternalServerError, "failed to check email existence", err)
+		return
+	}
+
+	// Return 404 if email doesn't exist and no organization claims its domain
+	if !exists && owner == nil {
 		h.logger.Debug("email not found", map[string]any{
 			"email": email,
 		})
@@ -330,9 +347,13 @@ func (h *MemberHandler) CheckEmail(c *gin.Context) {
 		return
 	}
 
-	// Return 200 OK with empty response if email exists
-	h.logger.Debug("email exists", map[string]any{
-		"email": email,
+	h.logger.Debug("email checked", map[string]any{
+		"email":          email,
+		"exists":         exists,
+		"domain_claimed": owner != nil,
+	})
+	response.Success(c, http.StatusOK, services.CheckEmailResponse{
+		Exists:             exists,
+		DomainOrganization: owner,
 	})
-	response.Success(c, http.StatusOK, gin.H{})
 }
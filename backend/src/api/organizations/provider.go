@@ -3,8 +3,10 @@ package organizations
 import (
 	"go.uber.org/dig"
 
+	auditServices "github.com/moasq/backend/app/audit/app/services"
 	"github.com/moasq/backend/app/organizations/app/services"
 	"github.com/moasq/backend/pkg/logger"
+	stytchcfg "github.com/moasq/backend/pkg/stytch"
 )
 
 // Provider provides organization API dependencies
@@ -42,9 +44,101 @@ func (p *Provider) RegisterDependencies() error {
 	// Register member handler (for auth/member routes)
 	if err := p.container.Provide(func(
 		memberService services.MemberService,
+		domainService services.DomainService,
 		logger logger.Logger,
 	) *MemberHandler {
-		return NewMemberHandler(memberService, logger)
+		return NewMemberHandler(memberService, domainService, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register reconciliation handler (admin member drift checks)
+	if err := p.container.Provide(func(
+		reconciliationService services.ReconciliationService,
+		logger logger.Logger,
+	) *ReconciliationHandler {
+		return NewReconciliationHandler(reconciliationService, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register session handler (list/revoke member sessions)
+	if err := p.container.Provide(func(
+		sessionService services.SessionService,
+		logger logger.Logger,
+	) *SessionHandler {
+		return NewSessionHandler(sessionService, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register settings handler (per-organization settings)
+	if err := p.container.Provide(func(
+		settingsService services.SettingsService,
+		logger logger.Logger,
+	) *SettingsHandler {
+		return NewSettingsHandler(settingsService, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register audit handler (audit log query and export)
+	if err := p.container.Provide(func(
+		auditService auditServices.AuditService,
+		logger logger.Logger,
+	) *AuditHandler {
+		return NewAuditHandler(auditService, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register SSO handler (SAML/OIDC connection management)
+	if err := p.container.Provide(func(
+		ssoService services.SSOService,
+		logger logger.Logger,
+	) *SSOHandler {
+		return NewSSOHandler(ssoService, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register security policy handler (MFA, allowed auth methods, IP allowlist)
+	if err := p.container.Provide(func(
+		securityPolicyService services.SecurityPolicyService,
+		logger logger.Logger,
+	) *SecurityPolicyHandler {
+		return NewSecurityPolicyHandler(securityPolicyService, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register domain handler (email domain verification, JIT membership)
+	if err := p.container.Provide(func(
+		domainService services.DomainService,
+		logger logger.Logger,
+	) *DomainHandler {
+		return NewDomainHandler(domainService, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register team handler (teams and team membership)
+	if err := p.container.Provide(func(
+		teamService services.TeamService,
+		logger logger.Logger,
+	) *TeamHandler {
+		return NewTeamHandler(teamService, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register webhook handler (auth provider → local sync)
+	if err := p.container.Provide(func(
+		webhookService services.WebhookService,
+		stytchConfig *stytchcfg.Config,
+		logger logger.Logger,
+	) *WebhookHandler {
+		return NewWebhookHandler(webhookService, stytchConfig, logger)
 	}); err != nil {
 		return err
 	}
@@ -54,8 +148,17 @@ func (p *Provider) RegisterDependencies() error {
 		organizationHandler *OrganizationHandler,
 		accountHandler *AccountHandler,
 		memberHandler *MemberHandler,
+		reconciliationHandler *ReconciliationHandler,
+		webhookHandler *WebhookHandler,
+		sessionHandler *SessionHandler,
+		settingsHandler *SettingsHandler,
+		auditHandler *AuditHandler,
+		ssoHandler *SSOHandler,
+		securityHandler *SecurityPolicyHandler,
+		domainHandler *DomainHandler,
+		teamHandler *TeamHandler,
 	) *Routes {
-		return NewRoutes(organizationHandler, accountHandler, memberHandler)
+		return NewRoutes(organizationHandler, accountHandler, memberHandler, reconciliationHandler, webhookHandler, sessionHandler, settingsHandler, auditHandler, ssoHandler, securityHandler, domainHandler, teamHandler)
 	}); err != nil {
 		return err
 	}
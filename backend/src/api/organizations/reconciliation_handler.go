@@ -0,0 +1,81 @@
+package organizations
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moasq/backend/app/organizations/app/services"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/api/response"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/logger"
+)
+
+type ReconciliationHandler struct {
+	reconciliationService services.ReconciliationService
+	logger                logger.Logger
+}
+
+func NewReconciliationHandler(
+	reconciliationService services.ReconciliationService,
+	logger logger.Logger,
+) *ReconciliationHandler {
+	return &ReconciliationHandler{
+		reconciliationService: reconciliationService,
+		logger:                logger,
+	}
+}
+
+// ReconcileMembers diffs the current organization's Stytch members against local accounts.
+// @Summary Reconcile organization members
+// @Description Compares members in the auth provider with local accounts and reports drift (missing, orphaned, role mismatch). Runs as a dry run by default; pass dry_run=false to apply the fixes.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param dry_run query bool false "Only report drift without applying changes (default true)"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.ReconciliationReport
+// @Failure 400 {object} map[string]any "Invalid dry_run value or missing organization context"
+// @Failure 500 {object} map[string]any "Failed to reconcile members"
+// @Router /organizations/reconcile [post]
+func (h *ReconciliationHandler) ReconcileMembers(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		h.logger.Error("missing request context", nil)
+		response.Error(c, http.StatusBadRequest, "organization context is required", nil)
+		return
+	}
+
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dry_run", "true"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "dry_run must be a boolean", err)
+		return
+	}
+
+	report, err := h.reconciliationService.ReconcileOrganization(c.Request.Context(), reqCtx.OrganizationID, dryRun)
+	if err != nil {
+		if errors.Is(err, domain.ErrAuthOrganizationIDRequired) {
+			response.Error(c, http.StatusBadRequest, "organization is not linked to the auth provider", err)
+			return
+		}
+		h.logger.Error("failed to reconcile members", map[string]any{
+			"org_id":  reqCtx.OrganizationID,
+			"dry_run": dryRun,
+			"error":   err.Error(),
+		})
+		response.Error(c, http.StatusInternalServerError, "failed to reconcile members", err)
+		return
+	}
+
+	h.logger.Info("member reconciliation completed", map[string]any{
+		"org_id":  reqCtx.OrganizationID,
+		"dry_run": dryRun,
+		"drifts":  len(report.Drifts),
+		"applied": report.Applied,
+		"failed":  report.Failed,
+	})
+
+	response.Success(c, http.StatusOK, report)
+}
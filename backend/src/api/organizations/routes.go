@@ -11,17 +11,44 @@ type Routes struct {
 	organizationHandler *OrganizationHandler
 	accountHandler      *AccountHandler
 	memberHandler       *MemberHandler
+	reconcileHandler    *ReconciliationHandler
+	webhookHandler      *WebhookHandler
+	sessionHandler      *SessionHandler
+	settingsHandler     *SettingsHandler
+	auditHandler        *AuditHandler
+	ssoHandler          *SSOHandler
+	securityHandler     *SecurityPolicyHandler
+	domainHandler       *DomainHandler
+	teamHandler         *TeamHandler
 }
 
 func NewRoutes(
 	organizationHandler *OrganizationHandler,
 	accountHandler *AccountHandler,
 	memberHandler *MemberHandler,
+	reconcileHandler *ReconciliationHandler,
+	webhookHandler *WebhookHandler,
+	sessionHandler *SessionHandler,
+	settingsHandler *SettingsHandler,
+	auditHandler *AuditHandler,
+	ssoHandler *SSOHandler,
+	securityHandler *SecurityPolicyHandler,
+	domainHandler *DomainHandler,
+	teamHandler *TeamHandler,
 ) *Routes {
 	return &Routes{
 		organizationHandler: organizationHandler,
 		accountHandler:      accountHandler,
 		memberHandler:       memberHandler,
+		reconcileHandler:    reconcileHandler,
+		webhookHandler:      webhookHandler,
+		sessionHandler:      sessionHandler,
+		settingsHandler:     settingsHandler,
+		auditHandler:        auditHandler,
+		ssoHandler:          ssoHandler,
+		securityHandler:     securityHandler,
+		domainHandler:       domainHandler,
+		teamHandler:         teamHandler,
 	}
 }
 
@@ -30,22 +57,25 @@ func (r *Routes) RegisterRoutes(router *gin.RouterGroup, resolver serverDomain.M
 	// Auth routes - member management and authentication
 	authGroup := router.Group("/auth")
 	{
-		// Public endpoint - Organization signup (no authentication required)
-		authGroup.POST("/signup", r.memberHandler.BootstrapOrganization)
+		// Public endpoint - Organization signup (no authentication required, rate limited by IP).
+		// Idempotency-Key makes retries safe so a timeout does not create a second organization.
+		authGroup.POST("/signup", resolver.Get("rate_limit"), resolver.Get("idempotency"), r.memberHandler.BootstrapOrganization)
 
-		// Public endpoint - Check if email exists (no authentication required)
-		authGroup.GET("/check-email", r.memberHandler.CheckEmail)
+		// Public endpoint - Check if email exists (no authentication required, rate limited by IP)
+		authGroup.GET("/check-email", resolver.Get("rate_limit"), r.memberHandler.CheckEmail)
 
 		// Protected endpoint - Add member (requires JWT authentication)
 		authGroup.POST("/members",
 			resolver.Get("auth"),
 			resolver.Get("org_context"),
+			resolver.Get("rate_limit"),
 			r.memberHandler.AddMember)
 
 		// Protected endpoint - List members (requires JWT authentication and org:manage permission)
 		authGroup.GET("/members",
 			resolver.Get("auth"),
 			resolver.Get("org_context"),
+			resolver.Get("rate_limit"),
 			auth.RequirePermissionFunc("org", "manage"),
 			r.memberHandler.ListMembers)
 
@@ -53,27 +83,92 @@ func (r *Routes) RegisterRoutes(router *gin.RouterGroup, resolver serverDomain.M
 		authGroup.GET("/profile/me",
 			resolver.Get("auth"),
 			resolver.Get("org_context"),
+			resolver.Get("rate_limit"),
 			r.memberHandler.GetProfile)
 
 		// Protected endpoint - Delete organization member (requires JWT authentication and org:manage permission)
 		authGroup.DELETE("/members/:member_id",
 			resolver.Get("auth"),
 			resolver.Get("org_context"),
+			resolver.Get("rate_limit"),
 			auth.RequirePermissionFunc("org", "manage"),
 			r.memberHandler.DeleteMember)
+
+		// Protected endpoints - Current user's sessions (requires JWT authentication only)
+		authGroup.GET("/sessions",
+			resolver.Get("auth"),
+			resolver.Get("org_context"),
+			resolver.Get("rate_limit"),
+			r.sessionHandler.ListMySessions)
+		authGroup.DELETE("/sessions",
+			resolver.Get("auth"),
+			resolver.Get("org_context"),
+			resolver.Get("rate_limit"),
+			r.sessionHandler.RevokeAllMySessions)
+		authGroup.DELETE("/sessions/:session_id",
+			resolver.Get("auth"),
+			resolver.Get("org_context"),
+			resolver.Get("rate_limit"),
+			r.sessionHandler.RevokeMySession)
 	}
 
+	// Webhook routes - public, authenticated by signature verification in the handler
+	router.POST("/webhooks/stytch", r.webhookHandler.HandleStytchWebhook)
+
 	// Organization routes - require JWT authentication
 	orgGroup := router.Group("/organizations")
 	orgGroup.Use(
 		resolver.Get("auth"),
 		resolver.Get("org_context"),
+		resolver.Get("rate_limit"),
 	)
 	{
 		// Current organization endpoints
 		orgGroup.GET("", auth.RequirePermissionFunc("org", "view"), r.organizationHandler.GetOrganization)
 		orgGroup.PUT("", auth.RequirePermissionFunc("org", "manage"), r.organizationHandler.UpdateOrganization)
 		orgGroup.GET("/stats", auth.RequirePermissionFunc("org", "view"), r.organizationHandler.GetOrganizationStats)
+		orgGroup.POST("/reconcile", auth.RequirePermissionFunc("org", "manage"), r.reconcileHandler.ReconcileMembers)
+
+		// Organization settings (read by authorization policies)
+		orgGroup.GET("/settings", auth.RequirePermissionFunc("org", "view"), r.settingsHandler.GetSettings)
+		orgGroup.PATCH("/settings", auth.RequirePermissionFunc("org", "manage"), r.settingsHandler.UpdateSettings)
+
+		// Audit log (admins only)
+		orgGroup.GET("/audit-log", auth.RequirePermissionFunc("org", "manage"), r.auditHandler.GetAuditLog)
+		orgGroup.GET("/audit-log/verify", auth.RequirePermissionFunc("org", "manage"), r.auditHandler.VerifyAuditLog)
+
+		// Security policy (MFA, allowed auth methods, IP allowlist), enforced by org_context
+		orgGroup.GET("/security-policy", auth.RequirePermissionFunc("org", "manage"), r.securityHandler.GetSecurityPolicy)
+		orgGroup.PUT("/security-policy", auth.RequirePermissionFunc("org", "manage"), r.securityHandler.UpdateSecurityPolicy)
+
+		// SSO connections (admins only)
+		ssoGroup := orgGroup.Group("/sso", auth.RequirePermissionFunc("org", "manage"))
+		ssoGroup.GET("", r.ssoHandler.GetSSO)
+		ssoGroup.POST("/connections", r.ssoHandler.CreateConnection)
+		ssoGroup.PUT("/connections/:connection_id/saml", r.ssoHandler.UpdateSAMLConnection)
+		ssoGroup.PUT("/connections/:connection_id/oidc", r.ssoHandler.UpdateOIDCConnection)
+		ssoGroup.DELETE("/connections/:connection_id", r.ssoHandler.DeleteConnection)
+		ssoGroup.PUT("/default-connection", r.ssoHandler.SetDefaultConnection)
+		ssoGroup.PUT("/required", r.ssoHandler.SetSSORequired)
+
+		// Email domains and JIT membership (admins only)
+		domainGroup := orgGroup.Group("/domains", auth.RequirePermissionFunc("org", "manage"))
+		domainGroup.GET("", r.domainHandler.ListDomains)
+		domainGroup.POST("", r.domainHandler.ClaimDomain)
+		domainGroup.POST("/:id/verify", r.domainHandler.VerifyDomain)
+		domainGroup.PUT("/:id", r.domainHandler.UpdateDomainJIT)
+		domainGroup.DELETE("/:id", r.domainHandler.RemoveDomain)
+
+		// Teams - managers administer every team, leads manage their own team's members
+		teamGroup := orgGroup.Group("/teams")
+		teamGroup.GET("", auth.RequirePermissionFunc("org", "view"), r.teamHandler.ListTeams)
+		teamGroup.POST("", auth.RequirePermissionFunc("org", "manage"), r.teamHandler.CreateTeam)
+		teamGroup.GET("/:id", auth.RequirePermissionFunc("org", "view"), r.teamHandler.GetTeam)
+		teamGroup.PUT("/:id", auth.RequirePermissionFunc("org", "view"), r.teamHandler.UpdateTeam)
+		teamGroup.DELETE("/:id", auth.RequirePermissionFunc("org", "manage"), r.teamHandler.DeleteTeam)
+		teamGroup.GET("/:id/members", auth.RequirePermissionFunc("org", "view"), r.teamHandler.ListTeamMembers)
+		teamGroup.PUT("/:id/members/:account_id", auth.RequirePermissionFunc("org", "view"), r.teamHandler.SetTeamMember)
+		teamGroup.DELETE("/:id/members/:account_id", auth.RequirePermissionFunc("org", "view"), r.teamHandler.RemoveTeamMember)
 	}
 
 	// Account routes - require JWT authentication
@@ -81,6 +176,7 @@ func (r *Routes) RegisterRoutes(router *gin.RouterGroup, resolver serverDomain.M
 	accountGroup.Use(
 		resolver.Get("auth"),
 		resolver.Get("org_context"),
+		resolver.Get("rate_limit"),
 	)
 	{
 		// Account management
@@ -93,6 +189,11 @@ func (r *Routes) RegisterRoutes(router *gin.RouterGroup, resolver serverDomain.M
 		accountGroup.POST("/:id/last-login", auth.RequirePermissionFunc("org", "view"), r.accountHandler.UpdateAccountLastLogin)
 		accountGroup.GET("/:id/permissions", auth.RequirePermissionFunc("org", "view"), r.accountHandler.CheckAccountPermission)
 		accountGroup.GET("/:id/stats", auth.RequirePermissionFunc("org", "view"), r.accountHandler.GetAccountStats)
+
+		// Session management
+		accountGroup.GET("/:id/sessions", auth.RequirePermissionFunc("org", "manage"), r.sessionHandler.ListAccountSessions)
+		accountGroup.DELETE("/:id/sessions", auth.RequirePermissionFunc("org", "manage"), r.sessionHandler.RevokeAllAccountSessions)
+		accountGroup.DELETE("/:id/sessions/:session_id", auth.RequirePermissionFunc("org", "manage"), r.sessionHandler.RevokeAccountSession)
 	}
 }
 
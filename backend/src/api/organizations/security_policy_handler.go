@@ -0,0 +1,111 @@
+package organizations
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moasq/backend/app/organizations/app/services"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/api/response"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/logger"
+)
+
+type SecurityPolicyHandler struct {
+	securityPolicyService services.SecurityPolicyService
+	logger                logger.Logger
+}
+
+func NewSecurityPolicyHandler(
+	securityPolicyService services.SecurityPolicyService,
+	logger logger.Logger,
+) *SecurityPolicyHandler {
+	return &SecurityPolicyHandler{
+		securityPolicyService: securityPolicyService,
+		logger:                logger,
+	}
+}
+
+// GetSecurityPolicy returns the current organization's security policy.
+// @Summary Get organization security policy
+// @Description Returns the MFA requirement, allowed auth methods, and IP allowlist enforced on every request to the organization. Empty lists impose no restriction.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.SecurityPolicy
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 500 {object} map[string]any "Failed to get security policy"
+// @Router /organizations/security-policy [get]
+func (h *SecurityPolicyHandler) GetSecurityPolicy(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	policy, err := h.securityPolicyService.GetPolicy(c.Request.Context(), reqCtx.OrganizationID)
+	if err != nil {
+		h.logger.Error("failed to get security policy", map[string]any{
+			"organization_id": reqCtx.OrganizationID,
+			"error":           err.Error(),
+		})
+		response.Error(c, http.StatusInternalServerError, "failed to get security policy", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, policy)
+}
+
+// UpdateSecurityPolicy replaces the current organization's security policy.
+// @Summary Update organization security policy
+// @Description Replaces the security policy. Requests that violate it are rejected with 403 and a reason of ip_not_allowed, auth_method_not_allowed, or mfa_required. A policy that would block the caller's own session is rejected with 409.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param request body github_com_moasq_backend_app_organizations_domain.UpdateSecurityPolicyRequest true "Security policy"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.SecurityPolicy
+// @Failure 400 {object} map[string]any "Invalid security policy"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 409 {object} map[string]any "Policy would block the current session"
+// @Failure 500 {object} map[string]any "Failed to update security policy"
+// @Router /organizations/security-policy [put]
+func (h *SecurityPolicyHandler) UpdateSecurityPolicy(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	var req domain.UpdateSecurityPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request payload", err)
+		return
+	}
+
+	policy, err := h.securityPolicyService.UpdatePolicy(c.Request.Context(), reqCtx.OrganizationID, &req)
+	if err != nil {
+		var lockout *domain.SecurityPolicyLockoutError
+		switch {
+		case errors.Is(err, domain.ErrInvalidSecurityPolicy):
+			response.Error(c, http.StatusBadRequest, err.Error(), err)
+		case errors.As(err, &lockout):
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   domain.ErrSecurityPolicyLockout.Error(),
+				"reason":  lockout.Reason,
+				"success": false,
+			})
+		default:
+			h.logger.Error("failed to update security policy", map[string]any{
+				"organization_id": reqCtx.OrganizationID,
+				"error":           err.Error(),
+			})
+			response.Error(c, http.StatusInternalServerError, "failed to update security policy", err)
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, policy)
+}
@@ -0,0 +1,229 @@
+package organizations
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moasq/backend/app/organizations/app/services"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/api/response"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/logger"
+)
+
+type SessionHandler struct {
+	sessionService services.SessionService
+	logger         logger.Logger
+}
+
+func NewSessionHandler(
+	sessionService services.SessionService,
+	logger logger.Logger,
+) *SessionHandler {
+	return &SessionHandler{
+		sessionService: sessionService,
+		logger:         logger,
+	}
+}
+
+// ListMySessions lists the caller's active sessions.
+// @Summary List my sessions
+// @Description Lists the current user's active sessions. The session used for this request is flagged with current=true.
+// @Tags auth
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Success 200 {array} github_com_moasq_backend_app_organizations_domain.AuthSession
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 500 {object} map[string]any "Failed to list sessions"
+// @Router /auth/sessions [get]
+func (h *SessionHandler) ListMySessions(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	h.listSessions(c, reqCtx, reqCtx.AccountID)
+}
+
+// RevokeMySession revokes one of the caller's sessions.
+// @Summary Revoke one of my sessions
+// @Description Revokes a single session of the current user. Tokens issued for that session are rejected immediately.
+// @Tags auth
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param session_id path string true "Session ID"
+// @Success 204 "Session revoked"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 404 {object} map[string]any "Session not found"
+// @Failure 500 {object} map[string]any "Failed to revoke session"
+// @Router /auth/sessions/{session_id} [delete]
+func (h *SessionHandler) RevokeMySession(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	h.revokeSession(c, reqCtx, reqCtx.AccountID, c.Param("session_id"))
+}
+
+// RevokeAllMySessions signs the caller out everywhere.
+// @Summary Revoke all my sessions
+// @Description Revokes every session of the current user, including the one used for this request.
+// @Tags auth
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Success 204 "Sessions revoked"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 500 {object} map[string]any "Failed to revoke sessions"
+// @Router /auth/sessions [delete]
+func (h *SessionHandler) RevokeAllMySessions(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	h.revokeAllSessions(c, reqCtx, reqCtx.AccountID)
+}
+
+// ListAccountSessions lists a member's active sessions.
+// @Summary List account sessions
+// @Description Lists the active sessions of an account in the current organization. Requires org:manage.
+// @Tags accounts
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path int true "Account ID"
+// @Success 200 {array} github_com_moasq_backend_app_organizations_domain.AuthSession
+// @Failure 400 {object} map[string]any "Invalid account ID"
+// @Failure 404 {object} map[string]any "Account not found"
+// @Failure 500 {object} map[string]any "Failed to list sessions"
+// @Router /accounts/{id}/sessions [get]
+func (h *SessionHandler) ListAccountSessions(c *gin.Context) {
+	reqCtx, accountID, ok := h.accountFromPath(c)
+	if !ok {
+		return
+	}
+
+	h.listSessions(c, reqCtx, accountID)
+}
+
+// RevokeAccountSession revokes one of a member's sessions.
+// @Summary Revoke account session
+// @Description Revokes a single session of an account in the current organization. Requires org:manage.
+// @Tags accounts
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path int true "Account ID"
+// @Param session_id path string true "Session ID"
+// @Success 204 "Session revoked"
+// @Failure 400 {object} map[string]any "Invalid account ID"
+// @Failure 404 {object} map[string]any "Account or session not found"
+// @Failure 500 {object} map[string]any "Failed to revoke session"
+// @Router /accounts/{id}/sessions/{session_id} [delete]
+func (h *SessionHandler) RevokeAccountSession(c *gin.Context) {
+	reqCtx, accountID, ok := h.accountFromPath(c)
+	if !ok {
+		return
+	}
+
+	h.revokeSession(c, reqCtx, accountID, c.Param("session_id"))
+}
+
+// RevokeAllAccountSessions revokes every session of a member.
+// @Summary Revoke all account sessions
+// @Description Revokes every session and outstanding token of an account in the current organization. Requires org:manage.
+// @Tags accounts
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path int true "Account ID"
+// @Success 204 "Sessions revoked"
+// @Failure 400 {object} map[string]any "Invalid account ID"
+// @Failure 404 {object} map[string]any "Account not found"
+// @Failure 500 {object} map[string]any "Failed to revoke sessions"
+// @Router /accounts/{id}/sessions [delete]
+func (h *SessionHandler) RevokeAllAccountSessions(c *gin.Context) {
+	reqCtx, accountID, ok := h.accountFromPath(c)
+	if !ok {
+		return
+	}
+
+	h.revokeAllSessions(c, reqCtx, accountID)
+}
+
+func (h *SessionHandler) listSessions(c *gin.Context, reqCtx *auth.RequestContext, accountID int32) {
+	currentSessionID := ""
+	if reqCtx.Identity != nil && accountID == reqCtx.AccountID {
+		currentSessionID = reqCtx.Identity.SessionID
+	}
+
+	sessions, err := h.sessionService.ListAccountSessions(c.Request.Context(), reqCtx.OrganizationID, accountID, currentSessionID)
+	if err != nil {
+		h.respondError(c, "failed to list sessions", accountID, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, sessions)
+}
+
+func (h *SessionHandler) revokeSession(c *gin.Context, reqCtx *auth.RequestContext, accountID int32, sessionID string) {
+	if sessionID == "" {
+		response.Error(c, http.StatusBadRequest, "session_id is required", nil)
+		return
+	}
+
+	if err := h.sessionService.RevokeAccountSession(c.Request.Context(), reqCtx.OrganizationID, accountID, sessionID); err != nil {
+		h.respondError(c, "failed to revoke session", accountID, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *SessionHandler) revokeAllSessions(c *gin.Context, reqCtx *auth.RequestContext, accountID int32) {
+	if err := h.sessionService.RevokeAllAccountSessions(c.Request.Context(), reqCtx.OrganizationID, accountID); err != nil {
+		h.respondError(c, "failed to revoke sessions", accountID, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *SessionHandler) accountFromPath(c *gin.Context) (*auth.RequestContext, int32, bool) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusBadRequest, "organization context is required", nil)
+		return nil, 0, false
+	}
+
+	accountIDParam := c.Param("id")
+	var accountID int32
+	if _, err := fmt.Sscanf(accountIDParam, "%d", &accountID); err != nil {
+		h.logger.Error("invalid account ID", map[string]interface{}{"id": accountIDParam, "error": err.Error()})
+		response.Error(c, http.StatusBadRequest, "invalid account ID format", err)
+		return nil, 0, false
+	}
+
+	return reqCtx, accountID, true
+}
+
+func (h *SessionHandler) respondError(c *gin.Context, message string, accountID int32, err error) {
+	switch {
+	case errors.Is(err, domain.ErrAccountNotFound):
+		response.Error(c, http.StatusNotFound, "account not found", err)
+	case errors.Is(err, domain.ErrAuthSessionNotFound):
+		response.Error(c, http.StatusNotFound, "session not found", err)
+	case errors.Is(err, domain.ErrAccountNotLinkedToAuth):
+		response.Error(c, http.StatusConflict, "account is not linked to an auth member", err)
+	default:
+		h.logger.Error(message, map[string]any{
+			"account_id": accountID,
+			"error":      err.Error(),
+		})
+		response.Error(c, http.StatusInternalServerError, message, err)
+	}
+}
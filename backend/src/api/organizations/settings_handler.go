@@ -0,0 +1,102 @@
+package organizations
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moasq/backend/app/organizations/app/services"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/api/response"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/logger"
+)
+
+type SettingsHandler struct {
+	settingsService services.SettingsService
+	logger          logger.Logger
+}
+
+func NewSettingsHandler(
+	settingsService services.SettingsService,
+	logger logger.Logger,
+) *SettingsHandler {
+	return &SettingsHandler{
+		settingsService: settingsService,
+		logger:          logger,
+	}
+}
+
+// GetSettings returns the current organization's settings.
+// @Summary Get organization settings
+// @Description Returns the current organization's settings, including the flags read by authorization policies (allow_self_approval, edit_own_resources_only).
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.OrganizationSettings
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 500 {object} map[string]any "Failed to get settings"
+// @Router /organizations/settings [get]
+func (h *SettingsHandler) GetSettings(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	settings, err := h.settingsService.GetSettings(c.Request.Context(), reqCtx.OrganizationID)
+	if err != nil {
+		h.logger.Error("failed to get organization settings", map[string]any{
+			"organization_id": reqCtx.OrganizationID,
+			"error":           err.Error(),
+		})
+		response.Error(c, http.StatusInternalServerError, "failed to get settings", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, settings)
+}
+
+// UpdateSettings merges changes into the current organization's settings.
+// @Summary Update organization settings
+// @Description Merges the given keys into the organization's settings. A null value removes the key. Policy flags must be booleans.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param body body map[string]any true "Settings to change"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.OrganizationSettings
+// @Failure 400 {object} map[string]any "Invalid settings"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 500 {object} map[string]any "Failed to update settings"
+// @Router /organizations/settings [patch]
+func (h *SettingsHandler) UpdateSettings(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	var patch map[string]any
+	if err := c.ShouldBindJSON(&patch); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request payload", err)
+		return
+	}
+
+	settings, err := h.settingsService.UpdateSettings(c.Request.Context(), reqCtx.OrganizationID, patch)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidSetting) {
+			response.Error(c, http.StatusBadRequest, err.Error(), err)
+			return
+		}
+		h.logger.Error("failed to update organization settings", map[string]any{
+			"organization_id": reqCtx.OrganizationID,
+			"error":           err.Error(),
+		})
+		response.Error(c, http.StatusInternalServerError, "failed to update settings", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, settings)
+}
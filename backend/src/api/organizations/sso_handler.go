@@ -0,0 +1,289 @@
+package organizations
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moasq/backend/app/organizations/app/services"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/api/response"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/logger"
+)
+
+type SSOHandler struct {
+	ssoService services.SSOService
+	logger     logger.Logger
+}
+
+func NewSSOHandler(
+	ssoService services.SSOService,
+	logger logger.Logger,
+) *SSOHandler {
+	return &SSOHandler{
+		ssoService: ssoService,
+		logger:     logger,
+	}
+}
+
+// GetSSO returns the current organization's SSO connections and settings.
+// @Summary Get SSO settings
+// @Description Returns the organization's SAML and OIDC connections, the default connection, and whether members must log in with SSO.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.SSOSettings
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 409 {object} map[string]any "Organization is not linked to the auth provider"
+// @Failure 500 {object} map[string]any "Failed to get SSO settings"
+// @Router /organizations/sso [get]
+func (h *SSOHandler) GetSSO(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	settings, err := h.ssoService.GetSSO(c.Request.Context(), reqCtx.OrganizationID)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to get sso settings", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, settings)
+}
+
+// CreateConnection creates a SAML or OIDC connection.
+// @Summary Create SSO connection
+// @Description Creates a pending SAML or OIDC connection. Give the returned acs_url/audience_uri (SAML) or redirect_url (OIDC) to the IdP, then configure the connection.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param request body github_com_moasq_backend_app_organizations_domain.CreateSSOConnectionRequest true "Connection"
+// @Success 201 {object} github_com_moasq_backend_app_organizations_domain.SSOConnection
+// @Failure 400 {object} map[string]any "Invalid request"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 409 {object} map[string]any "Organization is not linked to the auth provider"
+// @Failure 500 {object} map[string]any "Failed to create SSO connection"
+// @Router /organizations/sso/connections [post]
+func (h *SSOHandler) CreateConnection(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	var req domain.CreateSSOConnectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request payload", err)
+		return
+	}
+
+	conn, err := h.ssoService.CreateConnection(c.Request.Context(), reqCtx.OrganizationID, &req)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to create sso connection", err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, conn)
+}
+
+// UpdateSAMLConnection configures a SAML connection.
+// @Summary Configure SAML connection
+// @Description Configures a SAML connection from the IdP metadata URL, or from the IdP entity ID, SSO URL, and signing certificate.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param connection_id path string true "Connection ID"
+// @Param request body github_com_moasq_backend_app_organizations_domain.UpdateSAMLConnectionRequest true "IdP configuration"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.SSOConnection
+// @Failure 400 {object} map[string]any "Invalid request or connection is not SAML"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 404 {object} map[string]any "Connection not found"
+// @Failure 500 {object} map[string]any "Failed to update SSO connection"
+// @Router /organizations/sso/connections/{connection_id}/saml [put]
+func (h *SSOHandler) UpdateSAMLConnection(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	var req domain.UpdateSAMLConnectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request payload", err)
+		return
+	}
+	req.ConnectionID = c.Param("connection_id")
+
+	conn, err := h.ssoService.UpdateSAMLConnection(c.Request.Context(), reqCtx.OrganizationID, &req)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to update sso connection", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, conn)
+}
+
+// UpdateOIDCConnection configures an OIDC connection.
+// @Summary Configure OIDC connection
+// @Description Configures an OIDC connection. Endpoints that are omitted are discovered from the issuer.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param connection_id path string true "Connection ID"
+// @Param request body github_com_moasq_backend_app_organizations_domain.UpdateOIDCConnectionRequest true "IdP configuration"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.SSOConnection
+// @Failure 400 {object} map[string]any "Invalid request or connection is not OIDC"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 404 {object} map[string]any "Connection not found"
+// @Failure 500 {object} map[string]any "Failed to update SSO connection"
+// @Router /organizations/sso/connections/{connection_id}/oidc [put]
+func (h *SSOHandler) UpdateOIDCConnection(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	var req domain.UpdateOIDCConnectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request payload", err)
+		return
+	}
+	req.ConnectionID = c.Param("connection_id")
+
+	conn, err := h.ssoService.UpdateOIDCConnection(c.Request.Context(), reqCtx.OrganizationID, &req)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to update sso connection", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, conn)
+}
+
+// DeleteConnection deletes an SSO connection.
+// @Summary Delete SSO connection
+// @Description Deletes an SSO connection. The default connection cannot be deleted while SSO is required.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param connection_id path string true "Connection ID"
+// @Success 200 {object} map[string]any "Connection deleted"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 404 {object} map[string]any "Connection not found"
+// @Failure 409 {object} map[string]any "Connection is required for SSO login"
+// @Failure 500 {object} map[string]any "Failed to delete SSO connection"
+// @Router /organizations/sso/connections/{connection_id} [delete]
+func (h *SSOHandler) DeleteConnection(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	if err := h.ssoService.DeleteConnection(c.Request.Context(), reqCtx.OrganizationID, c.Param("connection_id")); err != nil {
+		h.handleError(c, reqCtx, "failed to delete sso connection", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "sso connection deleted"})
+}
+
+// SetDefaultConnection sets the connection used for SSO login.
+// @Summary Set default SSO connection
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param request body github_com_moasq_backend_app_organizations_domain.SetDefaultSSOConnectionRequest true "Connection"
+// @Success 200 {object} map[string]any "Default connection set"
+// @Failure 400 {object} map[string]any "Invalid request"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 404 {object} map[string]any "Connection not found"
+// @Failure 500 {object} map[string]any "Failed to set default connection"
+// @Router /organizations/sso/default-connection [put]
+func (h *SSOHandler) SetDefaultConnection(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	var req domain.SetDefaultSSOConnectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request payload", err)
+		return
+	}
+
+	if err := h.ssoService.SetDefaultConnection(c.Request.Context(), reqCtx.OrganizationID, req.ConnectionID); err != nil {
+		h.handleError(c, reqCtx, "failed to set default sso connection", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "default sso connection set"})
+}
+
+// SetSSORequired turns the SSO login requirement on or off.
+// @Summary Require SSO login
+// @Description When required, members can only log in through SSO. Requiring SSO needs an active default connection.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param request body github_com_moasq_backend_app_organizations_domain.SetSSORequiredRequest true "Requirement"
+// @Success 200 {object} map[string]any "SSO requirement updated"
+// @Failure 400 {object} map[string]any "Invalid request"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 409 {object} map[string]any "No active default connection"
+// @Failure 500 {object} map[string]any "Failed to update SSO requirement"
+// @Router /organizations/sso/required [put]
+func (h *SSOHandler) SetSSORequired(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	var req domain.SetSSORequiredRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request payload", err)
+		return
+	}
+
+	if err := h.ssoService.SetSSORequired(c.Request.Context(), reqCtx.OrganizationID, *req.Required); err != nil {
+		h.handleError(c, reqCtx, "failed to update sso requirement", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"sso_required": *req.Required})
+}
+
+func (h *SSOHandler) handleError(c *gin.Context, reqCtx *auth.RequestContext, msg string, err error) {
+	switch {
+	case errors.Is(err, domain.ErrSSOConnectionNotFound):
+		response.Error(c, http.StatusNotFound, "sso connection not found", err)
+	case errors.Is(err, domain.ErrSSOConnectionIDRequired),
+		errors.Is(err, domain.ErrSSOInvalidConnectionType),
+		errors.Is(err, domain.ErrSSODisplayNameRequired),
+		errors.Is(err, domain.ErrSSOConnectionTypeMismatch),
+		errors.Is(err, domain.ErrSSOMetadataRequired),
+		errors.Is(err, domain.ErrSSOMetadataConflict),
+		errors.Is(err, domain.ErrSSOInvalidURL):
+		response.Error(c, http.StatusBadRequest, err.Error(), err)
+	case errors.Is(err, domain.ErrSSONoActiveConnection),
+		errors.Is(err, domain.ErrOrganizationNotLinkedToAuth):
+		response.Error(c, http.StatusConflict, err.Error(), err)
+	default:
+		h.logger.Error(msg, map[string]any{
+			"organization_id": reqCtx.OrganizationID,
+			"error":           err.Error(),
+		})
+		response.Error(c, http.StatusInternalServerError, msg, err)
+	}
+}
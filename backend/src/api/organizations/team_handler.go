@@ -0,0 +1,361 @@
+package organizations
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moasq/backend/app/organizations/app/services"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/api/response"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/logger"
+)
+
+type TeamHandler struct {
+	teamService services.TeamService
+	logger      logger.Logger
+}
+
+func NewTeamHandler(
+	teamService services.TeamService,
+	logger logger.Logger,
+) *TeamHandler {
+	return &TeamHandler{
+		teamService: teamService,
+		logger:      logger,
+	}
+}
+
+// ListTeams returns the teams the caller can see.
+// @Summary List teams
+// @Description Returns every team in the organization for organization managers, and the caller's own teams for everyone else.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Success 200 {array} github_com_moasq_backend_app_organizations_domain.Team
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 500 {object} map[string]any "Failed to list teams"
+// @Router /organizations/teams [get]
+func (h *TeamHandler) ListTeams(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	teams, err := h.teamService.ListTeams(c.Request.Context(), reqCtx.OrganizationID)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to list teams", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, teams)
+}
+
+// CreateTeam creates a team in the current organization.
+// @Summary Create team
+// @Description Creates a team. Documents and resources assigned to a team are visible only to its members and organization managers.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param request body github_com_moasq_backend_app_organizations_domain.CreateTeamRequest true "Team"
+// @Success 201 {object} github_com_moasq_backend_app_organizations_domain.Team
+// @Failure 400 {object} map[string]any "Invalid request"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 409 {object} map[string]any "Team name already taken"
+// @Failure 500 {object} map[string]any "Failed to create team"
+// @Router /organizations/teams [post]
+func (h *TeamHandler) CreateTeam(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	var req domain.CreateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request payload", err)
+		return
+	}
+
+	team, err := h.teamService.CreateTeam(c.Request.Context(), reqCtx.OrganizationID, &req)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to create team", err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, team)
+}
+
+// GetTeam returns a team.
+// @Summary Get team
+// @Description Returns a team. Members see their own teams; organization managers see every team.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path int true "Team ID"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.Team
+// @Failure 400 {object} map[string]any "Invalid team ID"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 403 {object} map[string]any "Not a member of the team"
+// @Failure 404 {object} map[string]any "Team not found"
+// @Failure 500 {object} map[string]any "Failed to get team"
+// @Router /organizations/teams/{id} [get]
+func (h *TeamHandler) GetTeam(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	teamID, ok := h.pathID(c, "id", "invalid team ID")
+	if !ok {
+		return
+	}
+
+	team, err := h.teamService.GetTeam(c.Request.Context(), reqCtx.OrganizationID, teamID)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to get team", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, team)
+}
+
+// UpdateTeam renames a team or changes its description.
+// @Summary Update team
+// @Description Updates a team's name and description. Requires org:manage or the team's lead role.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path int true "Team ID"
+// @Param request body github_com_moasq_backend_app_organizations_domain.UpdateTeamRequest true "Team"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.Team
+// @Failure 400 {object} map[string]any "Invalid request"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 403 {object} map[string]any "Not a lead of the team"
+// @Failure 404 {object} map[string]any "Team not found"
+// @Failure 409 {object} map[string]any "Team name already taken"
+// @Failure 500 {object} map[string]any "Failed to update team"
+// @Router /organizations/teams/{id} [put]
+func (h *TeamHandler) UpdateTeam(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	teamID, ok := h.pathID(c, "id", "invalid team ID")
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request payload", err)
+		return
+	}
+
+	team, err := h.teamService.UpdateTeam(c.Request.Context(), reqCtx.OrganizationID, teamID, &req)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to update team", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, team)
+}
+
+// DeleteTeam deletes a team.
+// @Summary Delete team
+// @Description Deletes a team and its memberships. Fails while documents or resources are still assigned to the team.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path int true "Team ID"
+// @Success 200 {object} map[string]any "Team deleted"
+// @Failure 400 {object} map[string]any "Invalid team ID"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 404 {object} map[string]any "Team not found"
+// @Failure 409 {object} map[string]any "Team still owns documents or resources"
+// @Failure 500 {object} map[string]any "Failed to delete team"
+// @Router /organizations/teams/{id} [delete]
+func (h *TeamHandler) DeleteTeam(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	teamID, ok := h.pathID(c, "id", "invalid team ID")
+	if !ok {
+		return
+	}
+
+	if err := h.teamService.DeleteTeam(c.Request.Context(), reqCtx.OrganizationID, teamID); err != nil {
+		h.handleError(c, reqCtx, "failed to delete team", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "team deleted"})
+}
+
+// ListTeamMembers returns a team's members.
+// @Summary List team members
+// @Description Returns the members of a team with their team roles.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path int true "Team ID"
+// @Success 200 {array} github_com_moasq_backend_app_organizations_domain.TeamMember
+// @Failure 400 {object} map[string]any "Invalid team ID"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 403 {object} map[string]any "Not a member of the team"
+// @Failure 404 {object} map[string]any "Team not found"
+// @Failure 500 {object} map[string]any "Failed to list team members"
+// @Router /organizations/teams/{id}/members [get]
+func (h *TeamHandler) ListTeamMembers(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	teamID, ok := h.pathID(c, "id", "invalid team ID")
+	if !ok {
+		return
+	}
+
+	members, err := h.teamService.ListMembers(c.Request.Context(), reqCtx.OrganizationID, teamID)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to list team members", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, members)
+}
+
+// SetTeamMember adds an account to a team or changes its team role.
+// @Summary Set team member
+// @Description Adds an organization account to the team, or updates its role (lead or member). Requires org:manage or the team's lead role.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path int true "Team ID"
+// @Param account_id path int true "Account ID"
+// @Param request body github_com_moasq_backend_app_organizations_domain.SetTeamMemberRequest true "Team role"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_domain.TeamMember
+// @Failure 400 {object} map[string]any "Invalid request"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 403 {object} map[string]any "Not a lead of the team"
+// @Failure 404 {object} map[string]any "Team or account not found"
+// @Failure 500 {object} map[string]any "Failed to set team member"
+// @Router /organizations/teams/{id}/members/{account_id} [put]
+func (h *TeamHandler) SetTeamMember(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	teamID, ok := h.pathID(c, "id", "invalid team ID")
+	if !ok {
+		return
+	}
+	accountID, ok := h.pathID(c, "account_id", "invalid account ID")
+	if !ok {
+		return
+	}
+
+	var req domain.SetTeamMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid request payload", err)
+		return
+	}
+
+	member, err := h.teamService.SetMember(c.Request.Context(), reqCtx.OrganizationID, teamID, accountID, &req)
+	if err != nil {
+		h.handleError(c, reqCtx, "failed to set team member", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, member)
+}
+
+// RemoveTeamMember removes an account from a team.
+// @Summary Remove team member
+// @Description Removes an account from the team. Requires org:manage or the team's lead role.
+// @Tags organizations
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path int true "Team ID"
+// @Param account_id path int true "Account ID"
+// @Success 200 {object} map[string]any "Team member removed"
+// @Failure 400 {object} map[string]any "Invalid ID"
+// @Failure 401 {object} map[string]any "Authentication required"
+// @Failure 403 {object} map[string]any "Not a lead of the team"
+// @Failure 404 {object} map[string]any "Team or team member not found"
+// @Failure 500 {object} map[string]any "Failed to remove team member"
+// @Router /organizations/teams/{id}/members/{account_id} [delete]
+func (h *TeamHandler) RemoveTeamMember(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil {
+		response.Error(c, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+
+	teamID, ok := h.pathID(c, "id", "invalid team ID")
+	if !ok {
+		return
+	}
+	accountID, ok := h.pathID(c, "account_id", "invalid account ID")
+	if !ok {
+		return
+	}
+
+	if err := h.teamService.RemoveMember(c.Request.Context(), reqCtx.OrganizationID, teamID, accountID); err != nil {
+		h.handleError(c, reqCtx, "failed to remove team member", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "team member removed"})
+}
+
+// pathID parses an integer path parameter, writing a 400 response when it is invalid.
+func (h *TeamHandler) pathID(c *gin.Context, param, msg string) (int32, bool) {
+	var id int32
+	if _, err := fmt.Sscanf(c.Param(param), "%d", &id); err != nil {
+		response.Error(c, http.StatusBadRequest, msg, err)
+		return 0, false
+	}
+	return id, true
+}
+
+func (h *TeamHandler) handleError(c *gin.Context, reqCtx *auth.RequestContext, msg string, err error) {
+	switch {
+	case errors.Is(err, domain.ErrTeamNotFound),
+		errors.Is(err, domain.ErrTeamMemberNotFound),
+		errors.Is(err, domain.ErrAccountNotFound):
+		response.Error(c, http.StatusNotFound, err.Error(), err)
+	case errors.Is(err, domain.ErrTeamNameRequired),
+		errors.Is(err, domain.ErrInvalidTeam),
+		errors.Is(err, domain.ErrInvalidTeamRole):
+		response.Error(c, http.StatusBadRequest, err.Error(), err)
+	case errors.Is(err, domain.ErrPermissionDenied):
+		response.Error(c, http.StatusForbidden, err.Error(), err)
+	case errors.Is(err, domain.ErrTeamNameTaken),
+		errors.Is(err, domain.ErrTeamInUse):
+		response.Error(c, http.StatusConflict, err.Error(), err)
+	default:
+		h.logger.Error(msg, map[string]any{
+			"organization_id": reqCtx.OrganizationID,
+			"error":           err.Error(),
+		})
+		response.Error(c, http.StatusInternalServerError, msg, err)
+	}
+}
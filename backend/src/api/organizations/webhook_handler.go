@@ -0,0 +1,99 @@
+package organizations
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/moasq/backend/app/organizations/app/services"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/api/response"
+	"github.com/moasq/backend/pkg/logger"
+	"github.com/moasq/backend/pkg/metrics"
+	stytchcfg "github.com/moasq/backend/pkg/stytch"
+)
+
+// maxWebhookBodyBytes caps inbound webhook payloads.
+const maxWebhookBodyBytes = 1 << 20
+
+type WebhookHandler struct {
+	webhookService services.WebhookService
+	stytchConfig   *stytchcfg.Config
+	logger         logger.Logger
+}
+
+func NewWebhookHandler(
+	webhookService services.WebhookService,
+	stytchConfig *stytchcfg.Config,
+	logger logger.Logger,
+) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		stytchConfig:   stytchConfig,
+		logger:         logger,
+	}
+}
+
+// HandleStytchWebhook receives Stytch member and organization events.
+// @Summary Stytch webhook receiver
+// @Description Verifies the Svix signature of a Stytch event webhook and syncs local accounts and organizations. Handles member created/updated/deleted and organization updated events; redelivered events are acknowledged without side effects.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param svix-id header string true "Webhook message ID"
+// @Param svix-timestamp header string true "Webhook timestamp (unix seconds)"
+// @Param svix-signature header string true "Webhook signature"
+// @Success 200 {object} github_com_moasq_backend_app_organizations_app_services.WebhookResult
+// @Failure 400 {object} map[string]any "Invalid webhook payload"
+// @Failure 401 {object} map[string]any "Invalid webhook signature"
+// @Failure 500 {object} map[string]any "Failed to process webhook"
+// @Failure 503 {object} map[string]any "Webhook secret not configured"
+// @Router /webhooks/stytch [post]
+func (h *WebhookHandler) HandleStytchWebhook(c *gin.Context) {
+	if h.stytchConfig.WebhookSecret == "" {
+		h.logger.Error("stytch webhook received but STYTCH_WEBHOOK_SECRET is not set", nil)
+		response.Error(c, http.StatusServiceUnavailable, "webhook receiver is not configured", nil)
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookBodyBytes))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "failed to read webhook payload", err)
+		return
+	}
+
+	if err := stytchcfg.VerifyWebhookSignature(
+		h.stytchConfig.WebhookSecret,
+		c.GetHeader(stytchcfg.WebhookIDHeader),
+		c.GetHeader(stytchcfg.WebhookTimestampHeader),
+		payload,
+		c.GetHeader(stytchcfg.WebhookSignatureHeader),
+		stytchcfg.DefaultWebhookTolerance,
+	); err != nil {
+		h.logger.Warn("stytch webhook signature rejected", map[string]any{
+			"webhook_id": c.GetHeader(stytchcfg.WebhookIDHeader),
+			"error":      err.Error(),
+		})
+		metrics.ObserveWebhook(services.WebhookMetricsProvider, "other", metrics.WebhookRejected)
+		response.Error(c, http.StatusUnauthorized, "invalid webhook signature", err)
+		return
+	}
+
+	result, err := h.webhookService.ProcessAuthWebhook(c.Request.Context(), payload)
+	if err != nil {
+		if errors.Is(err, domain.ErrAuthWebhookPayloadInvalid) {
+			response.Error(c, http.StatusBadRequest, "invalid webhook payload", err)
+			return
+		}
+		h.logger.Error("failed to process stytch webhook", map[string]any{
+			"webhook_id": c.GetHeader(stytchcfg.WebhookIDHeader),
+			"error":      err.Error(),
+		})
+		response.Error(c, http.StatusInternalServerError, "failed to process webhook", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, result)
+}
@@ -3,6 +3,7 @@ package api
 import (
 	cognitiveAPI "github.com/moasq/backend/api/example_cognitive"
 	documentsAPI "github.com/moasq/backend/api/example_documents"
+	ocrAPI "github.com/moasq/backend/api/ocr"
 	organizations "github.com/moasq/backend/api/organizations"
 	rbacAPI "github.com/moasq/backend/api/rbac"
 	subscriptionsAPI "github.com/moasq/backend/api/subscriptions"
@@ -16,12 +17,14 @@ import (
 // 3. BillingHandler - Handles billing status and subscription routes (uses app/billing module)
 // 4. DocumentsRoutes - Handles PDF document upload and management routes
 // 5. CognitiveRoutes - Handles AI/RAG chat and document search routes
+// 6. OCRRoutes - Handles asynchronous OCR job routes
 type moduleRoutes struct {
 	OrganizationRoutes  *organizations.Routes
 	RbacRoutes          *rbacAPI.Routes
 	SubscriptionHandler *subscriptionsAPI.Handler
 	DocumentsRoutes     *documentsAPI.Routes
 	CognitiveRoutes     *cognitiveAPI.Routes
+	OCRRoutes           *ocrAPI.Routes
 }
 
 // 1. Sets up all module dependencies
@@ -51,6 +54,7 @@ func registerAPI(container *dig.Container) error {
 		subscriptionHandler *subscriptionsAPI.Handler,
 		documentsRoutes *documentsAPI.Routes,
 		cognitiveRoutes *cognitiveAPI.Routes,
+		ocrRoutes *ocrAPI.Routes,
 	) *moduleRoutes {
 		return &moduleRoutes{
 			OrganizationRoutes:  organizationRoutes,
@@ -58,6 +62,7 @@ func registerAPI(container *dig.Container) error {
 			SubscriptionHandler: subscriptionHandler,
 			DocumentsRoutes:     documentsRoutes,
 			CognitiveRoutes:     cognitiveRoutes,
+			OCRRoutes:           ocrRoutes,
 		}
 	}); err != nil {
 		return err
@@ -74,6 +79,7 @@ func registerAPI(container *dig.Container) error {
 		srv.RegisterRoutes(modules.SubscriptionHandler.Routes, server.ApiPrefix)
 		srv.RegisterRoutes(modules.DocumentsRoutes.Routes, server.ApiPrefix)
 		srv.RegisterRoutes(modules.CognitiveRoutes.Routes, server.ApiPrefix)
+		srv.RegisterRoutes(modules.OCRRoutes.Routes, server.ApiPrefix)
 	})
 }
 
@@ -83,6 +89,7 @@ func registerAPI(container *dig.Container) error {
 // 3. Billing API - subscription and billing management (handler uses app/billing module)
 // 4. Documents API - PDF document upload and management
 // 5. Cognitive API - AI/RAG chat and document search
+// 6. OCR API - asynchronous OCR jobs
 func setupDependencies(container *dig.Container) error {
 	if err := organizations.NewProvider(container).RegisterDependencies(); err != nil {
 		return err
@@ -108,5 +115,10 @@ func setupDependencies(container *dig.Container) error {
 		return err
 	}
 
+	// Initialize OCR API (asynchronous OCR jobs)
+	if err := ocrAPI.NewProvider(container).RegisterDependencies(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -13,11 +13,13 @@ import (
 // Handler handles RBAC API endpoints
 type Handler struct {
 	service auth.RBACService
+	checker *auth.AccessChecker
 }
 
-func NewHandler(service auth.RBACService) *Handler {
+func NewHandler(service auth.RBACService, checker *auth.AccessChecker) *Handler {
 	return &Handler{
 		service: service,
+		checker: checker,
 	}
 }
 
@@ -150,6 +152,65 @@ func (h *Handler) CheckPermission(c *gin.Context) {
 	})
 }
 
+// GetMyPermissions godoc
+// @Summary Get the caller's effective permissions
+// @Description Returns the roles and fully resolved permissions of the authenticated user, with wildcards and role-derived permissions expanded. Use this to drive UI visibility with a single call.
+// @Tags RBAC
+// @Produce json
+// @Success 200 {object} github_com_moasq_backend_pkg_auth.EffectivePermissionsResponse "Effective roles and permissions"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Router /rbac/me [get]
+func (h *Handler) GetMyPermissions(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil || reqCtx.Identity == nil {
+		response.Error(c, http.StatusUnauthorized, "unauthorized", nil)
+		return
+	}
+
+	roles := make([]string, len(reqCtx.Identity.Roles))
+	for i, role := range reqCtx.Identity.Roles {
+		roles[i] = string(role)
+	}
+
+	response.Success(c, http.StatusOK, auth.EffectivePermissionsResponse{
+		UserID:         reqCtx.Identity.UserID,
+		Email:          reqCtx.Identity.Email,
+		OrganizationID: reqCtx.OrganizationID,
+		AccountID:      reqCtx.AccountID,
+		Roles:          roles,
+		Permissions:    auth.PermissionsToStrings(auth.EffectivePermissions(reqCtx.Identity)),
+	})
+}
+
+// CheckPermissions godoc
+// @Summary Check a batch of permissions for the caller
+// @Description Evaluates up to 100 permission checks in one call. Each check may target a resource instance (resource_type + resource_id) and may require the caller to be its owner or approval assignee (relation). Results are returned in request order with a reason when denied.
+// @Tags RBAC
+// @Accept json
+// @Produce json
+// @Param body body github_com_moasq_backend_pkg_auth.BatchPermissionCheckRequest true "Permission checks"
+// @Success 200 {object} github_com_moasq_backend_pkg_auth.BatchPermissionCheckResponse "Check results"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Router /rbac/check [post]
+func (h *Handler) CheckPermissions(c *gin.Context) {
+	reqCtx := auth.GetRequestContext(c)
+	if reqCtx == nil || reqCtx.Identity == nil {
+		response.Error(c, http.StatusUnauthorized, "unauthorized", nil)
+		return
+	}
+
+	var req auth.BatchPermissionCheckRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "invalid_request", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, auth.BatchPermissionCheckResponse{
+		Results: h.checker.CheckAll(c.Request.Context(), reqCtx, req.Checks),
+	})
+}
+
 // GetMetadata godoc
 // @Summary Get RBAC system metadata
 // @Description Returns summary information about the RBAC system including total roles, permissions, and categories.
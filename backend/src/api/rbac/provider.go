@@ -27,9 +27,16 @@ func (p *Provider) RegisterDependencies() error {
 		return fmt.Errorf("failed to provide rbac service: %w", err)
 	}
 
+	// Provide access checker for caller-scoped and resource-instance checks
+	if err := p.container.Provide(func(resources *auth.ResourceRegistry, policies *auth.PolicyEngine) *auth.AccessChecker {
+		return auth.NewAccessChecker(resources, policies)
+	}); err != nil {
+		return fmt.Errorf("failed to provide access checker: %w", err)
+	}
+
 	// Provide RBAC Handler
-	if err := p.container.Provide(func(service auth.RBACService) *Handler {
-		return NewHandler(service)
+	if err := p.container.Provide(func(service auth.RBACService, checker *auth.AccessChecker) *Handler {
+		return NewHandler(service, checker)
 	}); err != nil {
 		return fmt.Errorf("failed to provide rbac handler: %w", err)
 	}
@@ -18,8 +18,9 @@ func NewRoutes(handler *Handler) *Routes {
 }
 
 // RegisterRoutes registers RBAC routes on the router
-// Note: RBAC endpoints are public and do NOT require authentication
-// These endpoints are used by frontend for role/permission discovery
+// Note: RBAC discovery endpoints are public and do NOT require authentication
+// These endpoints are used by frontend for role/permission discovery.
+// /rbac/me and /rbac/check evaluate the caller and require authentication.
 func (r *Routes) RegisterRoutes(router *gin.RouterGroup, resolver serverDomain.MiddlewareResolver) {
 	// RBAC info endpoints - NO authentication required for role/permission discovery
 	rbacGroup := router.Group("/rbac")
@@ -54,6 +55,25 @@ func (r *Routes) RegisterRoutes(router *gin.RouterGroup, resolver serverDomain.M
 		rbacGroup.GET("/metadata",
 			r.handler.GetMetadata)
 	}
+
+	// Caller-scoped endpoints - require authentication and organization context
+	callerGroup := router.Group("/rbac")
+	callerGroup.Use(
+		resolver.Get("auth"),
+		resolver.Get("org_context"),
+		resolver.Get("rate_limit"),
+	)
+	{
+		// Get the caller's resolved roles and permissions
+		// GET /api/rbac/me
+		callerGroup.GET("/me",
+			r.handler.GetMyPermissions)
+
+		// Evaluate a batch of permission checks, including resource-instance checks
+		// POST /api/rbac/check
+		callerGroup.POST("/check",
+			r.handler.CheckPermissions)
+	}
 }
 
 // Routes satisfies the RouteRegistrar interface
@@ -14,6 +14,7 @@ func (h *Handler) Routes(router *gin.RouterGroup, resolver serverDomain.Middlewa
 	subscriptions.Use(
 		resolver.Get("auth"),
 		resolver.Get("org_context"),
+		resolver.Get("rate_limit"),
 	)
 	{
 		// Get billing status - requires resource:view permission
@@ -0,0 +1,23 @@
+package services
+
+import (
+	"context"
+
+	"github.com/moasq/backend/app/audit/domain"
+)
+
+// AuditService records audit events and serves the organization audit log.
+type AuditService interface {
+	domain.Recorder
+
+	// ListEvents returns a page of events matching filter, newest first.
+	ListEvents(ctx context.Context, filter domain.Filter) (*domain.EventPage, error)
+
+	// ExportEvents streams every event matching filter, newest first, to fn.
+	// Filter.Limit is ignored; events are read in batches.
+	ExportEvents(ctx context.Context, filter domain.Filter, fn func(*domain.Event) error) error
+
+	// VerifyChain recomputes the organization's hash chain and reports the
+	// first event whose hash or link does not match.
+	VerifyChain(ctx context.Context, orgID int32) (*domain.ChainVerification, error)
+}
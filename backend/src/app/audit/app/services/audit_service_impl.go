@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/moasq/backend/app/audit/domain"
+	"github.com/moasq/backend/pkg/eventbus"
+	loggerDomain "github.com/moasq/backend/pkg/logger"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
+	batchSize        = 500
+)
+
+type auditService struct {
+	repo   domain.Repository
+	logger loggerDomain.Logger
+}
+
+func NewAuditService(repo domain.Repository, logger loggerDomain.Logger) AuditService {
+	return &auditService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *auditService) Record(ctx context.Context, entry domain.Entry) error {
+	if entry.OrganizationID == 0 {
+		return domain.ErrOrganizationRequired
+	}
+	if entry.Action == "" {
+		return domain.ErrActionRequired
+	}
+
+	actor := domain.ActorFromContext(ctx)
+	if entry.Actor != nil {
+		actor = *entry.Actor
+	}
+
+	event := &domain.Event{
+		OrganizationID: entry.OrganizationID,
+		OccurredAt:     time.Now(),
+		ActorType:      actor.Type,
+		ActorEmail:     actor.Email,
+		Action:         entry.Action,
+		TargetType:     entry.TargetType,
+		TargetID:       entry.TargetID,
+		IPAddress:      actor.IPAddress,
+		UserAgent:      actor.UserAgent,
+		RequestID:      actor.RequestID,
+		Changes:        entry.Changes,
+		Metadata:       entry.Metadata,
+	}
+	if actor.AccountID != 0 {
+		accountID := actor.AccountID
+		event.ActorAccountID = &accountID
+	}
+
+	// The audited action has already happened; a cancelled request must not drop its record.
+	if _, err := s.repo.Append(context.WithoutCancel(ctx), event); err != nil {
+		s.logger.Error("failed to record audit event", loggerDomain.Fields{
+			"organization_id": entry.OrganizationID,
+			"action":          entry.Action,
+			"error":           err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+func (s *auditService) HandleEvent(ctx context.Context, event eventbus.Event) error {
+	auditable, ok := event.(domain.Auditable)
+	if !ok {
+		return nil
+	}
+	entry, ok := auditable.AuditEntry()
+	if !ok {
+		return nil
+	}
+	return s.Record(ctx, entry)
+}
+
+func (s *auditService) ListEvents(ctx context.Context, filter domain.Filter) (*domain.EventPage, error) {
+	filter, err := normalizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultPageLimit
+	case filter.Limit > maxPageLimit:
+		filter.Limit = maxPageLimit
+	}
+
+	events, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.EventPage{Events: events}
+	if len(events) == int(filter.Limit) {
+		page.NextCursor = events[len(events)-1].Sequence
+	}
+	return page, nil
+}
+
+func (s *auditService) ExportEvents(ctx context.Context, filter domain.Filter, fn func(*domain.Event) error) error {
+	filter, err := normalizeFilter(filter)
+	if err != nil {
+		return err
+	}
+	filter.Limit = batchSize
+
+	for {
+		events, err := s.repo.List(ctx, filter)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+		if len(events) < batchSize {
+			return nil
+		}
+		filter.BeforeSequence = events[len(events)-1].Sequence
+	}
+}
+
+func (s *auditService) VerifyChain(ctx context.Context, orgID int32) (*domain.ChainVerification, error) {
+	result := &domain.ChainVerification{OrganizationID: orgID, Valid: true}
+	prevHash, sequence := domain.GenesisHash, int64(0)
+
+	for {
+		events, err := s.repo.ListChain(ctx, orgID, sequence, batchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range events {
+			hash, err := domain.ComputeHash(event)
+			if err != nil {
+				return nil, err
+			}
+			if event.Sequence != sequence+1 || event.PrevHash != prevHash || event.Hash != hash {
+				broken := sequence + 1
+				result.Valid = false
+				result.FirstBrokenSequence = &broken
+
+				s.logger.Warn("audit chain verification failed", loggerDomain.Fields{
+					"organization_id": orgID,
+					"sequence":        broken,
+				})
+				return result, nil
+			}
+
+			result.Checked++
+			prevHash, sequence = event.Hash, event.Sequence
+		}
+
+		if len(events) < batchSize {
+			return result, nil
+		}
+	}
+}
+
+// normalizeFilter validates filter and converts the action pattern to a LIKE pattern.
+func normalizeFilter(filter domain.Filter) (domain.Filter, error) {
+	if filter.OrganizationID == 0 {
+		return filter, domain.ErrOrganizationRequired
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, domain.ErrInvalidFilter
+	}
+
+	if filter.Action != "" {
+		prefix, wildcard := strings.CutSuffix(filter.Action, "*")
+		pattern := likeEscaper.Replace(prefix)
+		if wildcard {
+			pattern += "%"
+		}
+		filter.Action = pattern
+	}
+	return filter, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
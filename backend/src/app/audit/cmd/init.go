@@ -0,0 +1,12 @@
+package cmd
+
+import (
+	"go.uber.org/dig"
+
+	"github.com/moasq/backend/app/audit"
+)
+
+// Init registers the audit log module.
+func Init(container *dig.Container) error {
+	return audit.NewModule(container).RegisterDependencies()
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GenesisHash is the PrevHash of an organization's first audit event.
+var GenesisHash = strings.Repeat("0", 64)
+
+// hashedEvent is the canonical form of an event that is hashed. Field order is
+// fixed; changing it invalidates every existing chain.
+type hashedEvent struct {
+	OrganizationID int32           `json:"organization_id"`
+	Sequence       int64           `json:"sequence"`
+	OccurredAt     string          `json:"occurred_at"`
+	ActorType      string          `json:"actor_type"`
+	ActorAccountID *int32          `json:"actor_account_id"`
+	ActorEmail     string          `json:"actor_email"`
+	Action         string          `json:"action"`
+	TargetType     string          `json:"target_type"`
+	TargetID       string          `json:"target_id"`
+	IPAddress      string          `json:"ip_address"`
+	UserAgent      string          `json:"user_agent"`
+	RequestID      string          `json:"request_id"`
+	Changes        json.RawMessage `json:"changes"`
+	Metadata       json.RawMessage `json:"metadata"`
+	PrevHash       string          `json:"prev_hash"`
+}
+
+// ComputeHash returns the SHA-256 hash (hex) of the event's content and PrevHash.
+//
+// The hash depends only on values that survive a database round trip, so an
+// event read back from storage hashes to the value computed before insert.
+func ComputeHash(e *Event) (string, error) {
+	changes, err := CanonicalJSON(e.Changes)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audit changes: %w", err)
+	}
+	metadata, err := CanonicalJSON(e.Metadata)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audit metadata: %w", err)
+	}
+
+	data, err := json.Marshal(hashedEvent{
+		OrganizationID: e.OrganizationID,
+		Sequence:       e.Sequence,
+		OccurredAt:     NormalizeTime(e.OccurredAt).Format(time.RFC3339Nano),
+		ActorType:      e.ActorType,
+		ActorAccountID: e.ActorAccountID,
+		ActorEmail:     e.ActorEmail,
+		Action:         e.Action,
+		TargetType:     e.TargetType,
+		TargetID:       e.TargetID,
+		IPAddress:      e.IPAddress,
+		UserAgent:      e.UserAgent,
+		RequestID:      e.RequestID,
+		Changes:        changes,
+		Metadata:       metadata,
+		PrevHash:       e.PrevHash,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audit event: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// CanonicalJSON encodes v with sorted object keys and numbers kept verbatim.
+// A nil or empty value encodes as an empty object.
+func CanonicalJSON(v any) (json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var generic any
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	if generic == nil {
+		return json.RawMessage("{}"), nil
+	}
+	return json.Marshal(generic)
+}
+
+// NormalizeTime truncates t to the database's microsecond precision in UTC.
+func NormalizeTime(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Microsecond)
+}
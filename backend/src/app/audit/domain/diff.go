@@ -0,0 +1,33 @@
+package domain
+
+import "reflect"
+
+// Diff returns the fields whose values differ between before and after.
+// Fields present on only one side are reported with a nil counterpart.
+func Diff(before, after map[string]any) map[string]Change {
+	changes := make(map[string]Change)
+	for key, oldValue := range before {
+		newValue, ok := after[key]
+		if !ok {
+			changes[key] = Change{Before: oldValue}
+			continue
+		}
+		if !reflect.DeepEqual(oldValue, newValue) {
+			changes[key] = Change{Before: oldValue, After: newValue}
+		}
+	}
+	for key, newValue := range after {
+		if _, ok := before[key]; !ok {
+			changes[key] = Change{After: newValue}
+		}
+	}
+	return changes
+}
+
+// FieldChange returns a single-field change set, or nil when the values are equal.
+func FieldChange(field string, before, after any) map[string]Change {
+	if reflect.DeepEqual(before, after) {
+		return nil
+	}
+	return map[string]Change{field: {Before: before, After: after}}
+}
@@ -0,0 +1,91 @@
+package domain
+
+import "time"
+
+// Actor types recorded on audit events
+const (
+	ActorUser    = "user"
+	ActorSystem  = "system"
+	ActorWebhook = "webhook"
+)
+
+// Actor identifies who performed an audited action and from where.
+type Actor struct {
+	Type      string `json:"type"`
+	AccountID int32  `json:"account_id,omitempty"`
+	Email     string `json:"email,omitempty"`
+	IPAddress string `json:"ip_address,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// Change holds the before and after value of a changed field.
+type Change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// Entry is an audited action to be recorded.
+//
+// Actor is optional; when nil it is taken from the context (see ActorFromContext).
+type Entry struct {
+	OrganizationID int32
+	Action         string
+	TargetType     string
+	TargetID       string
+	Actor          *Actor
+	Changes        map[string]Change
+	Metadata       map[string]any
+}
+
+// Event is a recorded audit event. Events are append-only and chained per
+// organization: Hash covers the event's content and PrevHash, the hash of
+// the organization's previous event.
+type Event struct {
+	ID             int64             `json:"id"`
+	OrganizationID int32             `json:"organization_id"`
+	Sequence       int64             `json:"sequence"`
+	OccurredAt     time.Time         `json:"occurred_at"`
+	ActorType      string            `json:"actor_type"`
+	ActorAccountID *int32            `json:"actor_account_id,omitempty"`
+	ActorEmail     string            `json:"actor_email,omitempty"`
+	Action         string            `json:"action"`
+	TargetType     string            `json:"target_type,omitempty"`
+	TargetID       string            `json:"target_id,omitempty"`
+	IPAddress      string            `json:"ip_address,omitempty"`
+	UserAgent      string            `json:"user_agent,omitempty"`
+	RequestID      string            `json:"request_id,omitempty"`
+	Changes        map[string]Change `json:"changes"`
+	Metadata       map[string]any    `json:"metadata"`
+	PrevHash       string            `json:"prev_hash"`
+	Hash           string            `json:"hash"`
+}
+
+// Filter narrows an audit log query. Zero values are ignored.
+type Filter struct {
+	OrganizationID int32
+	// Action matches exactly, or by prefix when it ends in "*" (e.g. "member.*").
+	Action         string
+	ActorAccountID int32
+	TargetType     string
+	TargetID       string
+	From           *time.Time
+	To             *time.Time
+	// BeforeSequence returns events older than the given sequence (pagination cursor).
+	BeforeSequence int64
+	Limit          int32
+}
+
+// EventPage is a page of audit events, newest first.
+type EventPage struct {
+	Events     []*Event `json:"events"`
+	NextCursor int64    `json:"next_cursor,omitempty"`
+}
+
+// ChainVerification is the result of re-computing an organization's hash chain.
+type ChainVerification struct {
+	OrganizationID      int32  `json:"organization_id"`
+	Valid               bool   `json:"valid"`
+	Checked             int64  `json:"checked"`
+	FirstBrokenSequence *int64 `json:"first_broken_sequence,omitempty"`
+}
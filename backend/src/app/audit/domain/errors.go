@@ -0,0 +1,10 @@
+package domain
+
+import "errors"
+
+// Audit errors
+var (
+	ErrOrganizationRequired = errors.New("audit event organization is required")
+	ErrActionRequired       = errors.New("audit event action is required")
+	ErrInvalidFilter        = errors.New("invalid audit log filter")
+)
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/eventbus"
+)
+
+// Recorder writes audit events.
+//
+// Services call Record directly for actions that publish no event. Modules
+// that publish Auditable events subscribe HandleEvent to them instead.
+type Recorder interface {
+	// Record appends entry to the organization's audit log.
+	Record(ctx context.Context, entry Entry) error
+	// HandleEvent records events that implement Auditable and ignores the rest.
+	HandleEvent(ctx context.Context, event eventbus.Event) error
+}
+
+// Auditable is implemented by domain events that belong in the audit log.
+type Auditable interface {
+	// AuditEntry returns the entry to record, or false to skip the event.
+	AuditEntry() (Entry, bool)
+}
+
+type actorContextKey struct{}
+
+// WithActor overrides the actor recorded for audit events created with ctx.
+// Webhook and background handlers use it to attribute their changes.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the actor set with WithActor, else the
+// authenticated caller from the auth request context, else the system actor.
+func ActorFromContext(ctx context.Context) Actor {
+	if actor, ok := ctx.Value(actorContextKey{}).(Actor); ok {
+		return actor
+	}
+
+	if reqCtx := auth.RequestContextFromContext(ctx); reqCtx != nil {
+		actor := Actor{
+			Type:      ActorUser,
+			AccountID: reqCtx.AccountID,
+			IPAddress: reqCtx.ClientIP,
+			UserAgent: reqCtx.UserAgent,
+			RequestID: reqCtx.RequestID,
+		}
+		if reqCtx.Identity != nil {
+			actor.Email = reqCtx.Identity.Email
+		}
+		return actor
+	}
+
+	return Actor{Type: ActorSystem}
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "context"
+
+// Repository persists audit events
+type Repository interface {
+	// Append chains event onto the organization's log. Sequence, PrevHash, and
+	// Hash are assigned by the repository; the stored event is returned.
+	Append(ctx context.Context, event *Event) (*Event, error)
+	// List returns events matching filter, newest first.
+	List(ctx context.Context, filter Filter) ([]*Event, error)
+	// ListChain returns up to limit events after sequence afterSequence, oldest first.
+	ListChain(ctx context.Context, orgID int32, afterSequence int64, limit int32) ([]*Event, error)
+}
@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/moasq/backend/app/audit/domain"
+	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/db/postgres"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+type auditRepository struct {
+	auditStore adapters.AuditStore
+}
+
+func NewAuditRepository(auditStore adapters.AuditStore) domain.Repository {
+	return &auditRepository{
+		auditStore: auditStore,
+	}
+}
+
+func (r *auditRepository) Append(ctx context.Context, event *domain.Event) (*domain.Event, error) {
+	changes, err := domain.CanonicalJSON(event.Changes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit changes: %w", err)
+	}
+	metadata, err := domain.CanonicalJSON(event.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit metadata: %w", err)
+	}
+
+	pending := *event
+	pending.OccurredAt = domain.NormalizeTime(event.OccurredAt)
+
+	result, err := r.auditStore.AppendAuditEvent(ctx, event.OrganizationID, domain.GenesisHash, func(prevHash string, sequence int64) (sqlc.InsertAuditEventParams, error) {
+		pending.PrevHash = prevHash
+		pending.Sequence = sequence
+		hash, err := domain.ComputeHash(&pending)
+		if err != nil {
+			return sqlc.InsertAuditEventParams{}, err
+		}
+
+		return sqlc.InsertAuditEventParams{
+			OrganizationID: pending.OrganizationID,
+			Sequence:       sequence,
+			OccurredAt:     postgres.PgTimestamptz(&pending.OccurredAt),
+			ActorType:      pending.ActorType,
+			ActorAccountID: postgres.PgInt4(pending.ActorAccountID),
+			ActorEmail:     postgres.PgTextFromString(pending.ActorEmail),
+			Action:         pending.Action,
+			TargetType:     postgres.PgTextFromString(pending.TargetType),
+			TargetID:       postgres.PgTextFromString(pending.TargetID),
+			IpAddress:      postgres.PgTextFromString(pending.IPAddress),
+			UserAgent:      postgres.PgTextFromString(pending.UserAgent),
+			RequestID:      postgres.PgTextFromString(pending.RequestID),
+			Changes:        changes,
+			Metadata:       metadata,
+			PrevHash:       prevHash,
+			Hash:           hash,
+		}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to append audit event: %w", err)
+	}
+
+	return r.mapToDomainEvent(&result)
+}
+
+func (r *auditRepository) List(ctx context.Context, filter domain.Filter) ([]*domain.Event, error) {
+	params := sqlc.ListAuditEventsParams{
+		OrganizationID: filter.OrganizationID,
+		Action:         postgres.PgTextFromString(filter.Action),
+		TargetType:     postgres.PgTextFromString(filter.TargetType),
+		TargetID:       postgres.PgTextFromString(filter.TargetID),
+		OccurredFrom:   postgres.PgTimestamptz(filter.From),
+		OccurredTo:     postgres.PgTimestamptz(filter.To),
+		PageLimit:      filter.Limit,
+	}
+	if filter.ActorAccountID != 0 {
+		params.ActorAccountID = postgres.PgInt4FromInt32(filter.ActorAccountID)
+	}
+	if filter.BeforeSequence > 0 {
+		params.BeforeSequence = pgtype.Int8{Int64: filter.BeforeSequence, Valid: true}
+	}
+
+	rows, err := r.auditStore.ListAuditEvents(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	return r.mapToDomainEvents(rows)
+}
+
+func (r *auditRepository) ListChain(ctx context.Context, orgID int32, afterSequence int64, limit int32) ([]*domain.Event, error) {
+	rows, err := r.auditStore.ListAuditEventChain(ctx, sqlc.ListAuditEventChainParams{
+		OrganizationID: orgID,
+		Sequence:       afterSequence,
+		Limit:          limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit chain: %w", err)
+	}
+
+	return r.mapToDomainEvents(rows)
+}
+
+func (r *auditRepository) mapToDomainEvents(rows []sqlc.AuditEvent) ([]*domain.Event, error) {
+	events := make([]*domain.Event, 0, len(rows))
+	for i := range rows {
+		event, err := r.mapToDomainEvent(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (r *auditRepository) mapToDomainEvent(row *sqlc.AuditEvent) (*domain.Event, error) {
+	// Numbers are decoded verbatim so the event re-hashes to its stored hash.
+	changes := map[string]domain.Change{}
+	if err := decodeJSON(row.Changes, &changes); err != nil {
+		return nil, fmt.Errorf("failed to decode audit changes: %w", err)
+	}
+	metadata := map[string]any{}
+	if err := decodeJSON(row.Metadata, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to decode audit metadata: %w", err)
+	}
+
+	var occurredAt time.Time
+	if row.OccurredAt.Valid {
+		occurredAt = row.OccurredAt.Time.UTC()
+	}
+
+	return &domain.Event{
+		ID:             row.ID,
+		OrganizationID: row.OrganizationID,
+		Sequence:       row.Sequence,
+		OccurredAt:     occurredAt,
+		ActorType:      row.ActorType,
+		ActorAccountID: postgres.Int32Ptr(row.ActorAccountID),
+		ActorEmail:     postgres.StringFromPgText(row.ActorEmail),
+		Action:         row.Action,
+		TargetType:     postgres.StringFromPgText(row.TargetType),
+		TargetID:       postgres.StringFromPgText(row.TargetID),
+		IPAddress:      postgres.StringFromPgText(row.IpAddress),
+		UserAgent:      postgres.StringFromPgText(row.UserAgent),
+		RequestID:      postgres.StringFromPgText(row.RequestID),
+		Changes:        changes,
+		Metadata:       metadata,
+		PrevHash:       row.PrevHash,
+		Hash:           row.Hash,
+	}, nil
+}
+
+func decodeJSON(data []byte, v any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
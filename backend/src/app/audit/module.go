@@ -0,0 +1,54 @@
+package audit
+
+import (
+	"go.uber.org/dig"
+
+	"github.com/moasq/backend/app/audit/app/services"
+	"github.com/moasq/backend/app/audit/domain"
+	"github.com/moasq/backend/app/audit/infra/repositories"
+	"github.com/moasq/backend/pkg/db/adapters"
+	loggerDomain "github.com/moasq/backend/pkg/logger/domain"
+)
+
+// Module provides audit log dependencies
+type Module struct {
+	container *dig.Container
+}
+
+func NewModule(container *dig.Container) *Module {
+	return &Module{
+		container: container,
+	}
+}
+
+// RegisterDependencies registers all audit module dependencies.
+//
+// Other modules depend on domain.Recorder, so this module must be
+// registered before them.
+func (m *Module) RegisterDependencies() error {
+	if err := m.container.Provide(func(
+		auditStore adapters.AuditStore,
+	) domain.Repository {
+		return repositories.NewAuditRepository(auditStore)
+	}); err != nil {
+		return err
+	}
+
+	if err := m.container.Provide(func(
+		repo domain.Repository,
+		logger loggerDomain.Logger,
+	) services.AuditService {
+		return services.NewAuditService(repo, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Recorder used by other modules' service hooks and event subscriptions
+	if err := m.container.Provide(func(service services.AuditService) domain.Recorder {
+		return service
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
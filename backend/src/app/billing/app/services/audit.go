@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	audit "github.com/moasq/backend/app/audit/domain"
+	"github.com/moasq/backend/app/billing/domain"
+)
+
+// Audit actions recorded for subscription changes
+const (
+	auditActionSubscriptionUpdated  = "billing.subscription_updated"
+	auditActionSubscriptionCanceled = "billing.subscription_canceled"
+
+	auditTargetSubscription = "subscription"
+)
+
+// subscriptionAuditFields lists the subscription fields compared in audit diffs.
+func subscriptionAuditFields(sub *domain.Subscription) map[string]any {
+	if sub == nil {
+		return map[string]any{}
+	}
+	fields := map[string]any{
+		"status":               sub.SubscriptionStatus,
+		"product_id":           sub.ProductID,
+		"product_name":         sub.ProductName,
+		"cancel_at_period_end": sub.CancelAtPeriodEnd,
+		"current_period_end":   sub.CurrentPeriodEnd.UTC().Format(time.RFC3339),
+	}
+	if sub.CanceledAt != nil {
+		fields["canceled_at"] = sub.CanceledAt.UTC().Format(time.RFC3339)
+	}
+	return fields
+}
+
+// recordSubscriptionChange audits a subscription change. previous is the
+// stored subscription before the change, or nil for a new subscription.
+// Failures are logged: the change has already been applied.
+func (s *billingService) recordSubscriptionChange(ctx context.Context, action string, previous, current *domain.Subscription) {
+	changes := audit.Diff(subscriptionAuditFields(previous), subscriptionAuditFields(current))
+	if len(changes) == 0 {
+		return
+	}
+
+	if err := s.auditRecorder.Record(ctx, audit.Entry{
+		OrganizationID: current.OrganizationID,
+		Action:         action,
+		TargetType:     auditTargetSubscription,
+		TargetID:       current.SubscriptionID,
+		Changes:        changes,
+	}); err != nil {
+		s.logger.Warn("Failed to record subscription audit entry", map[string]any{
+			"organization_id": current.OrganizationID,
+			"action":          action,
+			"error":           err.Error(),
+		})
+	}
+}
+
+// previousSubscription returns the stored subscription for the audit diff, or nil.
+func (s *billingService) previousSubscription(ctx context.Context, organizationID int32) *domain.Subscription {
+	previous, err := s.repo.GetSubscriptionByOrgID(ctx, organizationID)
+	if err != nil {
+		return nil
+	}
+	return previous
+}
@@ -11,7 +11,9 @@ import (
 // CheckQuotaAvailability performs a read-only verification of quota availability
 // This method does NOT consume quota - it only checks if processing is allowed
 // Use ConsumeInvoiceQuota after successful invoice processing to actually decrement the quota
-func (s *billingService) CheckQuotaAvailability(ctx context.Context, organizationID int32) (*domain.BillingStatus, error) {
+func (s *billingService) CheckQuotaAvailability(ctx context.Context, organizationID int32) (_ *domain.BillingStatus, err error) {
+	defer func() { observeQuotaCheck(organizationID, err) }()
+
 	// Step 1: Check database quota status (read-only)
 	quotaStatus, err := s.repo.GetQuotaStatus(ctx, organizationID)
 	if err != nil {
@@ -35,6 +35,7 @@ func (s *billingService) ConsumeInvoiceQuota(ctx context.Context, organizationID
 		})
 		return nil, fmt.Errorf("failed to decrement invoice count: %w", err)
 	}
+	observeQuotaConsumed(organizationID)
 
 	s.logger.Info("Successfully consumed invoice quota locally", map[string]any{
 		"organization_id":    organizationID,
@@ -46,7 +47,15 @@ func (s *billingService) ConsumeInvoiceQuota(ctx context.Context, organizationID
 	// Step 3: Ingest meter event to Polar to consume credits (best-effort)
 	// This notifies Polar about the invoice processing usage
 	// Local tracking is maintained for fast quota checks, Polar tracks actual billing
-	go s.ingestMeterEventToPolar(context.Background(), organizationID)
+	// Tracked by the lifecycle manager so shutdown waits for it to finish
+	if err := s.lifecycle.Go("billing.meter_event", func(ctx context.Context) {
+		s.ingestMeterEventToPolar(ctx, organizationID)
+	}); err != nil {
+		s.logger.Warn("Skipped Polar meter event during shutdown", map[string]any{
+			"organization_id": organizationID,
+			"error":           err.Error(),
+		})
+	}
 
 	// Step 4: Return updated billing status
 	return &domain.BillingStatus{
@@ -60,10 +69,10 @@ func (s *billingService) ConsumeInvoiceQuota(ctx context.Context, organizationID
 }
 
 // ingestMeterEventToPolar ingests a meter event to Polar for usage-based billing
-// This runs in a background goroutine and uses best-effort approach
+// This runs as a lifecycle task and uses best-effort approach
 // Failures are logged but don't affect the main operation since local tracking is maintained
 func (s *billingService) ingestMeterEventToPolar(ctx context.Context, organizationID int32) {
-	// Use background context with timeout (independent of request context)
+	// Task context with timeout (independent of request context, canceled on shutdown)
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
@@ -29,6 +29,7 @@ func (s *billingService) GetBillingStatus(ctx context.Context, organizationID in
 		HasActiveSubscription: quotaStatus.SubscriptionStatus == "active",
 		CanProcessInvoices:    quotaStatus.CanProcessInvoice,
 		InvoiceCount:          quotaStatus.InvoiceCount,
+		PlanName:              quotaStatus.PlanName,
 		Reason:                s.buildStatusReason(quotaStatus),
 		CheckedAt:             time.Now(),
 	}, nil
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/moasq/backend/app/billing/domain"
+	"github.com/moasq/backend/pkg/metrics"
+)
+
+// Quota check results.
+const (
+	quotaAvailable      = "available"
+	quotaExceeded       = "exceeded"
+	quotaNoSubscription = "no_subscription"
+	quotaError          = "error"
+)
+
+var (
+	quotaChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "billing_quota_checks_total",
+		Help: "Invoice quota checks by result.",
+	}, []string{"result", "organization_id"})
+
+	quotaConsumed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "billing_quota_consumed_total",
+		Help: "Invoice quota units consumed.",
+	}, []string{"organization_id"})
+)
+
+func observeQuotaCheck(organizationID int32, err error) {
+	result := quotaAvailable
+	switch {
+	case err == nil:
+	case errors.Is(err, domain.ErrQuotaExceeded):
+		result = quotaExceeded
+	case errors.Is(err, domain.ErrSubscriptionNotFound):
+		result = quotaNoSubscription
+	default:
+		result = quotaError
+	}
+	quotaChecks.WithLabelValues(result, metrics.OrganizationLabel(organizationID)).Inc()
+}
+
+func observeQuotaConsumed(organizationID int32) {
+	quotaConsumed.WithLabelValues(metrics.OrganizationLabel(organizationID)).Inc()
+}
+
+// polarWebhookEventTypes are the Polar event types counted under their own
+// name; anything else is counted as "other".
+var polarWebhookEventTypes = map[string]bool{
+	"subscription.created":      true,
+	"subscription.updated":      true,
+	"subscription.canceled":     true,
+	"customer.updated":          true,
+	"meter.grant.updated":       true,
+	"meter.grant.created":       true,
+	"entitlement.grant.updated": true,
+}
+
+func observeWebhook(eventType string, err error) {
+	outcome := metrics.WebhookProcessed
+	if err != nil {
+		outcome = metrics.WebhookFailed
+	}
+	if !polarWebhookEventTypes[eventType] {
+		eventType = "other"
+		outcome = metrics.WebhookIgnored
+	}
+	metrics.ObserveWebhook("polar", eventType, outcome)
+}
@@ -3,10 +3,12 @@ package services
 import (
 	"go.uber.org/dig"
 
+	audit "github.com/moasq/backend/app/audit/domain"
 	"github.com/moasq/backend/app/billing/domain"
 	"github.com/moasq/backend/app/billing/infra/polar"
 	"github.com/moasq/backend/app/billing/infra/repositories"
 	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/lifecycle"
 	logger "github.com/moasq/backend/pkg/logger/domain"
 	polarpkg "github.com/moasq/backend/pkg/polar"
 )
@@ -46,9 +48,11 @@ func (m *Module) Configure(container *dig.Container) error {
 		repo domain.SubscriptionRepository,
 		orgAdapter domain.OrganizationAdapter,
 		polarAdapter PolarAdapter,
+		auditRecorder audit.Recorder,
 		logger logger.Logger,
+		manager *lifecycle.Manager,
 	) BillingService {
-		return NewBillingService(repo, orgAdapter, polarAdapter, logger)
+		return NewBillingService(repo, orgAdapter, polarAdapter, auditRecorder, logger, manager)
 	}); err != nil {
 		return err
 	}
@@ -9,17 +9,23 @@ import (
 	"strings"
 	"time"
 
+	audit "github.com/moasq/backend/app/audit/domain"
 	"github.com/moasq/backend/app/billing/domain"
 )
 
 const invoicesProcessedMeterSlug = "invoice.processed"
 
-func (s *billingService) ProcessWebhookEvent(ctx context.Context, eventType string, payload map[string]any) error {
+func (s *billingService) ProcessWebhookEvent(ctx context.Context, eventType string, payload map[string]any) (err error) {
+	defer func() { observeWebhook(eventType, err) }()
+
 	s.logger.Info("Processing webhook event", map[string]any{
 		"event_type":   eventType,
 		"payload_keys": mapKeys(payload),
 	})
 
+	// Billing changes made by the payment provider are attributed to the webhook in the audit log
+	ctx = audit.WithActor(ctx, audit.Actor{Type: audit.ActorWebhook})
+
 	// Update subscription based on event type
 	switch eventType {
 	case "subscription.created", "subscription.updated":
@@ -257,6 +263,7 @@ func (s *billingService) handleSubscriptionUpsert(ctx context.Context, eventData
 		SubscriptionStatus: eventData.Status,
 		ProductID:          eventData.ProductID,
 		ProductName:        eventData.ProductName,
+		PlanName:           planName(eventData),
 		CurrentPeriodStart: eventData.CurrentPeriodStart,
 		CurrentPeriodEnd:   eventData.CurrentPeriodEnd,
 		CancelAtPeriodEnd:  eventData.CancelAtPeriodEnd,
@@ -264,10 +271,12 @@ func (s *billingService) handleSubscriptionUpsert(ctx context.Context, eventData
 	}
 
 	// Step 5: Upsert subscription to database
+	previous := s.previousSubscription(ctx, organizationID)
 	_, err = s.repo.UpsertSubscription(ctx, subscription)
 	if err != nil {
 		return fmt.Errorf("failed to upsert subscription: %w", err)
 	}
+	s.recordSubscriptionChange(ctx, auditActionSubscriptionUpdated, previous, subscription)
 
 	s.logger.Info("Upserted subscription", map[string]any{
 		"organization_id": organizationID,
@@ -322,6 +331,7 @@ func (s *billingService) handleSubscriptionCanceled(ctx context.Context, eventDa
 		SubscriptionStatus: "canceled",
 		ProductID:          eventData.ProductID,
 		ProductName:        eventData.ProductName,
+		PlanName:           planName(eventData),
 		CurrentPeriodStart: eventData.CurrentPeriodStart,
 		CurrentPeriodEnd:   eventData.CurrentPeriodEnd,
 		CancelAtPeriodEnd:  false, // Already canceled
@@ -334,10 +344,12 @@ func (s *billingService) handleSubscriptionCanceled(ctx context.Context, eventDa
 	}
 
 	// Step 3: Upsert subscription with canceled status
+	previous := s.previousSubscription(ctx, organizationID)
 	_, err = s.repo.UpsertSubscription(ctx, subscription)
 	if err != nil {
 		return fmt.Errorf("failed to update subscription to canceled: %w", err)
 	}
+	s.recordSubscriptionChange(ctx, auditActionSubscriptionCanceled, previous, subscription)
 
 	s.logger.Info("Subscription marked as canceled", map[string]any{
 		"organization_id": organizationID,
@@ -948,3 +960,13 @@ func extractInvoiceCountFromProduct(product map[string]any) string {
 
 	return ""
 }
+
+// planName returns the subscription's plan: the product's "plan" metadata
+// value, or the lowercased product name when it is not set. Plans select
+// plan-specific limits such as API rate limits.
+func planName(eventData *domain.SubscriptionEventData) string {
+	if plan := strings.TrimSpace(eventData.ProductMetadata["plan"]); plan != "" {
+		return strings.ToLower(plan)
+	}
+	return strings.ToLower(strings.TrimSpace(eventData.ProductName))
+}
@@ -3,7 +3,9 @@ package services
 import (
 	"context"
 
+	audit "github.com/moasq/backend/app/audit/domain"
 	"github.com/moasq/backend/app/billing/domain"
+	"github.com/moasq/backend/pkg/lifecycle"
 	logger "github.com/moasq/backend/pkg/logger/domain"
 )
 
@@ -71,23 +73,29 @@ type BillingService interface {
 }
 
 type billingService struct {
-	repo         domain.SubscriptionRepository
-	orgAdapter   domain.OrganizationAdapter
-	polarAdapter PolarAdapter
-	logger       logger.Logger
+	repo          domain.SubscriptionRepository
+	orgAdapter    domain.OrganizationAdapter
+	polarAdapter  PolarAdapter
+	auditRecorder audit.Recorder
+	logger        logger.Logger
+	lifecycle     *lifecycle.Manager
 }
 
 func NewBillingService(
 	repo domain.SubscriptionRepository,
 	orgAdapter domain.OrganizationAdapter,
 	polarAdapter PolarAdapter,
+	auditRecorder audit.Recorder,
 	logger logger.Logger,
+	lifecycle *lifecycle.Manager,
 ) BillingService {
 	return &billingService{
-		repo:         repo,
-		orgAdapter:   orgAdapter,
-		polarAdapter: polarAdapter,
-		logger:       logger,
+		repo:          repo,
+		orgAdapter:    orgAdapter,
+		polarAdapter:  polarAdapter,
+		auditRecorder: auditRecorder,
+		logger:        logger,
+		lifecycle:     lifecycle,
 	}
 }
 
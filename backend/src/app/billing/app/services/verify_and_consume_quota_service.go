@@ -8,7 +8,9 @@ import (
 	"github.com/moasq/backend/app/billing/domain"
 )
 
-func (s *billingService) VerifyAndConsumeQuota(ctx context.Context, organizationID int32) (*domain.BillingStatus, error) {
+func (s *billingService) VerifyAndConsumeQuota(ctx context.Context, organizationID int32) (_ *domain.BillingStatus, err error) {
+	defer func() { observeQuotaCheck(organizationID, err) }()
+
 	// Step 1: Check database quota status
 	quotaStatus, err := s.repo.GetQuotaStatus(ctx, organizationID)
 	if err != nil {
@@ -62,6 +64,7 @@ func (s *billingService) VerifyAndConsumeQuota(ctx context.Context, organization
 	if err != nil {
 		return nil, fmt.Errorf("failed to decrement invoice count: %w", err)
 	}
+	observeQuotaConsumed(organizationID)
 
 	// Step 5: Return success status
 	return &domain.BillingStatus{
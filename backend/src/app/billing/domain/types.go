@@ -41,6 +41,7 @@ type QuotaStatus struct {
 	CurrentPeriodStart time.Time
 	CurrentPeriodEnd   time.Time
 	CancelAtPeriodEnd  bool
+	PlanName           string
 	InvoiceCount       int32 // Remaining invoices
 	MaxSeats           int32
 	CanProcessInvoice  bool
@@ -53,6 +54,7 @@ type BillingStatus struct {
 	HasActiveSubscription bool
 	CanProcessInvoices    bool
 	InvoiceCount          int32 // Remaining invoices
+	PlanName              string
 	Reason                string
 	CheckedAt             time.Time
 }
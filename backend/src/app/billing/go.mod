@@ -2,21 +2,25 @@ module github.com/moasq/backend/app/billing
 
 go 1.25
 
-
 replace (
 	github.com/moasq/backend/pkg/auth => ../../pkg/auth
 	github.com/moasq/backend/pkg/db => ../../pkg/db
+	github.com/moasq/backend/pkg/lifecycle => ../../pkg/lifecycle
 	github.com/moasq/backend/pkg/logger => ../../pkg/logger
-	github.com/moasq/backend/pkg/polar => ../../pkg/polar
+	github.com/moasq/backend/pkg/metrics => ../../pkg/metrics
 	github.com/moasq/backend/pkg/paywall => ../../pkg/paywall
+	github.com/moasq/backend/pkg/polar => ../../pkg/polar
 )
 
 require (
 	github.com/jackc/pgx/v5 v5.7.2
 	github.com/moasq/backend/pkg/db v0.0.0
+	github.com/moasq/backend/pkg/lifecycle v0.0.0
 	github.com/moasq/backend/pkg/logger v0.0.0
-	github.com/moasq/backend/pkg/polar v0.0.0
+	github.com/moasq/backend/pkg/metrics v0.0.0
 	github.com/moasq/backend/pkg/paywall v0.0.0
+	github.com/moasq/backend/pkg/polar v0.0.0
+	github.com/prometheus/client_golang v1.20.5
 	go.uber.org/dig v1.19.0
 )
 
@@ -35,6 +35,7 @@ func (a *StatusProviderAdapter) GetSubscriptionStatus(ctx context.Context, organ
 	status := &paywall.SubscriptionStatus{
 		OrganizationID: billingStatus.OrganizationID,
 		IsActive:       billingStatus.HasActiveSubscription,
+		Plan:           billingStatus.PlanName,
 		Reason:         billingStatus.Reason,
 	}
 
@@ -67,6 +68,7 @@ func (a *StatusProviderAdapter) RefreshSubscriptionStatus(ctx context.Context, o
 	status := &paywall.SubscriptionStatus{
 		OrganizationID: billingStatus.OrganizationID,
 		IsActive:       billingStatus.HasActiveSubscription,
+		Plan:           billingStatus.PlanName,
 		Reason:         billingStatus.Reason,
 	}
 
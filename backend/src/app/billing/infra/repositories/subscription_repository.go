@@ -182,6 +182,7 @@ func (r *subscriptionRepository) mapToDomainQuotaStatus(qs *sqlc.GetQuotaStatusR
 		SubscriptionStatus: qs.SubscriptionStatus,
 		CurrentPeriodStart: qs.CurrentPeriodStart.Time,
 		CurrentPeriodEnd:   qs.CurrentPeriodEnd.Time,
+		PlanName:           postgres.StringFromPgText(qs.PlanName),
 		InvoiceCount:       qs.InvoiceCount,
 		CanProcessInvoice:  qs.CanProcessInvoice,
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/moasq/backend/app/example_cognitive/domain"
+	"github.com/moasq/backend/pkg/auth"
 )
 
 const (
@@ -77,7 +78,7 @@ func (s *embeddingService) SearchSimilarDocuments(ctx context.Context, orgID int
 	}
 
 	// Search for similar documents
-	return s.embeddingRepo.SearchSimilar(ctx, orgID, embedding, limit)
+	return s.embeddingRepo.SearchSimilar(ctx, orgID, auth.TeamScope(ctx), embedding, limit)
 }
 
 func (s *embeddingService) DeleteDocumentEmbeddings(ctx context.Context, orgID, documentID int32) error {
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/moasq/backend/app/example_cognitive/domain"
+	"github.com/moasq/backend/pkg/auth"
 )
 
 const (
@@ -88,7 +89,7 @@ func (s *ragService) Chat(ctx context.Context, orgID, accountID int32, req *doma
 		// Generate embedding for the query and search
 		embedding, err := s.textVectorizer.Vectorize(ctx, req.Message)
 		if err == nil {
-			docs, err := s.embeddingRepo.SearchSimilar(ctx, orgID, embedding, int32(maxDocs))
+			docs, err := s.embeddingRepo.SearchSimilar(ctx, orgID, auth.TeamScope(ctx), embedding, int32(maxDocs))
 			if err == nil {
 				referencedDocs = docs
 			}
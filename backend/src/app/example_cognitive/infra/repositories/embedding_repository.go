@@ -6,6 +6,7 @@ import (
 
 	"github.com/moasq/backend/app/example_cognitive/domain"
 	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/db/postgres"
 	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
 	"github.com/pgvector/pgvector-go"
 )
@@ -69,11 +70,12 @@ func (r *embeddingRepository) GetByDocumentID(ctx context.Context, orgID, docume
 	return embeddings, nil
 }
 
-func (r *embeddingRepository) SearchSimilar(ctx context.Context, orgID int32, embedding []float64, limit int32) ([]*domain.SimilarDocument, error) {
+func (r *embeddingRepository) SearchSimilar(ctx context.Context, orgID int32, viewerID *int32, embedding []float64, limit int32) ([]*domain.SimilarDocument, error) {
 	params := sqlc.SearchSimilarDocumentsParams{
-		Column1:        toVector(embedding),
-		OrganizationID: orgID,
-		Limit:          limit,
+		Embedding:       toVector(embedding),
+		OrganizationID:  orgID,
+		ViewerAccountID: postgres.PgInt4(viewerID),
+		Limit:           limit,
 	}
 
 	results, err := r.store.SearchSimilarDocuments(ctx, params)
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ClassifierConfig configures document classification after text extraction.
+type ClassifierConfig struct {
+	// Enabled turns classification on. Disabled, documents keep the general
+	// file context and have no type.
+	Enabled bool
+	// MinConfidence is the confidence a classification needs to move the
+	// file to the type's file context. Lower results are still recorded.
+	MinConfidence float32
+	// Timeout bounds the LLM call; on timeout the heuristics decide alone
+	Timeout time.Duration
+}
+
+func (c ClassifierConfig) Validate() error {
+	if c.MinConfidence < 0 || c.MinConfidence > 1 {
+		return fmt.Errorf("DOCUMENT_CLASSIFICATION_MIN_CONFIDENCE must be between 0 and 1")
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("DOCUMENT_CLASSIFICATION_TIMEOUT must be positive")
+	}
+	return nil
+}
+
+func NewClassifierConfig() (ClassifierConfig, error) {
+	cfg := ClassifierConfig{}
+	var err error
+
+	if cfg.Enabled, err = strconv.ParseBool(getEnvOrDefault("DOCUMENT_CLASSIFICATION_ENABLED", "true")); err != nil {
+		return cfg, fmt.Errorf("invalid DOCUMENT_CLASSIFICATION_ENABLED: %w", err)
+	}
+	minConfidence, err := strconv.ParseFloat(getEnvOrDefault("DOCUMENT_CLASSIFICATION_MIN_CONFIDENCE", "0.6"), 32)
+	if err != nil {
+		return cfg, fmt.Errorf("invalid DOCUMENT_CLASSIFICATION_MIN_CONFIDENCE: %w", err)
+	}
+	cfg.MinConfidence = float32(minConfidence)
+	if cfg.Timeout, err = time.ParseDuration(getEnvOrDefault("DOCUMENT_CLASSIFICATION_TIMEOUT", "30s")); err != nil {
+		return cfg, fmt.Errorf("invalid DOCUMENT_CLASSIFICATION_TIMEOUT: %w", err)
+	}
+
+	return cfg, cfg.Validate()
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/moasq/backend/app/example_documents/domain"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/logger"
+	loggerdomain "github.com/moasq/backend/pkg/logger/domain"
+)
+
+type documentFieldService struct {
+	fieldRepo        domain.DocumentFieldRepository
+	docRepo          domain.DocumentRepository
+	types            DocumentTypeService
+	extractor        domain.FieldExtractor
+	config           ExtractionConfig
+	classifierConfig ClassifierConfig
+	logger           logger.Logger
+}
+
+func NewDocumentFieldService(
+	fieldRepo domain.DocumentFieldRepository,
+	docRepo domain.DocumentRepository,
+	types DocumentTypeService,
+	extractor domain.FieldExtractor,
+	config ExtractionConfig,
+	classifierConfig ClassifierConfig,
+	logger logger.Logger,
+) DocumentFieldService {
+	return &documentFieldService{
+		fieldRepo:        fieldRepo,
+		docRepo:          docRepo,
+		types:            types,
+		extractor:        extractor,
+		config:           config,
+		classifierConfig: classifierConfig,
+		logger:           logger,
+	}
+}
+
+func (s *documentFieldService) ExtractFields(ctx context.Context, doc *domain.Document, classification *domain.Classification, pages []*domain.DocumentPage) (*domain.FieldExtraction, error) {
+	if !s.config.Enabled || classification == nil {
+		return nil, nil
+	}
+
+	docType, err := s.extractionType(ctx, doc.OrganizationID, classification)
+	if err != nil {
+		return nil, err
+	}
+	if docType == nil {
+		// The document is no longer of a type with fields
+		if err := s.fieldRepo.ReplaceAll(ctx, doc.OrganizationID, doc.ID, nil); err != nil {
+			return nil, err
+		}
+		if _, ok := doc.Metadata["field_extraction"]; ok {
+			delete(doc.Metadata, "field_extraction")
+			if _, err := s.docRepo.Update(ctx, doc); err != nil {
+				return nil, fmt.Errorf("failed to update document metadata: %w", err)
+			}
+		}
+		return nil, nil
+	}
+
+	extractCtx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+	result, err := s.extractor.Extract(extractCtx, &domain.FieldExtractionRequest{
+		DocumentType: docType,
+		Pages:        pages,
+		MaxAttempts:  s.config.MaxAttempts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.fieldRepo.ReplaceAll(ctx, doc.OrganizationID, doc.ID, result.Fields); err != nil {
+		return nil, err
+	}
+
+	extraction := &domain.FieldExtraction{
+		DocumentType: docType.Name,
+		Status:       domain.FieldExtractionStatusValid,
+		Attempts:     result.Attempts,
+		ExtractedAt:  time.Now(),
+	}
+	if len(result.Violations) > 0 {
+		extraction.Status = domain.FieldExtractionStatusPartial
+		for _, v := range result.Violations {
+			extraction.Violations = append(extraction.Violations, v.String())
+		}
+	}
+
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]interface{})
+	}
+	doc.Metadata["field_extraction"] = extraction
+	if _, err := s.docRepo.Update(ctx, doc); err != nil {
+		return nil, fmt.Errorf("failed to update document metadata: %w", err)
+	}
+
+	s.logger.Info("Document fields extracted", loggerdomain.Fields{
+		"document_id": doc.ID,
+		"type":        docType.Name,
+		"fields":      len(result.Fields),
+		"attempts":    result.Attempts,
+		"status":      extraction.Status,
+	})
+
+	return extraction, nil
+}
+
+func (s *documentFieldService) ListDocumentFields(ctx context.Context, orgID, docID int32) ([]*domain.DocumentField, error) {
+	if _, err := s.docRepo.GetByID(ctx, orgID, docID, auth.TeamScope(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	fields, err := s.fieldRepo.List(ctx, orgID, docID)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// extractionType returns the classified type when the classification is
+// confident and the type has an extraction schema, and nil otherwise
+func (s *documentFieldService) extractionType(ctx context.Context, orgID int32, classification *domain.Classification) (*domain.DocumentType, error) {
+	if classification.Confidence < s.classifierConfig.MinConfidence {
+		return nil, nil
+	}
+
+	types, err := s.types.ListDocumentTypes(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range types {
+		if t.Name == classification.Type && t.ExtractionSchema != nil {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
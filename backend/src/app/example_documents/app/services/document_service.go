@@ -3,55 +3,118 @@ package services
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
-	"strings"
+	"strconv"
 
+	audit "github.com/moasq/backend/app/audit/domain"
 	"github.com/moasq/backend/app/example_documents/domain"
 	"github.com/moasq/backend/app/example_documents/domain/events"
+	"github.com/moasq/backend/pkg/auth"
 	"github.com/moasq/backend/pkg/eventbus"
 	filemanager "github.com/moasq/backend/pkg/file_manager"
 	filedomain "github.com/moasq/backend/pkg/file_manager/domain"
 	"github.com/moasq/backend/pkg/logger"
 	loggerdomain "github.com/moasq/backend/pkg/logger/domain"
 	ocrdomain "github.com/moasq/backend/pkg/ocr/domain"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/moasq/backend/app/example_documents")
+
+// Audit actions recorded for document changes
+const (
+	auditActionDocumentUploaded = "document.uploaded"
+	auditActionDocumentDeleted  = "document.deleted"
+
+	auditTargetDocument = "document"
+)
+
+// maxJobHistory bounds the jobs returned for one document
+const maxJobHistory = 20
+
+// AuthDocumentType is the resource type documents are registered under for
+// instance-level checks
+const AuthDocumentType = "document"
+
+// DocumentPolicy guards documents with the "resource" permissions. Documents
+// have no approval, so only ownership applies: edit and delete are limited to
+// the uploader or an org manager when the organization enables
+// edit_own_resources_only.
+var DocumentPolicy = auth.Policy{
+	ResourceType:       AuthDocumentType,
+	PermissionResource: "resource",
+	Rules: []auth.PolicyRule{
+		auth.WhenSetting(auth.SettingEditOwnResourcesOnly, auth.RequireOwner(auth.PermOrgManage, "edit", "delete")),
+	},
+}
+
 type documentService struct {
-	docRepo     domain.DocumentRepository
-	fileService filedomain.FileService
-	ocrService  ocrdomain.OCRService
-	eventBus    eventbus.EventBus
-	logger      logger.Logger
+	docRepo       domain.DocumentRepository
+	fileService   filedomain.FileService
+	ocrService    ocrdomain.OCRService
+	teamAccess    auth.TeamAccess
+	eventBus      eventbus.EventBus
+	auditRecorder audit.Recorder
+	logger        logger.Logger
+	queue         *DocumentJobQueue
+	jobs          domain.DocumentJobRepository
+	pages         domain.DocumentPageRepository
+	types         DocumentTypeService
+	fields        DocumentFieldService
 }
 
 func NewDocumentService(
 	docRepo domain.DocumentRepository,
 	fileService filedomain.FileService,
 	ocrService ocrdomain.OCRService,
+	teamAccess auth.TeamAccess,
 	eventBus eventbus.EventBus,
+	auditRecorder audit.Recorder,
 	logger logger.Logger,
+	queue *DocumentJobQueue,
+	jobs domain.DocumentJobRepository,
+	pages domain.DocumentPageRepository,
+	types DocumentTypeService,
+	fields DocumentFieldService,
 ) DocumentService {
 	return &documentService{
-		docRepo:     docRepo,
-		fileService: fileService,
-		ocrService:  ocrService,
-		eventBus:    eventBus,
-		logger:      logger,
+		docRepo:       docRepo,
+		fileService:   fileService,
+		ocrService:    ocrService,
+		teamAccess:    teamAccess,
+		eventBus:      eventBus,
+		auditRecorder: auditRecorder,
+		logger:        logger,
+		queue:         queue,
+		jobs:          jobs,
+		pages:         pages,
+		types:         types,
+		fields:        fields,
 	}
 }
 
 func (s *documentService) UploadDocument(ctx context.Context, orgID int32, req *UploadDocumentRequest, content io.Reader) (*domain.Document, error) {
-	// Validate content type (only PDFs allowed)
-	if !strings.Contains(strings.ToLower(req.ContentType), "pdf") {
+	// The file type follows the extension; the file manager verifies the
+	// content matches it
+	contentType, ok := domain.MimeTypeForFileName(req.FileName)
+	if !ok {
 		return nil, domain.ErrInvalidFileType
 	}
 
+	// Team-owned documents can only be assigned to the uploader's teams
+	if req.TeamID != nil {
+		if err := s.teamAccess.AuthorizeTeam(ctx, orgID, *req.TeamID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Upload file using file manager
 	fileReq := &filedomain.FileUploadRequest{
 		Filename:    req.FileName,
 		Size:        req.FileSize,
-		ContentType: req.ContentType,
+		ContentType: contentType,
 		Context:     filemanager.ContextGeneral,
 		Metadata:    req.Metadata,
 	}
@@ -61,34 +124,44 @@ func (s *documentService) UploadDocument(ctx context.Context, orgID int32, req *
 		return nil, fmt.Errorf("%w: %v", domain.ErrFileUploadFailed, err)
 	}
 
-	// Create document record
+	// Create document record, owned by the uploader
 	doc := &domain.Document{
 		OrganizationID: orgID,
 		FileAssetID:    fileAsset.ID,
+		TeamID:         req.TeamID,
 		Title:          req.Title,
 		FileName:       req.FileName,
-		ContentType:    req.ContentType,
+		ContentType:    contentType,
 		FileSize:       req.FileSize,
 		Status:         domain.DocumentStatusPending,
 		Metadata:       req.Metadata,
 	}
+	if reqCtx := auth.RequestContextFromContext(ctx); reqCtx != nil && reqCtx.AccountID != 0 {
+		accountID := reqCtx.AccountID
+		doc.CreatedByAccountID = &accountID
+	}
 
 	createdDoc, err := s.docRepo.Create(ctx, doc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create document: %w", err)
 	}
 
-	// Process document asynchronously (extract text)
-	go func() {
-		processCtx := context.Background()
-		s.ProcessDocument(processCtx, orgID, createdDoc.ID)
-	}()
+	s.recordAudit(ctx, auditActionDocumentUploaded, createdDoc)
+
+	// Queue text extraction. If queueing fails the document stays pending and
+	// the worker sweeper queues it later.
+	if _, err := s.queue.Enqueue(ctx, orgID, createdDoc.ID); err != nil {
+		s.logger.Warn("failed to queue document processing", loggerdomain.Fields{
+			"document_id": createdDoc.ID,
+			"error":       err.Error(),
+		})
+	}
 
 	return createdDoc, nil
 }
 
 func (s *documentService) GetDocument(ctx context.Context, orgID, docID int32) (*domain.Document, error) {
-	doc, err := s.docRepo.GetByID(ctx, orgID, docID)
+	doc, err := s.docRepo.GetByID(ctx, orgID, docID, auth.TeamScope(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get document: %w", err)
 	}
@@ -100,19 +173,20 @@ func (s *documentService) ListDocuments(ctx context.Context, orgID int32, req *L
 	var docs []*domain.Document
 	var total int64
 	var err error
+	viewerID := auth.TeamScope(ctx)
 
 	if req.Status != nil {
-		docs, err = s.docRepo.ListByStatus(ctx, orgID, *req.Status, req.Limit, req.Offset)
+		docs, err = s.docRepo.ListByStatus(ctx, orgID, viewerID, *req.Status, req.Limit, req.Offset)
 		if err != nil {
 			return nil, fmt.Errorf("failed to list documents by status: %w", err)
 		}
-		total, err = s.docRepo.CountByStatus(ctx, orgID, *req.Status)
+		total, err = s.docRepo.CountByStatus(ctx, orgID, viewerID, *req.Status)
 	} else {
-		docs, err = s.docRepo.List(ctx, orgID, req.Limit, req.Offset)
+		docs, err = s.docRepo.List(ctx, orgID, viewerID, req.Limit, req.Offset)
 		if err != nil {
 			return nil, fmt.Errorf("failed to list documents: %w", err)
 		}
-		total, err = s.docRepo.Count(ctx, orgID)
+		total, err = s.docRepo.Count(ctx, orgID, viewerID)
 	}
 
 	if err != nil {
@@ -129,7 +203,7 @@ func (s *documentService) ListDocuments(ctx context.Context, orgID int32, req *L
 
 func (s *documentService) UpdateDocument(ctx context.Context, orgID, docID int32, req *UpdateDocumentRequest) (*domain.Document, error) {
 	// Get existing document
-	doc, err := s.docRepo.GetByID(ctx, orgID, docID)
+	doc, err := s.docRepo.GetByID(ctx, orgID, docID, auth.TeamScope(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get document: %w", err)
 	}
@@ -152,7 +226,7 @@ func (s *documentService) UpdateDocument(ctx context.Context, orgID, docID int32
 
 func (s *documentService) DeleteDocument(ctx context.Context, orgID, docID int32) error {
 	// Get document to verify it exists
-	doc, err := s.docRepo.GetByID(ctx, orgID, docID)
+	doc, err := s.docRepo.GetByID(ctx, orgID, docID, auth.TeamScope(ctx))
 	if err != nil {
 		return fmt.Errorf("failed to get document: %w", err)
 	}
@@ -167,26 +241,52 @@ func (s *documentService) DeleteDocument(ctx context.Context, orgID, docID int32
 		return fmt.Errorf("failed to delete document: %w", err)
 	}
 
+	s.recordAudit(ctx, auditActionDocumentDeleted, doc)
+
 	return nil
 }
 
+func (s *documentService) LoadAuthAttributes(ctx context.Context, orgID int32, resourceID string) (*auth.ResourceAttributes, error) {
+	docID, err := strconv.ParseInt(resourceID, 10, 32)
+	if err != nil {
+		return nil, auth.ErrResourceNotFound
+	}
+
+	doc, err := s.docRepo.GetByID(ctx, orgID, int32(docID), auth.TeamScope(ctx))
+	if err != nil {
+		if errors.Is(err, domain.ErrDocumentNotFound) {
+			return nil, auth.ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	return &auth.ResourceAttributes{
+		Type:               AuthDocumentType,
+		ID:                 resourceID,
+		OrganizationID:     doc.OrganizationID,
+		CreatedByAccountID: doc.CreatedByAccountID,
+	}, nil
+}
+
 func (s *documentService) GetDocumentStats(ctx context.Context, orgID int32) (*domain.DocumentStats, error) {
-	total, err := s.docRepo.Count(ctx, orgID)
+	viewerID := auth.TeamScope(ctx)
+
+	total, err := s.docRepo.Count(ctx, orgID, viewerID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to count documents: %w", err)
 	}
 
-	pending, err := s.docRepo.CountByStatus(ctx, orgID, domain.DocumentStatusPending)
+	pending, err := s.docRepo.CountByStatus(ctx, orgID, viewerID, domain.DocumentStatusPending)
 	if err != nil {
 		return nil, fmt.Errorf("failed to count pending documents: %w", err)
 	}
 
-	processed, err := s.docRepo.CountByStatus(ctx, orgID, domain.DocumentStatusProcessed)
+	processed, err := s.docRepo.CountByStatus(ctx, orgID, viewerID, domain.DocumentStatusProcessed)
 	if err != nil {
 		return nil, fmt.Errorf("failed to count processed documents: %w", err)
 	}
 
-	failed, err := s.docRepo.CountByStatus(ctx, orgID, domain.DocumentStatusFailed)
+	failed, err := s.docRepo.CountByStatus(ctx, orgID, viewerID, domain.DocumentStatusFailed)
 	if err != nil {
 		return nil, fmt.Errorf("failed to count failed documents: %w", err)
 	}
@@ -209,25 +309,58 @@ func (s *documentService) ProcessDocument(ctx context.Context, orgID, docID int3
 	// Download file content
 	content, _, err := s.fileService.DownloadFile(ctx, doc.FileAssetID)
 	if err != nil {
-		s.markDocumentFailed(ctx, orgID, docID, err.Error())
-		return nil, fmt.Errorf("%w: %v", domain.ErrFileDownloadFailed, err)
+		return nil, fmt.Errorf("%w: %w", domain.ErrFileDownloadFailed, err)
 	}
 	defer content.Close()
 
-	// Extract text from PDF
-	extractedText, err := s.extractTextFromPDF(content)
+	// Extract text: PDFs and images through OCR, other formats locally
+	ocrResult, err := s.extractText(ctx, content, documentMimeType(doc))
 	if err != nil {
-		s.markDocumentFailed(ctx, orgID, docID, err.Error())
-		return nil, fmt.Errorf("%w: %v", domain.ErrTextExtractionFailed, err)
+		return nil, fmt.Errorf("%w: %w", domain.ErrTextExtractionFailed, err)
+	}
+	extractedText := ocrResult.Text
+
+	// Record how the text was obtained
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]interface{})
+	}
+	doc.Metadata["extraction"] = extractionMetadata(ocrResult)
+	if _, err := s.docRepo.Update(ctx, doc); err != nil {
+		return nil, fmt.Errorf("failed to update document metadata: %w", err)
+	}
+
+	// Store the pages before the document is marked processed, so a processed
+	// document always has pages from the same extraction
+	pages := documentPages(docID, ocrResult)
+	if err := s.pages.ReplaceAll(ctx, orgID, docID, pages); err != nil {
+		return nil, fmt.Errorf("failed to save document pages: %w", err)
 	}
 
 	// Update document with extracted text
 	doc, err = s.docRepo.UpdateExtractedText(ctx, orgID, docID, extractedText)
 	if err != nil {
-		s.markDocumentFailed(ctx, orgID, docID, err.Error())
 		return nil, fmt.Errorf("failed to update extracted text: %w", err)
 	}
 
+	// Classify and extract fields before announcing the text, so listeners
+	// can rely on them. A failed classification leaves the document
+	// unclassified; a failed extraction keeps the fields of the last one.
+	if doc.HasText() {
+		classification, err := s.types.ClassifyDocument(ctx, doc)
+		if err != nil {
+			s.logger.Warn("failed to classify document", loggerdomain.Fields{
+				"document_id": docID,
+				"error":       err.Error(),
+			})
+		}
+		if _, err := s.fields.ExtractFields(ctx, doc, classification, pages); err != nil {
+			s.logger.Warn("failed to extract document fields", loggerdomain.Fields{
+				"document_id": docID,
+				"error":       err.Error(),
+			})
+		}
+	}
+
 	// Publish event for cognitive module to pick up
 	event := events.NewDocumentUploaded(docID, orgID, doc.FileAssetID, doc.Title, extractedText)
 	if err := s.eventBus.Publish(ctx, event); err != nil {
@@ -237,52 +370,219 @@ func (s *documentService) ProcessDocument(ctx context.Context, orgID, docID int3
 	return doc, nil
 }
 
-// markDocumentFailed marks a document as failed and publishes failure event
-func (s *documentService) markDocumentFailed(ctx context.Context, orgID, docID int32, errMsg string) {
-	s.docRepo.UpdateStatus(ctx, orgID, docID, domain.DocumentStatusFailed)
+func (s *documentService) ReprocessDocument(ctx context.Context, orgID, docID int32) (*domain.DocumentJob, error) {
+	// Get document to verify it exists and is visible to the caller
+	if _, err := s.docRepo.GetByID(ctx, orgID, docID, auth.TeamScope(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
 
-	// Publish failure event
-	event := events.NewDocumentFailed(docID, orgID, errMsg)
-	s.eventBus.Publish(ctx, event)
+	// Mark pending first: a document left pending without a job is queued by
+	// the sweeper, while a job could otherwise find the document processed
+	if _, err := s.docRepo.UpdateStatus(ctx, orgID, docID, domain.DocumentStatusPending); err != nil {
+		return nil, fmt.Errorf("failed to update document status: %w", err)
+	}
+
+	job, err := s.queue.Enqueue(ctx, orgID, docID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue document: %w", err)
+	}
+
+	return job, nil
 }
 
-// extractTextFromPDF extracts text from a PDF file using OCR service
-func (s *documentService) extractTextFromPDF(content io.Reader) (string, error) {
+func (s *documentService) GetDocumentJob(ctx context.Context, orgID, jobID int32) (*domain.DocumentJob, error) {
+	job, err := s.jobs.GetByID(ctx, orgID, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document job: %w", err)
+	}
+
+	// Jobs of documents the caller cannot see are reported as missing
+	if _, err := s.docRepo.GetByID(ctx, orgID, job.DocumentID, auth.TeamScope(ctx)); err != nil {
+		if errors.Is(err, domain.ErrDocumentNotFound) {
+			return nil, domain.ErrDocumentJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	return job, nil
+}
+
+func (s *documentService) GetDocumentPage(ctx context.Context, orgID, docID, pageNumber int32) (*DocumentPageResponse, error) {
+	if _, err := s.docRepo.GetByID(ctx, orgID, docID, auth.TeamScope(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	page, err := s.pages.GetByNumber(ctx, orgID, docID, pageNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document page: %w", err)
+	}
+
+	total, err := s.pages.Count(ctx, orgID, docID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count document pages: %w", err)
+	}
+
+	return &DocumentPageResponse{
+		Page:       page,
+		TotalPages: total,
+	}, nil
+}
+
+func (s *documentService) ListDocumentJobs(ctx context.Context, orgID, docID int32) ([]*domain.DocumentJob, error) {
+	if _, err := s.docRepo.GetByID(ctx, orgID, docID, auth.TeamScope(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	jobs, err := s.jobs.ListByDocument(ctx, orgID, docID, maxJobHistory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// recordAudit writes an audit entry for a document change. Failures are
+// logged: the change has already been applied.
+func (s *documentService) recordAudit(ctx context.Context, action string, doc *domain.Document) {
+	if err := s.auditRecorder.Record(ctx, audit.Entry{
+		OrganizationID: doc.OrganizationID,
+		Action:         action,
+		TargetType:     auditTargetDocument,
+		TargetID:       strconv.FormatInt(int64(doc.ID), 10),
+		Metadata: map[string]any{
+			"title":         doc.Title,
+			"file_name":     doc.FileName,
+			"file_asset_id": doc.FileAssetID,
+		},
+	}); err != nil {
+		s.logger.Warn("failed to record document audit entry", loggerdomain.Fields{
+			"document_id": doc.ID,
+			"action":      action,
+			"error":       err.Error(),
+		})
+	}
+}
+
+// extractText extracts text from a document using OCR service. Digital PDFs
+// are read from their text layer and only scanned pages go to OCR; DOCX,
+// XLSX, CSV and plain text are read without OCR.
+func (s *documentService) extractText(ctx context.Context, content io.Reader, mimeType string) (*ocrdomain.OCRResponse, error) {
 	// Read all content into memory
 	data, err := io.ReadAll(content)
 	if err != nil {
-		return "", fmt.Errorf("failed to read PDF content: %w", err)
+		return nil, fmt.Errorf("failed to read document content: %w", err)
 	}
 
 	// Encode to base64 for OCR service
 	base64Data := base64.StdEncoding.EncodeToString(data)
 
 	// Call OCR service
-	ctx := context.Background()
-	ocrResult, err := s.ocrService.ExtractText(ctx, base64Data, "application/pdf")
+	ocrResult, err := s.ocrService.ExtractText(ctx, base64Data, mimeType)
 	if err != nil {
 		s.logger.Error("OCR extraction failed", loggerdomain.Fields{"error": err.Error()})
-		return "", fmt.Errorf("OCR extraction failed: %w", err)
+		return nil, fmt.Errorf("OCR extraction failed: %w", err)
 	}
 
-	// Check confidence score
-	const MinOCRConfidence = 0.7
-	if ocrResult.Confidence < MinOCRConfidence {
-		s.logger.Warn("OCR confidence below threshold", loggerdomain.Fields{
-			"confidence":    ocrResult.Confidence,
-			"pages":         ocrResult.Pages,
-			"min_threshold": MinOCRConfidence,
-		})
-		// Still proceed but log the warning
-	}
+	// Confidence thresholds are applied per provider by the OCR chain, which
+	// returns its best result when none reaches them
 
 	// Log success
-	s.logger.Info("Successfully extracted PDF text via OCR", loggerdomain.Fields{
+	s.logger.Info("Successfully extracted document text", loggerdomain.Fields{
+		"mime_type":  mimeType,
 		"pages":      ocrResult.Pages,
 		"chars":      len(ocrResult.Text),
 		"confidence": ocrResult.Confidence,
+		"extractor":  ocrResult.Extractor,
 	})
 
-	// Return extracted text (already in markdown format from Mistral)
-	return ocrResult.Text, nil
+	// Text layer pages are plain text; OCR pages are markdown from Mistral and
+	// local formats keep headings and tables as markdown
+	return ocrResult, nil
+}
+
+// documentMimeType returns the MIME type text is extracted as. Documents
+// uploaded before other formats were accepted are PDFs.
+func documentMimeType(doc *domain.Document) string {
+	if mimeType, ok := domain.MimeTypeForFileName(doc.FileName); ok {
+		return mimeType
+	}
+	return "application/pdf"
+}
+
+// extractionMetadata describes which extractor produced the text of each page
+func extractionMetadata(result *ocrdomain.OCRResponse) map[string]interface{} {
+	ocrPages := make([]int, 0)
+	for _, page := range result.PageTexts {
+		if isOCRExtractor(page.Extractor) {
+			ocrPages = append(ocrPages, page.Number)
+		}
+	}
+
+	return map[string]interface{}{
+		"extractor":  result.Extractor,
+		"pages":      result.Pages,
+		"ocr_pages":  ocrPages,
+		"confidence": result.Confidence,
+	}
+}
+
+// isOCRExtractor reports whether an extractor recognizes text from images
+// rather than reading text stored in the file
+func isOCRExtractor(extractor string) bool {
+	switch extractor {
+	case ocrdomain.ExtractorPDFText, ocrdomain.ExtractorDOCX, ocrdomain.ExtractorXLSX,
+		ocrdomain.ExtractorCSV, ocrdomain.ExtractorPlainText:
+		return false
+	default:
+		return true
+	}
+}
+
+// documentPages converts the extraction result into stored pages. Extractors
+// that do not report pages produce a single page holding the whole text.
+func documentPages(docID int32, result *ocrdomain.OCRResponse) []*domain.DocumentPage {
+	if len(result.PageTexts) == 0 {
+		if result.Text == "" {
+			return nil
+		}
+		return []*domain.DocumentPage{{
+			DocumentID: docID,
+			PageNumber: 1,
+			Text:       result.Text,
+			Extractor:  result.Extractor,
+		}}
+	}
+
+	pages := make([]*domain.DocumentPage, 0, len(result.PageTexts))
+	for _, pt := range result.PageTexts {
+		page := &domain.DocumentPage{
+			DocumentID: docID,
+			PageNumber: int32(pt.Number),
+			Text:       pt.Text,
+			Extractor:  pt.Extractor,
+			Width:      pt.Width,
+			Height:     pt.Height,
+			Blocks:     make([]domain.PageBlock, 0, len(pt.Blocks)),
+			Tables:     make([]domain.PageTable, 0, len(pt.Tables)),
+		}
+		for _, b := range pt.Blocks {
+			page.Blocks = append(page.Blocks, domain.PageBlock{
+				Type:       b.Type,
+				Text:       b.Text,
+				BBox:       domain.BoundingBox(b.BBox),
+				Confidence: b.Confidence,
+			})
+		}
+		for _, t := range pt.Tables {
+			table := domain.PageTable{Rows: t.Rows}
+			if t.BBox != nil {
+				bbox := domain.BoundingBox(*t.BBox)
+				table.BBox = &bbox
+			}
+			page.Tables = append(page.Tables, table)
+		}
+		pages = append(pages, page)
+	}
+
+	return pages
 }
@@ -0,0 +1,359 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	audit "github.com/moasq/backend/app/audit/domain"
+	"github.com/moasq/backend/app/example_documents/domain"
+	"github.com/moasq/backend/app/example_documents/domain/events"
+	"github.com/moasq/backend/pkg/eventbus"
+	filemanager "github.com/moasq/backend/pkg/file_manager"
+	filedomain "github.com/moasq/backend/pkg/file_manager/domain"
+	"github.com/moasq/backend/pkg/logger"
+	loggerdomain "github.com/moasq/backend/pkg/logger/domain"
+)
+
+const (
+	auditActionDocumentTypeCreated = "document_type.created"
+	auditActionDocumentTypeUpdated = "document_type.updated"
+	auditActionDocumentTypeDeleted = "document_type.deleted"
+
+	auditTargetDocumentType = "document_type"
+
+	// heuristicExcerptBytes bounds the text scanned for keywords
+	heuristicExcerptBytes = 20000
+	// heuristicMaxConfidence caps keyword confidence: keywords alone cannot
+	// tell a receipt quoting an invoice number from an invoice
+	heuristicMaxConfidence = 0.9
+	// heuristicFullStrength is the keyword score at which the heuristics
+	// reach their confidence cap
+	heuristicFullStrength = 6.0
+)
+
+// typeKeywords are phrases that suggest a built-in type, with their weight
+var typeKeywords = map[string]map[string]float64{
+	domain.DocumentTypeInvoice: {
+		"invoice": 2, "invoice number": 2, "invoice no": 2, "tax invoice": 2,
+		"bill to": 1.5, "amount due": 1.5, "balance due": 1.5, "due date": 1,
+		"payment terms": 1, "remit to": 1, "net 30": 1, "purchase order": 0.5,
+	},
+	domain.DocumentTypeReceipt: {
+		"receipt": 2, "thank you for your purchase": 2, "change due": 2, "tendered": 1.5,
+		"card ending": 1, "auth code": 1, "transaction id": 1, "cashier": 1,
+		"paid": 0.5, "cash": 0.5, "visa": 0.5, "mastercard": 0.5,
+	},
+	domain.DocumentTypeContract: {
+		"agreement": 2, "hereinafter": 2, "whereas": 2, "in witness whereof": 2,
+		"governing law": 1.5, "the parties": 1.5, "termination": 1, "indemnif": 1,
+		"obligations": 1, "effective date": 1, "terms and conditions": 0.5, "signature": 0.5,
+	},
+	domain.DocumentTypeReport: {
+		"executive summary": 2, "report": 1.5, "findings": 1.5, "balance sheet": 1.5,
+		"income statement": 1.5, "statement of": 1, "analysis": 1, "fiscal year": 1,
+		"recommendations": 1, "conclusion": 1, "quarter": 0.5, "summary": 0.5,
+	},
+}
+
+// fileContexts maps types to the file context of classified files
+var fileContexts = map[string]filemanager.FileContext{
+	domain.DocumentTypeInvoice:  filemanager.ContextInvoice,
+	domain.DocumentTypeReceipt:  filemanager.ContextReceipt,
+	domain.DocumentTypeContract: filemanager.ContextContract,
+	domain.DocumentTypeReport:   filemanager.ContextReport,
+}
+
+type documentTypeService struct {
+	typeRepo      domain.DocumentTypeRepository
+	docRepo       domain.DocumentRepository
+	fileService   filedomain.FileService
+	classifier    domain.TypeClassifier
+	eventBus      eventbus.EventBus
+	auditRecorder audit.Recorder
+	config        ClassifierConfig
+	logger        logger.Logger
+}
+
+func NewDocumentTypeService(
+	typeRepo domain.DocumentTypeRepository,
+	docRepo domain.DocumentRepository,
+	fileService filedomain.FileService,
+	classifier domain.TypeClassifier,
+	eventBus eventbus.EventBus,
+	auditRecorder audit.Recorder,
+	config ClassifierConfig,
+	logger logger.Logger,
+) DocumentTypeService {
+	return &documentTypeService{
+		typeRepo:      typeRepo,
+		docRepo:       docRepo,
+		fileService:   fileService,
+		classifier:    classifier,
+		eventBus:      eventBus,
+		auditRecorder: auditRecorder,
+		config:        config,
+		logger:        logger,
+	}
+}
+
+func (s *documentTypeService) ListDocumentTypes(ctx context.Context, orgID int32) ([]*domain.DocumentType, error) {
+	custom, err := s.typeRepo.List(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make([]*domain.DocumentType, 0, len(domain.BuiltInDocumentTypes)+len(custom))
+	types = append(types, domain.BuiltInDocumentTypes...)
+	return append(types, custom...), nil
+}
+
+func (s *documentTypeService) CreateDocumentType(ctx context.Context, orgID int32, req *domain.CreateDocumentTypeRequest) (*domain.DocumentType, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Best effort under concurrent creates; the limit only keeps the
+	// classification prompt bounded
+	count, err := s.typeRepo.Count(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= domain.MaxCustomDocumentTypes {
+		return nil, domain.ErrDocumentTypeLimitReached
+	}
+
+	docType, err := s.typeRepo.Create(ctx, orgID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordTypeAudit(ctx, auditActionDocumentTypeCreated, orgID, docType.ID, nil, docType)
+
+	return docType, nil
+}
+
+func (s *documentTypeService) UpdateDocumentType(ctx context.Context, orgID, typeID int32, req *domain.UpdateDocumentTypeRequest) (*domain.DocumentType, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	before, err := s.typeRepo.GetByID(ctx, orgID, typeID)
+	if err != nil {
+		return nil, err
+	}
+
+	docType, err := s.typeRepo.Update(ctx, orgID, typeID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordTypeAudit(ctx, auditActionDocumentTypeUpdated, orgID, typeID, before, docType)
+
+	return docType, nil
+}
+
+func (s *documentTypeService) DeleteDocumentType(ctx context.Context, orgID, typeID int32) error {
+	before, err := s.typeRepo.GetByID(ctx, orgID, typeID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.typeRepo.Delete(ctx, orgID, typeID); err != nil {
+		return err
+	}
+
+	s.recordTypeAudit(ctx, auditActionDocumentTypeDeleted, orgID, typeID, before, nil)
+
+	return nil
+}
+
+func (s *documentTypeService) ClassifyDocument(ctx context.Context, doc *domain.Document) (*domain.Classification, error) {
+	if !s.config.Enabled {
+		return nil, nil
+	}
+
+	types, err := s.ListDocumentTypes(ctx, doc.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	classification := s.classify(ctx, doc, types)
+
+	// Only confident results move the file; other and custom types belong
+	// in the general context
+	if classification.Confidence >= s.config.MinConfidence {
+		fileContext, ok := fileContexts[classification.Type]
+		if !ok {
+			fileContext = filemanager.ContextGeneral
+		}
+		if err := s.fileService.UpdateFileContext(ctx, doc.FileAssetID, fileContext); err != nil {
+			return nil, err
+		}
+		classification.FileContext = string(fileContext)
+	}
+
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]interface{})
+	}
+	doc.Metadata["classification"] = classification
+	if _, err := s.docRepo.Update(ctx, doc); err != nil {
+		return nil, fmt.Errorf("failed to update document metadata: %w", err)
+	}
+
+	s.logger.Info("Document classified", loggerdomain.Fields{
+		"document_id":  doc.ID,
+		"type":         classification.Type,
+		"confidence":   classification.Confidence,
+		"method":       classification.Method,
+		"file_context": classification.FileContext,
+	})
+
+	event := events.NewDocumentClassified(doc.ID, doc.OrganizationID, doc.FileAssetID,
+		classification.Type, classification.Confidence, classification.Method, classification.FileContext)
+	if err := s.eventBus.Publish(ctx, event); err != nil {
+		s.logger.Warn("failed to publish document classified event", loggerdomain.Fields{
+			"document_id": doc.ID,
+			"error":       err.Error(),
+		})
+	}
+
+	return classification, nil
+}
+
+// classify combines the LLM prediction with keyword heuristics. Agreement
+// raises the confidence; on disagreement the more confident one wins,
+// discounted by the other's confidence. Without either the document is other.
+func (s *documentTypeService) classify(ctx context.Context, doc *domain.Document, types []*domain.DocumentType) *domain.Classification {
+	heuristic := classifyByKeywords(doc.ExtractedText, types)
+
+	llmCtx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+	prediction, err := s.classifier.Classify(llmCtx, doc.ExtractedText, types)
+	if err != nil {
+		s.logger.Warn("LLM classification failed, using heuristics", loggerdomain.Fields{
+			"document_id": doc.ID,
+			"error":       err.Error(),
+		})
+		prediction = nil
+	}
+
+	classification := &domain.Classification{
+		Type:         domain.DocumentTypeOther,
+		Method:       domain.ClassificationMethodHeuristics,
+		ClassifiedAt: time.Now(),
+	}
+
+	switch {
+	case prediction == nil && heuristic == nil:
+		// Nothing to go on
+	case prediction == nil:
+		classification.Type = heuristic.Type
+		classification.Confidence = heuristic.Confidence
+		classification.Reason = heuristic.Reason
+	case heuristic == nil:
+		classification.Type = prediction.Type
+		classification.Confidence = prediction.Confidence
+		classification.Method = domain.ClassificationMethodLLM
+		classification.Reason = prediction.Reason
+	case prediction.Type == heuristic.Type:
+		classification.Type = prediction.Type
+		classification.Confidence = 1 - (1-prediction.Confidence)*(1-heuristic.Confidence)
+		classification.Method = domain.ClassificationMethodCombined
+		classification.Reason = prediction.Reason
+	case prediction.Confidence >= heuristic.Confidence:
+		classification.Type = prediction.Type
+		classification.Confidence = max(prediction.Confidence-heuristic.Confidence/2, 0)
+		classification.Method = domain.ClassificationMethodLLM
+		classification.Reason = prediction.Reason
+	default:
+		classification.Type = heuristic.Type
+		classification.Confidence = max(heuristic.Confidence-prediction.Confidence/2, 0)
+		classification.Reason = heuristic.Reason
+	}
+
+	return classification
+}
+
+// classifyByKeywords scores types by the weighted keywords found in the text.
+// Custom types score on the words of their name. It returns nil when no
+// keyword matches.
+func classifyByKeywords(text string, types []*domain.DocumentType) *domain.TypePrediction {
+	if len(text) > heuristicExcerptBytes {
+		text = text[:heuristicExcerptBytes]
+	}
+	text = strings.ToLower(text)
+
+	scores := make(map[string]float64, len(types))
+	matches := make(map[string][]string, len(types))
+	for _, t := range types {
+		keywords, ok := typeKeywords[t.Name]
+		if !ok && !t.BuiltIn {
+			keywords = map[string]float64{strings.ReplaceAll(t.Name, "_", " "): 2}
+		}
+		for keyword, weight := range keywords {
+			if strings.Contains(text, keyword) {
+				scores[t.Name] += weight
+				matches[t.Name] = append(matches[t.Name], keyword)
+			}
+		}
+	}
+
+	var best string
+	var bestScore, total float64
+	for name, score := range scores {
+		total += score
+		if score > bestScore || (score == bestScore && name < best) {
+			best, bestScore = name, score
+		}
+	}
+	if bestScore == 0 {
+		return nil
+	}
+
+	// Confidence grows with the winner's share of all matches and with how
+	// much evidence there is
+	strength := min(bestScore/heuristicFullStrength, 1)
+	confidence := heuristicMaxConfidence * (bestScore / total) * strength
+
+	found := matches[best]
+	sort.Strings(found)
+	return &domain.TypePrediction{
+		Type:       best,
+		Confidence: float32(confidence),
+		Reason:     "keywords: " + strings.Join(found, ", "),
+	}
+}
+
+// recordTypeAudit writes an audit entry for a document type change. Failures
+// are logged: the change has already been applied.
+func (s *documentTypeService) recordTypeAudit(ctx context.Context, action string, orgID, typeID int32, before, after *domain.DocumentType) {
+	if err := s.auditRecorder.Record(ctx, audit.Entry{
+		OrganizationID: orgID,
+		Action:         action,
+		TargetType:     auditTargetDocumentType,
+		TargetID:       strconv.FormatInt(int64(typeID), 10),
+		Changes:        audit.Diff(documentTypeAuditFields(before), documentTypeAuditFields(after)),
+	}); err != nil {
+		s.logger.Warn("failed to record document type audit entry", loggerdomain.Fields{
+			"document_type_id": typeID,
+			"action":           action,
+			"error":            err.Error(),
+		})
+	}
+}
+
+// documentTypeAuditFields returns the audited fields of a document type
+func documentTypeAuditFields(docType *domain.DocumentType) map[string]any {
+	if docType == nil {
+		return nil
+	}
+	return map[string]any{
+		"name":              docType.Name,
+		"description":       docType.Description,
+		"extraction_schema": docType.ExtractionSchema,
+	}
+}
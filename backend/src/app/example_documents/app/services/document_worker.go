@@ -0,0 +1,386 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moasq/backend/app/example_documents/domain"
+	"github.com/moasq/backend/app/example_documents/domain/events"
+	"github.com/moasq/backend/pkg/eventbus"
+	"github.com/moasq/backend/pkg/logger"
+	loggerdomain "github.com/moasq/backend/pkg/logger/domain"
+	ocrdomain "github.com/moasq/backend/pkg/ocr/domain"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// bookkeepingTimeout bounds the queue and status updates after a job
+	// returns, which run even when shutdown canceled the job.
+	bookkeepingTimeout = 5 * time.Second
+	// orphanBatchSize bounds the orphaned documents queued per sweep
+	orphanBatchSize = 100
+)
+
+// DocumentWorkerPool processes queued document jobs with a fixed number of
+// workers. Transient OCR failures are retried with exponential backoff; other
+// failures, and transient ones past the last attempt, fail the document.
+type DocumentWorkerPool struct {
+	queue    *DocumentJobQueue
+	jobs     domain.DocumentJobRepository
+	docRepo  domain.DocumentRepository
+	service  DocumentService
+	eventBus eventbus.EventBus
+	config   JobQueueConfig
+	logger   logger.Logger
+	workerID string
+
+	mu      sync.Mutex
+	running bool
+	stop    chan struct{}
+	cancel  context.CancelFunc
+	loops   sync.WaitGroup
+}
+
+func NewDocumentWorkerPool(
+	queue *DocumentJobQueue,
+	jobs domain.DocumentJobRepository,
+	docRepo domain.DocumentRepository,
+	service DocumentService,
+	eventBus eventbus.EventBus,
+	config JobQueueConfig,
+	logger logger.Logger,
+) *DocumentWorkerPool {
+	return &DocumentWorkerPool{
+		queue:    queue,
+		jobs:     jobs,
+		docRepo:  docRepo,
+		service:  service,
+		eventBus: eventBus,
+		config:   config,
+		logger:   logger,
+		workerID: newWorkerID(),
+	}
+}
+
+// Start launches the workers and the sweeper. It is a no-op when no workers
+// are configured or the pool is already running.
+func (p *DocumentWorkerPool) Start() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.running || p.config.Workers <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.stop = make(chan struct{})
+	p.cancel = cancel
+	p.running = true
+
+	for i := 0; i < p.config.Workers; i++ {
+		p.loops.Add(1)
+		go func(stop chan struct{}) {
+			defer p.loops.Done()
+			p.work(ctx, stop)
+		}(p.stop)
+	}
+
+	p.loops.Add(1)
+	go func(stop chan struct{}) {
+		defer p.loops.Done()
+		p.sweep(ctx, stop)
+	}(p.stop)
+
+	p.logger.Info("document workers started", loggerdomain.Fields{
+		"workers":         p.config.Workers,
+		"org_concurrency": p.config.OrgConcurrency,
+		"worker_id":       p.workerID,
+	})
+}
+
+// Stop stops claiming jobs and waits up to the drain timeout for running jobs
+// to finish. Jobs still running after that are canceled and returned to the
+// queue for another instance.
+func (p *DocumentWorkerPool) Stop(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.running {
+		return nil
+	}
+
+	close(p.stop)
+	p.running = false
+	defer p.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		p.loops.Wait()
+		close(done)
+	}()
+
+	drain := time.NewTimer(p.config.DrainTimeout)
+	defer drain.Stop()
+
+	select {
+	case <-done:
+		return nil
+	case <-drain.C:
+	case <-ctx.Done():
+	}
+
+	p.logger.Warn("document jobs still running after drain, returning them to the queue")
+	p.cancel()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("document workers did not stop: %w", ctx.Err())
+	}
+}
+
+// work claims and runs jobs until stop is closed. It waits for a wake-up or
+// the poll interval whenever the queue is empty.
+func (p *DocumentWorkerPool) work(ctx context.Context, stop chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		job, err := p.jobs.Claim(ctx, p.workerID, p.config.VisibilityTimeout, p.config.OrgConcurrency)
+		if err == nil {
+			// More jobs may be waiting: pass the wake-up on to an idle worker
+			p.queue.Notify()
+			p.run(ctx, job)
+			continue
+		}
+		if !errors.Is(err, domain.ErrNoDocumentJob) && ctx.Err() == nil {
+			p.logger.Error("failed to claim document job", loggerdomain.Fields{
+				"error": err.Error(),
+			})
+		}
+
+		poll := time.NewTimer(p.config.PollInterval)
+		select {
+		case <-stop:
+			poll.Stop()
+			return
+		case <-p.queue.wake:
+		case <-poll.C:
+		}
+		poll.Stop()
+	}
+}
+
+// run processes one claimed job and records the outcome.
+func (p *DocumentWorkerPool) run(ctx context.Context, job *domain.DocumentJob) {
+	ctx, span := tracer.Start(ctx, "ProcessDocument", trace.WithAttributes(
+		attribute.Int("document.id", int(job.DocumentID)),
+		attribute.Int("organization.id", int(job.OrganizationID)),
+		attribute.Int("document.job.id", int(job.ID)),
+		attribute.Int("document.job.attempt", int(job.Attempts)),
+	))
+	defer span.End()
+
+	jobCtx, cancel := context.WithCancelCause(ctx)
+	go p.heartbeat(jobCtx, cancel, job.ID)
+
+	_, err := p.service.ProcessDocument(jobCtx, job.OrganizationID, job.DocumentID)
+	leaseErr := context.Cause(jobCtx)
+	cancel(nil)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	// Record the outcome even when shutdown canceled the job
+	bctx, bcancel := context.WithTimeout(context.WithoutCancel(ctx), bookkeepingTimeout)
+	defer bcancel()
+
+	fields := loggerdomain.Fields{
+		"document_id": job.DocumentID,
+		"job_id":      job.ID,
+		"attempt":     job.Attempts,
+	}
+
+	switch {
+	case errors.Is(leaseErr, domain.ErrDocumentJobLeaseLost):
+		// Another worker owns the job now; leave its state alone
+		p.logger.Warn("document job lease lost, abandoning attempt", fields)
+		return
+	case err == nil:
+		p.record(p.jobs.Complete(bctx, job.ID, p.workerID), "complete", fields)
+	case ctx.Err() != nil:
+		p.record(p.jobs.Release(bctx, job.ID, p.workerID), "release", fields)
+		p.setStatus(bctx, job, domain.DocumentStatusPending)
+		p.logger.Warn("document job interrupted by shutdown, returned to queue", fields)
+	case isTransient(err) && job.HasAttemptsLeft():
+		delay := p.config.retryDelay(job.Attempts)
+		p.record(p.jobs.Retry(bctx, job.ID, p.workerID, delay, err.Error()), "retry", fields)
+		p.setStatus(bctx, job, domain.DocumentStatusPending)
+		fields["retry_in"] = delay.String()
+		fields["error"] = err.Error()
+		p.logger.Warn("document job failed, retrying", fields)
+	default:
+		p.record(p.jobs.Fail(bctx, job.ID, p.workerID, err.Error()), "fail", fields)
+		p.failDocument(bctx, job, err.Error())
+		fields["error"] = err.Error()
+		p.logger.Error("document job failed", fields)
+	}
+}
+
+// heartbeat renews the job's lease until ctx is done. Losing the lease
+// cancels the job: the sweeper has already handed it to another worker.
+func (p *DocumentWorkerPool) heartbeat(ctx context.Context, cancel context.CancelCauseFunc, jobID int32) {
+	ticker := time.NewTicker(p.config.VisibilityTimeout / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := p.jobs.ExtendLease(ctx, jobID, p.workerID, p.config.VisibilityTimeout)
+			if errors.Is(err, domain.ErrDocumentJobLeaseLost) {
+				cancel(err)
+				return
+			}
+			if err != nil && ctx.Err() == nil {
+				// Retried on the next tick, well before the lease expires
+				p.logger.Warn("failed to extend document job lease", loggerdomain.Fields{
+					"job_id": jobID,
+					"error":  err.Error(),
+				})
+			}
+		}
+	}
+}
+
+// sweep periodically recovers jobs of crashed workers and documents that were
+// never queued. It also runs once at startup so work resumes after a restart.
+func (p *DocumentWorkerPool) sweep(ctx context.Context, stop chan struct{}) {
+	ticker := time.NewTicker(p.config.SweepInterval)
+	defer ticker.Stop()
+
+	for {
+		p.sweepOnce(ctx)
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *DocumentWorkerPool) sweepOnce(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, p.config.SweepInterval)
+	defer cancel()
+
+	expired, err := p.jobs.RequeueExpired(ctx)
+	if err != nil {
+		p.logger.Error("failed to requeue expired document jobs", loggerdomain.Fields{
+			"error": err.Error(),
+		})
+	}
+	for _, job := range expired {
+		if job.Status == domain.JobStatusFailed {
+			p.failDocument(ctx, job, job.LastError)
+			continue
+		}
+		p.setStatus(ctx, job, domain.DocumentStatusPending)
+	}
+
+	orphaned, err := p.jobs.EnqueueOrphaned(ctx, p.config.MaxAttempts, p.config.VisibilityTimeout, orphanBatchSize)
+	if err != nil {
+		p.logger.Error("failed to queue orphaned documents", loggerdomain.Fields{
+			"error": err.Error(),
+		})
+	}
+
+	if len(expired) > 0 || len(orphaned) > 0 {
+		p.logger.Warn("recovered document jobs", loggerdomain.Fields{
+			"expired":  len(expired),
+			"orphaned": len(orphaned),
+		})
+		p.queue.Notify()
+	}
+}
+
+// failDocument marks a document as failed and publishes failure event
+func (p *DocumentWorkerPool) failDocument(ctx context.Context, job *domain.DocumentJob, errMsg string) {
+	p.setStatus(ctx, job, domain.DocumentStatusFailed)
+
+	event := events.NewDocumentFailed(job.DocumentID, job.OrganizationID, errMsg)
+	if err := p.eventBus.Publish(ctx, event); err != nil {
+		p.logger.Warn("failed to publish document failure", loggerdomain.Fields{
+			"document_id": job.DocumentID,
+			"error":       err.Error(),
+		})
+	}
+}
+
+func (p *DocumentWorkerPool) setStatus(ctx context.Context, job *domain.DocumentJob, status domain.DocumentStatus) {
+	if _, err := p.docRepo.UpdateStatus(ctx, job.OrganizationID, job.DocumentID, status); err != nil {
+		p.logger.Error("failed to update document status", loggerdomain.Fields{
+			"document_id": job.DocumentID,
+			"status":      string(status),
+			"error":       err.Error(),
+		})
+	}
+}
+
+// record logs a failed queue update. A lost lease means the sweeper already
+// requeued the job, which then runs again.
+func (p *DocumentWorkerPool) record(err error, op string, fields loggerdomain.Fields) {
+	if err == nil {
+		return
+	}
+	logged := loggerdomain.Fields{"operation": op, "error": err.Error()}
+	for k, v := range fields {
+		logged[k] = v
+	}
+	p.logger.Error("failed to update document job", logged)
+}
+
+// isTransient reports whether a processing error is worth retrying
+func isTransient(err error) bool {
+	return errors.Is(err, ocrdomain.ErrTransientError)
+}
+
+// retryDelay doubles the base delay for every attempt already made, up to the
+// maximum, and adds up to 20% jitter so a failed burst does not retry in step.
+func (c JobQueueConfig) retryDelay(attempt int32) time.Duration {
+	delay := c.RetryBaseDelay
+	for i := int32(1); i < attempt && delay < c.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > c.RetryMaxDelay {
+		delay = c.RetryMaxDelay
+	}
+	return delay + rand.N(delay/5+1)
+}
+
+// newWorkerID identifies this process in job leases
+func newWorkerID() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	if len(host) > 64 {
+		host = host[:64]
+	}
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), uuid.NewString()[:8])
+}
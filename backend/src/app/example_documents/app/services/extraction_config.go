@@ -0,0 +1,47 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ExtractionConfig configures field extraction after classification.
+type ExtractionConfig struct {
+	// Enabled turns field extraction on. It runs for documents classified
+	// with at least the classifier's minimum confidence as a type with an
+	// extraction schema.
+	Enabled bool
+	// MaxAttempts bounds the LLM requests per document: the first, then
+	// repairs of replies that do not follow the schema
+	MaxAttempts int
+	// Timeout bounds all attempts together
+	Timeout time.Duration
+}
+
+func (c ExtractionConfig) Validate() error {
+	if c.MaxAttempts < 1 {
+		return fmt.Errorf("DOCUMENT_EXTRACTION_MAX_ATTEMPTS must be at least 1")
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("DOCUMENT_EXTRACTION_TIMEOUT must be positive")
+	}
+	return nil
+}
+
+func NewExtractionConfig() (ExtractionConfig, error) {
+	cfg := ExtractionConfig{}
+	var err error
+
+	if cfg.Enabled, err = strconv.ParseBool(getEnvOrDefault("DOCUMENT_EXTRACTION_ENABLED", "true")); err != nil {
+		return cfg, fmt.Errorf("invalid DOCUMENT_EXTRACTION_ENABLED: %w", err)
+	}
+	if cfg.MaxAttempts, err = strconv.Atoi(getEnvOrDefault("DOCUMENT_EXTRACTION_MAX_ATTEMPTS", "3")); err != nil {
+		return cfg, fmt.Errorf("invalid DOCUMENT_EXTRACTION_MAX_ATTEMPTS: %w", err)
+	}
+	if cfg.Timeout, err = time.ParseDuration(getEnvOrDefault("DOCUMENT_EXTRACTION_TIMEOUT", "2m")); err != nil {
+		return cfg, fmt.Errorf("invalid DOCUMENT_EXTRACTION_TIMEOUT: %w", err)
+	}
+
+	return cfg, cfg.Validate()
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/moasq/backend/app/example_documents/domain"
+	"github.com/moasq/backend/pkg/auth"
 )
 
 // DocumentService defines the interface for document operations
@@ -27,8 +28,71 @@ type DocumentService interface {
 	// GetDocumentStats retrieves document statistics
 	GetDocumentStats(ctx context.Context, orgID int32) (*domain.DocumentStats, error)
 
-	// ProcessDocument processes a document (extract text, etc.)
+	// ProcessDocument processes a document (extract text, etc.). It is run by
+	// the document workers; failures are recorded by the job, not here.
 	ProcessDocument(ctx context.Context, orgID, docID int32) (*domain.Document, error)
+
+	// ReprocessDocument queues a document for processing again
+	ReprocessDocument(ctx context.Context, orgID, docID int32) (*domain.DocumentJob, error)
+
+	// GetDocumentJob retrieves a processing job by ID
+	GetDocumentJob(ctx context.Context, orgID, jobID int32) (*domain.DocumentJob, error)
+
+	// GetDocumentPage retrieves the text and layout of one page (1-based)
+	GetDocumentPage(ctx context.Context, orgID, docID, pageNumber int32) (*DocumentPageResponse, error)
+
+	// ListDocumentJobs lists a document's most recent processing jobs, newest first
+	ListDocumentJobs(ctx context.Context, orgID, docID int32) ([]*domain.DocumentJob, error)
+
+	// LoadAuthAttributes loads a visible document for policy checks; it is
+	// the auth.ResourceLoader of the document resource types
+	LoadAuthAttributes(ctx context.Context, orgID int32, resourceID string) (*auth.ResourceAttributes, error)
+}
+
+// DocumentTypeService manages the document types of an organization and
+// classifies documents into them
+type DocumentTypeService interface {
+	// ListDocumentTypes lists the built-in types followed by the organization's custom types
+	ListDocumentTypes(ctx context.Context, orgID int32) ([]*domain.DocumentType, error)
+
+	// CreateDocumentType adds a custom type
+	CreateDocumentType(ctx context.Context, orgID int32, req *domain.CreateDocumentTypeRequest) (*domain.DocumentType, error)
+
+	// UpdateDocumentType replaces the description and extraction schema of a custom type
+	UpdateDocumentType(ctx context.Context, orgID, typeID int32, req *domain.UpdateDocumentTypeRequest) (*domain.DocumentType, error)
+
+	// DeleteDocumentType removes a custom type
+	DeleteDocumentType(ctx context.Context, orgID, typeID int32) error
+
+	// ClassifyDocument assigns a type to a document with extracted text,
+	// records it in the document metadata, moves the file to the type's file
+	// context when confident, and publishes document.classified. It returns
+	// nil when classification is disabled.
+	ClassifyDocument(ctx context.Context, doc *domain.Document) (*domain.Classification, error)
+}
+
+// DocumentTypesResponse represents the document types of an organization
+type DocumentTypesResponse struct {
+	Types []*domain.DocumentType `json:"types"`
+}
+
+// DocumentFieldService extracts structured fields from classified documents
+// and serves them
+type DocumentFieldService interface {
+	// ExtractFields extracts the fields described by the extraction schema of
+	// the document's type, stores them, and records the outcome in the
+	// document metadata. Fields of an earlier extraction are removed when the
+	// type has no schema or the classification is not confident. It returns
+	// nil when no extraction ran.
+	ExtractFields(ctx context.Context, doc *domain.Document, classification *domain.Classification, pages []*domain.DocumentPage) (*domain.FieldExtraction, error)
+
+	// ListDocumentFields lists the fields extracted from a document
+	ListDocumentFields(ctx context.Context, orgID, docID int32) ([]*domain.DocumentField, error)
+}
+
+// DocumentFieldsResponse represents the fields extracted from a document
+type DocumentFieldsResponse struct {
+	Fields []*domain.DocumentField `json:"fields"`
 }
 
 // UploadDocumentRequest represents a request to upload a document
@@ -38,6 +102,9 @@ type UploadDocumentRequest struct {
 	ContentType string                 `json:"content_type"`
 	FileSize    int64                  `json:"file_size"`
 	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	// TeamID restricts the document to a team the uploader belongs to.
+	// Nil makes it visible to the whole organization.
+	TeamID *int32 `json:"team_id,omitempty"`
 }
 
 // ListDocumentsRequest represents a request to list documents
@@ -55,6 +122,17 @@ type ListDocumentsResponse struct {
 	Offset    int32              `json:"offset"`
 }
 
+// DocumentJobsResponse represents the processing jobs of a document
+type DocumentJobsResponse struct {
+	Jobs []*domain.DocumentJob `json:"jobs"`
+}
+
+// DocumentPageResponse represents one page of a document
+type DocumentPageResponse struct {
+	Page       *domain.DocumentPage `json:"page"`
+	TotalPages int64                `json:"total_pages"`
+}
+
 // UpdateDocumentRequest represents a request to update a document
 type UpdateDocumentRequest struct {
 	Title    string                 `json:"title,omitempty"`
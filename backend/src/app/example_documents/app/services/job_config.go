@@ -0,0 +1,109 @@
+package services
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// JobQueueConfig configures the document processing queue and its workers.
+type JobQueueConfig struct {
+	// Workers is the number of workers per instance. Zero disables processing
+	// on this instance; uploads are still queued for other instances.
+	Workers int
+	// OrgConcurrency caps the running jobs of one organization across all instances
+	OrgConcurrency int32
+	// MaxAttempts bounds retries of transient failures
+	MaxAttempts int32
+	// RetryBaseDelay is the delay before the first retry; it doubles per attempt
+	RetryBaseDelay time.Duration
+	// RetryMaxDelay caps the retry delay
+	RetryMaxDelay time.Duration
+	// VisibilityTimeout is the lease a worker holds on a running job. The
+	// worker renews it while processing; a job whose lease expires (the worker
+	// crashed) is requeued by the sweeper.
+	VisibilityTimeout time.Duration
+	// PollInterval is how often idle workers look for new jobs. Uploads on the
+	// same instance wake a worker immediately.
+	PollInterval time.Duration
+	// SweepInterval is how often expired leases and orphaned documents are recovered
+	SweepInterval time.Duration
+	// DrainTimeout is how long shutdown waits for running jobs before
+	// returning them to the queue
+	DrainTimeout time.Duration
+}
+
+func (c JobQueueConfig) Validate() error {
+	if c.Workers < 0 {
+		return fmt.Errorf("DOCUMENT_WORKERS must not be negative")
+	}
+	if c.OrgConcurrency < 1 {
+		return fmt.Errorf("DOCUMENT_JOB_ORG_CONCURRENCY must be at least 1")
+	}
+	if c.MaxAttempts < 1 {
+		return fmt.Errorf("DOCUMENT_JOB_MAX_ATTEMPTS must be at least 1")
+	}
+	if c.RetryBaseDelay <= 0 || c.RetryMaxDelay < c.RetryBaseDelay {
+		return fmt.Errorf("DOCUMENT_JOB_RETRY_BASE_DELAY must be positive and not exceed DOCUMENT_JOB_RETRY_MAX_DELAY")
+	}
+	if c.VisibilityTimeout < 3*time.Second {
+		return fmt.Errorf("DOCUMENT_JOB_VISIBILITY_TIMEOUT must be at least 3s")
+	}
+	if c.PollInterval <= 0 || c.SweepInterval <= 0 || c.DrainTimeout < 0 {
+		return fmt.Errorf("document job intervals must be positive")
+	}
+	return nil
+}
+
+// StopTimeout bounds stopping the workers: the drain timeout plus time to
+// return interrupted jobs to the queue.
+func (c JobQueueConfig) StopTimeout() time.Duration {
+	return c.DrainTimeout + 2*bookkeepingTimeout
+}
+
+func NewJobQueueConfig() (JobQueueConfig, error) {
+	cfg := JobQueueConfig{}
+	var err error
+
+	if cfg.Workers, err = strconv.Atoi(getEnvOrDefault("DOCUMENT_WORKERS", "4")); err != nil {
+		return cfg, fmt.Errorf("invalid DOCUMENT_WORKERS: %w", err)
+	}
+	orgConcurrency, err := strconv.Atoi(getEnvOrDefault("DOCUMENT_JOB_ORG_CONCURRENCY", "2"))
+	if err != nil {
+		return cfg, fmt.Errorf("invalid DOCUMENT_JOB_ORG_CONCURRENCY: %w", err)
+	}
+	cfg.OrgConcurrency = int32(orgConcurrency)
+	maxAttempts, err := strconv.Atoi(getEnvOrDefault("DOCUMENT_JOB_MAX_ATTEMPTS", "5"))
+	if err != nil {
+		return cfg, fmt.Errorf("invalid DOCUMENT_JOB_MAX_ATTEMPTS: %w", err)
+	}
+	cfg.MaxAttempts = int32(maxAttempts)
+
+	durations := []struct {
+		key   string
+		value string
+		dst   *time.Duration
+	}{
+		{"DOCUMENT_JOB_RETRY_BASE_DELAY", "10s", &cfg.RetryBaseDelay},
+		{"DOCUMENT_JOB_RETRY_MAX_DELAY", "10m", &cfg.RetryMaxDelay},
+		{"DOCUMENT_JOB_VISIBILITY_TIMEOUT", "5m", &cfg.VisibilityTimeout},
+		{"DOCUMENT_JOB_POLL_INTERVAL", "2s", &cfg.PollInterval},
+		{"DOCUMENT_JOB_SWEEP_INTERVAL", "1m", &cfg.SweepInterval},
+		{"DOCUMENT_JOB_DRAIN_TIMEOUT", "10s", &cfg.DrainTimeout},
+	}
+	for _, d := range durations {
+		if *d.dst, err = time.ParseDuration(getEnvOrDefault(d.key, d.value)); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", d.key, err)
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+func getEnvOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
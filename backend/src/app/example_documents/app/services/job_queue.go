@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+
+	"github.com/moasq/backend/app/example_documents/domain"
+)
+
+// DocumentJobQueue enqueues document processing jobs and wakes the workers of
+// this instance. Workers on other instances pick jobs up on their next poll.
+type DocumentJobQueue struct {
+	jobs   domain.DocumentJobRepository
+	config JobQueueConfig
+	wake   chan struct{}
+}
+
+func NewDocumentJobQueue(jobs domain.DocumentJobRepository, config JobQueueConfig) *DocumentJobQueue {
+	return &DocumentJobQueue{
+		jobs:   jobs,
+		config: config,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Enqueue queues a document for processing. It returns
+// domain.ErrDocumentJobActive when the document is already queued or running.
+func (q *DocumentJobQueue) Enqueue(ctx context.Context, orgID, docID int32) (*domain.DocumentJob, error) {
+	job, err := q.jobs.Enqueue(ctx, orgID, docID, q.config.MaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+
+	q.Notify()
+	return job, nil
+}
+
+// Notify wakes one idle worker. It never blocks.
+func (q *DocumentJobQueue) Notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
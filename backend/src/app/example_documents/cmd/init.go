@@ -8,5 +8,13 @@ import (
 
 func Init(container *dig.Container) error {
 	module := documents.NewModule(container)
-	return module.RegisterDependencies()
+	if err := module.RegisterDependencies(); err != nil {
+		return err
+	}
+
+	if err := module.RegisterAuthResources(); err != nil {
+		return err
+	}
+
+	return module.StartBackgroundJobs()
 }
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	llmdomain "github.com/moasq/backend/pkg/llm/domain"
+)
+
+// Built-in document types. Invoice, receipt, contract and report move the
+// file to the file context of the same name; other and custom types leave
+// it in the general context.
+const (
+	DocumentTypeInvoice  = "invoice"
+	DocumentTypeReceipt  = "receipt"
+	DocumentTypeContract = "contract"
+	DocumentTypeReport   = "report"
+	DocumentTypeOther    = "other"
+)
+
+// Classification methods
+const (
+	ClassificationMethodLLM        = "llm"            // The LLM decided alone
+	ClassificationMethodHeuristics = "heuristics"     // Keyword heuristics decided; the LLM failed or disagreed with less confidence
+	ClassificationMethodCombined   = "llm+heuristics" // The LLM and the heuristics agreed
+)
+
+// MaxCustomDocumentTypes bounds the custom types of an organization, which
+// are all part of the classification prompt
+const MaxCustomDocumentTypes = 50
+
+// BuiltInDocumentTypes are available to every organization
+var BuiltInDocumentTypes = []*DocumentType{
+	{Name: DocumentTypeInvoice, Description: "A bill from a vendor requesting payment, with an invoice number, due date, line items and an amount due", BuiltIn: true, ExtractionSchema: InvoiceExtractionSchema},
+	{Name: DocumentTypeReceipt, Description: "Proof of a completed payment or purchase, such as a till receipt or card payment confirmation", BuiltIn: true, ExtractionSchema: ReceiptExtractionSchema},
+	{Name: DocumentTypeContract, Description: "An agreement between parties with terms, obligations and signatures, such as a service agreement, lease or NDA", BuiltIn: true, ExtractionSchema: ContractExtractionSchema},
+	{Name: DocumentTypeReport, Description: "A report or statement presenting information or results, such as a financial statement, audit or analysis", BuiltIn: true},
+	{Name: DocumentTypeOther, Description: "Anything that fits none of the other types", BuiltIn: true},
+}
+
+var documentTypeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// DocumentType is a type documents are classified into. Custom types belong
+// to an organization; built-in types have no ID.
+type DocumentType struct {
+	ID          int32  `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	BuiltIn     bool   `json:"built_in"`
+	// ExtractionSchema describes the fields extracted from documents of the
+	// type; without one only the text is kept
+	ExtractionSchema *llmdomain.Schema `json:"extraction_schema,omitempty"`
+	CreatedAt        *time.Time        `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time        `json:"updated_at,omitempty"`
+}
+
+// IsBuiltInDocumentType reports whether name is a built-in type
+func IsBuiltInDocumentType(name string) bool {
+	for _, t := range BuiltInDocumentTypes {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateDocumentTypeRequest adds a custom type to an organization
+type CreateDocumentTypeRequest struct {
+	Name             string            `json:"name" binding:"required"`
+	Description      string            `json:"description" binding:"required"`
+	ExtractionSchema *llmdomain.Schema `json:"extraction_schema,omitempty"`
+}
+
+// Validate checks the request and trims it in place
+func (r *CreateDocumentTypeRequest) Validate() error {
+	r.Name = strings.TrimSpace(r.Name)
+	if !documentTypeNamePattern.MatchString(r.Name) {
+		return ErrInvalidDocumentTypeName
+	}
+	if IsBuiltInDocumentType(r.Name) {
+		return ErrDocumentTypeReserved
+	}
+	description, err := normalizeTypeDescription(r.Description)
+	if err != nil {
+		return err
+	}
+	r.Description = description
+	return validateExtractionSchema(r.ExtractionSchema)
+}
+
+// UpdateDocumentTypeRequest replaces the description and extraction schema
+// of a custom type; omitting the schema removes it. The name cannot change:
+// classified documents refer to it.
+type UpdateDocumentTypeRequest struct {
+	Description      string            `json:"description" binding:"required"`
+	ExtractionSchema *llmdomain.Schema `json:"extraction_schema,omitempty"`
+}
+
+// Validate checks the request and trims it in place
+func (r *UpdateDocumentTypeRequest) Validate() error {
+	description, err := normalizeTypeDescription(r.Description)
+	if err != nil {
+		return err
+	}
+	r.Description = description
+	return validateExtractionSchema(r.ExtractionSchema)
+}
+
+// validateExtractionSchema checks an optional extraction schema: an object
+// whose top-level properties are the extracted fields
+func validateExtractionSchema(schema *llmdomain.Schema) error {
+	if schema == nil {
+		return nil
+	}
+	if schema.Type != llmdomain.SchemaTypeObject {
+		return fmt.Errorf("%w: the top level must be an object", ErrInvalidExtractionSchema)
+	}
+	for name := range schema.Properties {
+		if !fieldNamePattern.MatchString(name) {
+			return fmt.Errorf("%w: field name %q must start with a letter and contain only letters, digits and underscores (at most 100)", ErrInvalidExtractionSchema, name)
+		}
+	}
+	if err := schema.Check(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidExtractionSchema, err)
+	}
+	return nil
+}
+
+func normalizeTypeDescription(description string) (string, error) {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return "", ErrDocumentTypeDescriptionRequired
+	}
+	if len(description) > 500 {
+		return "", ErrDocumentTypeDescriptionTooLong
+	}
+	return description, nil
+}
+
+// Classification records the type assigned to a document
+type Classification struct {
+	Type         string    `json:"type"`
+	Confidence   float32   `json:"confidence"`
+	Method       string    `json:"method"`
+	FileContext  string    `json:"file_context,omitempty"` // Context the file was moved to; empty when below the confidence threshold
+	Reason       string    `json:"reason,omitempty"`
+	ClassifiedAt time.Time `json:"classified_at"`
+}
+
+// TypePrediction is a classifier's guess at the type of a document
+type TypePrediction struct {
+	Type       string
+	Confidence float32 // 0.0 to 1.0
+	Reason     string
+}
+
+// TypeClassifier predicts the type of a document from its text.
+// Implementation details (LLM providers, prompts) are in the infra layer.
+type TypeClassifier interface {
+	// Classify picks one of types for text. The prediction is always one of
+	// the given type names.
+	Classify(ctx context.Context, text string, types []*DocumentType) (*TypePrediction, error)
+}
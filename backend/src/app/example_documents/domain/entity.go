@@ -14,11 +14,12 @@ const (
 	DocumentStatusFailed     DocumentStatus = "failed"
 )
 
-// Document represents an uploaded document (PDF)
+// Document represents an uploaded document (PDF, image, office document or text)
 type Document struct {
 	ID             int32                  `json:"id"`
 	OrganizationID int32                  `json:"organization_id"`
 	FileAssetID    int32                  `json:"file_asset_id"`
+	TeamID         *int32                 `json:"team_id,omitempty"`
 	Title          string                 `json:"title"`
 	FileName       string                 `json:"file_name"`
 	ContentType    string                 `json:"content_type"`
@@ -28,6 +29,8 @@ type Document struct {
 	Metadata       map[string]interface{} `json:"metadata,omitempty"`
 	CreatedAt      time.Time              `json:"created_at"`
 	UpdatedAt      time.Time              `json:"updated_at"`
+	// Uploader; nil for documents uploaded before it was recorded
+	CreatedByAccountID *int32 `json:"created_by_account_id,omitempty"`
 }
 
 func (d *Document) GetID() int32 {
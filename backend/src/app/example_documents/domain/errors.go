@@ -18,8 +18,29 @@ var (
 	ErrDocumentProcessingFailed = errors.New("document processing failed")
 	ErrTextExtractionFailed     = errors.New("text extraction from document failed")
 
+	// Job queue errors
+	ErrDocumentJobActive    = errors.New("document already has a queued or running job")
+	ErrDocumentJobNotFound  = errors.New("document job not found")
+	ErrNoDocumentJob        = errors.New("no document job available")
+	ErrDocumentJobLeaseLost = errors.New("document job lease lost")
+
+	// Page errors
+	ErrDocumentPageNotFound = errors.New("document page not found")
+
+	// Document type errors
+	ErrDocumentTypeNotFound            = errors.New("document type not found")
+	ErrInvalidDocumentTypeName         = errors.New("document type name must start with a lowercase letter and contain only lowercase letters, digits and underscores (at most 50)")
+	ErrDocumentTypeReserved            = errors.New("document type name is reserved for a built-in type")
+	ErrDocumentTypeExists              = errors.New("document type already exists")
+	ErrDocumentTypeDescriptionRequired = errors.New("document type description is required")
+	ErrDocumentTypeDescriptionTooLong  = errors.New("document type description must be at most 500 characters")
+	ErrDocumentTypeLimitReached        = errors.New("organization has reached the maximum number of document types")
+	ErrClassificationFailed            = errors.New("document classification failed")
+	ErrInvalidExtractionSchema         = errors.New("invalid extraction schema")
+	ErrFieldExtractionFailed           = errors.New("field extraction failed")
+
 	// File errors
-	ErrInvalidFileType     = errors.New("invalid file type: only PDF files are allowed")
+	ErrInvalidFileType     = errors.New("invalid file type: allowed types are PDF, PNG, JPEG, WebP, TIFF, DOCX, XLSX, CSV and TXT")
 	ErrFileTooLarge        = errors.New("file size exceeds maximum allowed limit")
 	ErrFileUploadFailed    = errors.New("failed to upload file")
 	ErrFileDownloadFailed  = errors.New("failed to download file")
@@ -8,9 +8,10 @@ import (
 )
 
 const (
-	DocumentUploadedEventType  = "document.uploaded"
-	DocumentProcessedEventType = "document.processed"
-	DocumentFailedEventType    = "document.failed"
+	DocumentUploadedEventType   = "document.uploaded"
+	DocumentProcessedEventType  = "document.processed"
+	DocumentFailedEventType     = "document.failed"
+	DocumentClassifiedEventType = "document.classified"
 )
 
 // DocumentUploaded is published when a document has been uploaded and text extracted
@@ -82,3 +83,35 @@ func NewDocumentFailed(documentID, organizationID int32, err string) *DocumentFa
 		Error:          err,
 	}
 }
+
+// DocumentClassified is published when a document has been assigned a type
+type DocumentClassified struct {
+	eventbus.BaseEvent
+	DocumentID     int32   `json:"document_id"`
+	OrganizationID int32   `json:"organization_id"`
+	FileAssetID    int32   `json:"file_asset_id"`
+	DocumentType   string  `json:"document_type"`
+	Confidence     float32 `json:"confidence"`
+	Method         string  `json:"method"`
+	// FileContext is the file context the file was moved to; empty when the
+	// confidence was below the threshold
+	FileContext string `json:"file_context,omitempty"`
+}
+
+func NewDocumentClassified(documentID, organizationID, fileAssetID int32, documentType string, confidence float32, method, fileContext string) *DocumentClassified {
+	return &DocumentClassified{
+		BaseEvent: eventbus.BaseEvent{
+			ID:        uuid.New().String(),
+			Name:      DocumentClassifiedEventType,
+			CreatedAt: time.Now(),
+			Meta:      make(map[string]interface{}),
+		},
+		DocumentID:     documentID,
+		OrganizationID: organizationID,
+		FileAssetID:    fileAssetID,
+		DocumentType:   documentType,
+		Confidence:     confidence,
+		Method:         method,
+		FileContext:    fileContext,
+	}
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	llmdomain "github.com/moasq/backend/pkg/llm/domain"
+)
+
+// Extraction schemas of the built-in types. Amounts are numbers in the
+// document's currency; dates are YYYY-MM-DD.
+var (
+	InvoiceExtractionSchema = &llmdomain.Schema{
+		Type: llmdomain.SchemaTypeObject,
+		Properties: map[string]*llmdomain.Schema{
+			"invoice_number": {Type: llmdomain.SchemaTypeString, Description: "Invoice number or reference"},
+			"vendor_name":    {Type: llmdomain.SchemaTypeString, Description: "Company that issued the invoice"},
+			"vendor_address": {Type: llmdomain.SchemaTypeString, Description: "Postal address of the vendor"},
+			"vendor_tax_id":  {Type: llmdomain.SchemaTypeString, Description: "VAT, GST or tax number of the vendor"},
+			"customer_name":  {Type: llmdomain.SchemaTypeString, Description: "Company or person billed"},
+			"issue_date":     {Type: llmdomain.SchemaTypeString, Format: llmdomain.SchemaFormatDate, Description: "Date the invoice was issued"},
+			"due_date":       {Type: llmdomain.SchemaTypeString, Format: llmdomain.SchemaFormatDate, Description: "Date payment is due"},
+			"currency":       currencySchema,
+			"subtotal":       amountSchema("Total before tax"),
+			"tax":            amountSchema("Total tax"),
+			"total":          amountSchema("Amount due, including tax"),
+			"purchase_order": {Type: llmdomain.SchemaTypeString, Description: "Purchase order number the invoice refers to"},
+			"payment_terms":  {Type: llmdomain.SchemaTypeString, Description: "Payment terms, e.g. Net 30"},
+			"line_items":     lineItemsSchema,
+		},
+		Required: []string{"vendor_name", "total"},
+	}
+
+	ReceiptExtractionSchema = &llmdomain.Schema{
+		Type: llmdomain.SchemaTypeObject,
+		Properties: map[string]*llmdomain.Schema{
+			"merchant_name":    {Type: llmdomain.SchemaTypeString, Description: "Shop or business that was paid"},
+			"merchant_address": {Type: llmdomain.SchemaTypeString, Description: "Address of the merchant"},
+			"transaction_date": {Type: llmdomain.SchemaTypeString, Format: llmdomain.SchemaFormatDate, Description: "Date of the purchase"},
+			"currency":         currencySchema,
+			"subtotal":         amountSchema("Total before tax and tip"),
+			"tax":              amountSchema("Total tax"),
+			"tip":              amountSchema("Tip or gratuity"),
+			"total":            amountSchema("Amount paid"),
+			"payment_method":   {Type: llmdomain.SchemaTypeString, Description: "How it was paid, e.g. cash or card ending 1234"},
+			"line_items":       lineItemsSchema,
+		},
+		Required: []string{"merchant_name", "total"},
+	}
+
+	ContractExtractionSchema = &llmdomain.Schema{
+		Type: llmdomain.SchemaTypeObject,
+		Properties: map[string]*llmdomain.Schema{
+			"title": {Type: llmdomain.SchemaTypeString, Description: "Title of the agreement"},
+			"parties": {
+				Type:        llmdomain.SchemaTypeArray,
+				Description: "Parties to the agreement",
+				Items: &llmdomain.Schema{
+					Type: llmdomain.SchemaTypeObject,
+					Properties: map[string]*llmdomain.Schema{
+						"name": {Type: llmdomain.SchemaTypeString, Description: "Legal name of the party"},
+						"role": {Type: llmdomain.SchemaTypeString, Description: "Role in the agreement, e.g. customer, supplier, landlord"},
+					},
+					Required: []string{"name"},
+				},
+				MinItems: intPtr(1),
+			},
+			"effective_date":     {Type: llmdomain.SchemaTypeString, Format: llmdomain.SchemaFormatDate, Description: "Date the agreement takes effect"},
+			"end_date":           {Type: llmdomain.SchemaTypeString, Format: llmdomain.SchemaFormatDate, Description: "Date the agreement ends, when fixed"},
+			"renewal_terms":      {Type: llmdomain.SchemaTypeString, Description: "How the agreement renews, e.g. automatically for one year"},
+			"termination_notice": {Type: llmdomain.SchemaTypeString, Description: "Notice required to terminate, e.g. 30 days written notice"},
+			"governing_law":      {Type: llmdomain.SchemaTypeString, Description: "Jurisdiction whose law governs the agreement"},
+			"currency":           currencySchema,
+			"total_value":        amountSchema("Total value of the agreement"),
+		},
+		Required: []string{"parties"},
+	}
+
+	currencySchema = &llmdomain.Schema{
+		Type:        llmdomain.SchemaTypeString,
+		Description: "ISO 4217 currency code, e.g. USD",
+		MinLength:   intPtr(3),
+		MaxLength:   intPtr(3),
+	}
+
+	lineItemsSchema = &llmdomain.Schema{
+		Type:        llmdomain.SchemaTypeArray,
+		Description: "Items listed on the document, in order",
+		Items: &llmdomain.Schema{
+			Type: llmdomain.SchemaTypeObject,
+			Properties: map[string]*llmdomain.Schema{
+				"description": {Type: llmdomain.SchemaTypeString, Description: "Item or service"},
+				"quantity":    {Type: llmdomain.SchemaTypeNumber},
+				"unit_price":  amountSchema("Price per unit"),
+				"amount":      amountSchema("Line total"),
+			},
+			Required: []string{"description"},
+		},
+	}
+)
+
+func amountSchema(description string) *llmdomain.Schema {
+	return &llmdomain.Schema{Type: llmdomain.SchemaTypeNumber, Description: description}
+}
+
+func intPtr(n int) *int {
+	return &n
+}
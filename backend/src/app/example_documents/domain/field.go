@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	llmdomain "github.com/moasq/backend/pkg/llm/domain"
+)
+
+// Field extraction statuses
+const (
+	FieldExtractionStatusValid   = "valid"   // Every field follows the schema
+	FieldExtractionStatusPartial = "partial" // Fields that still broke the schema after every attempt were dropped
+)
+
+var fieldNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,99}$`)
+
+// DocumentField is a value extracted from a document, such as the total of
+// an invoice. Value holds the JSON value described by the field's schema.
+type DocumentField struct {
+	Name       string    `json:"name"`
+	Value      any       `json:"value"`
+	Confidence float32   `json:"confidence"`            // 0.0 to 1.0
+	PageNumber *int32    `json:"page_number,omitempty"` // Page the value was read from, when known
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// FieldExtraction records the outcome of extracting the fields of a document
+type FieldExtraction struct {
+	DocumentType string    `json:"document_type"`
+	Status       string    `json:"status"`
+	Attempts     int       `json:"attempts"`
+	Violations   []string  `json:"violations,omitempty"` // Schema violations of the last attempt, when partial
+	ExtractedAt  time.Time `json:"extracted_at"`
+}
+
+// FieldExtractionRequest asks a FieldExtractor for the fields of a document
+type FieldExtractionRequest struct {
+	DocumentType *DocumentType
+	Pages        []*DocumentPage
+	// MaxAttempts bounds the requests: the first, then repairs of replies
+	// that do not follow the schema
+	MaxAttempts int
+}
+
+// FieldExtractionResult is the fields a FieldExtractor found
+type FieldExtractionResult struct {
+	Fields     []*DocumentField
+	Attempts   int
+	Violations []llmdomain.SchemaViolation
+}
+
+// FieldExtractor reads the fields described by a document type's extraction
+// schema from the pages of a document.
+// Implementation details (LLM providers, prompts) are in the infra layer.
+type FieldExtractor interface {
+	// Extract returns the fields that follow the schema. When replies still
+	// break the schema after MaxAttempts, the offending fields are left out
+	// and the violations are returned with the rest.
+	Extract(ctx context.Context, req *FieldExtractionRequest) (*FieldExtractionResult, error)
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// mimeTypes maps the accepted file extensions to the MIME type used for
+// storage and text extraction. Images and PDFs go to OCR; office documents,
+// CSV and plain text are read locally.
+var mimeTypes = map[string]string{
+	".pdf":  "application/pdf",
+	".png":  "image/png",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".webp": "image/webp",
+	".tif":  "image/tiff",
+	".tiff": "image/tiff",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".csv":  "text/csv",
+	".txt":  "text/plain",
+}
+
+// MimeTypeForFileName returns the MIME type of an accepted document by its
+// file extension. The declared content type of an upload is not trusted;
+// the file manager checks the content against the extension.
+func MimeTypeForFileName(fileName string) (string, bool) {
+	mimeType, ok := mimeTypes[strings.ToLower(filepath.Ext(fileName))]
+	return mimeType, ok
+}
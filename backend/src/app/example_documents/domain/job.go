@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+)
+
+// JobStatus represents the state of a document processing job
+type JobStatus string
+
+const (
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+)
+
+// DocumentJob is one attempt series at processing a document. A document has
+// at most one queued or running job at a time; finished jobs are kept as
+// history.
+type DocumentJob struct {
+	ID             int32      `json:"id"`
+	DocumentID     int32      `json:"document_id"`
+	OrganizationID int32      `json:"organization_id"`
+	Status         JobStatus  `json:"status"`
+	Attempts       int32      `json:"attempts"`
+	MaxAttempts    int32      `json:"max_attempts"`
+	RunAfter       time.Time  `json:"run_after"`
+	LastError      string     `json:"last_error,omitempty"`
+	StartedAt      *time.Time `json:"started_at,omitempty"`
+	FinishedAt     *time.Time `json:"finished_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// IsActive reports whether the job is still queued or running
+func (j *DocumentJob) IsActive() bool {
+	return j.Status == JobStatusQueued || j.Status == JobStatusRunning
+}
+
+// HasAttemptsLeft reports whether a failed attempt may be retried
+func (j *DocumentJob) HasAttemptsLeft() bool {
+	return j.Attempts < j.MaxAttempts
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"time"
+)
+
+// DocumentPage is the extracted text and layout of one page of a document
+type DocumentPage struct {
+	DocumentID int32       `json:"document_id"`
+	PageNumber int32       `json:"page_number"`
+	Text       string      `json:"text"`
+	Extractor  string      `json:"extractor"`
+	Width      float32     `json:"width,omitempty"`
+	Height     float32     `json:"height,omitempty"`
+	Blocks     []PageBlock `json:"blocks"`
+	Tables     []PageTable `json:"tables"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+// BoundingBox locates a region as fractions (0-1) of the page width and
+// height, with the origin at the top-left corner
+type BoundingBox struct {
+	X      float32 `json:"x"`
+	Y      float32 `json:"y"`
+	Width  float32 `json:"width"`
+	Height float32 `json:"height"`
+}
+
+// PageBlock is a positioned region of a page: a line or block of text, or an image
+type PageBlock struct {
+	Type       string      `json:"type"`
+	Text       string      `json:"text,omitempty"`
+	BBox       BoundingBox `json:"bbox"`
+	Confidence float32     `json:"confidence,omitempty"`
+}
+
+// PageTable is a table found on a page, header row first
+type PageTable struct {
+	BBox *BoundingBox `json:"bbox,omitempty"`
+	Rows [][]string   `json:"rows"`
+}
@@ -1,23 +1,30 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
-// DocumentRepository defines the interface for document data operations
+// DocumentRepository defines the interface for document data operations.
+//
+// Read methods take a viewerID that limits results to documents without a
+// team and documents owned by the viewer's teams; nil sees every document
+// (see auth.TeamScope).
 type DocumentRepository interface {
 	// Create creates a new document
 	Create(ctx context.Context, doc *Document) (*Document, error)
 
 	// GetByID retrieves a document by ID
-	GetByID(ctx context.Context, orgID, docID int32) (*Document, error)
+	GetByID(ctx context.Context, orgID, docID int32, viewerID *int32) (*Document, error)
 
 	// GetByFileAssetID retrieves a document by file asset ID
-	GetByFileAssetID(ctx context.Context, orgID, fileAssetID int32) (*Document, error)
+	GetByFileAssetID(ctx context.Context, orgID, fileAssetID int32, viewerID *int32) (*Document, error)
 
 	// List retrieves documents with pagination
-	List(ctx context.Context, orgID int32, limit, offset int32) ([]*Document, error)
+	List(ctx context.Context, orgID int32, viewerID *int32, limit, offset int32) ([]*Document, error)
 
 	// ListByStatus retrieves documents by status with pagination
-	ListByStatus(ctx context.Context, orgID int32, status DocumentStatus, limit, offset int32) ([]*Document, error)
+	ListByStatus(ctx context.Context, orgID int32, viewerID *int32, status DocumentStatus, limit, offset int32) ([]*Document, error)
 
 	// UpdateStatus updates the document status
 	UpdateStatus(ctx context.Context, orgID, docID int32, status DocumentStatus) (*Document, error)
@@ -32,8 +39,96 @@ type DocumentRepository interface {
 	Delete(ctx context.Context, orgID, docID int32) error
 
 	// Count returns the total count of documents for an organization
-	Count(ctx context.Context, orgID int32) (int64, error)
+	Count(ctx context.Context, orgID int32, viewerID *int32) (int64, error)
 
 	// CountByStatus returns the count of documents with a specific status
-	CountByStatus(ctx context.Context, orgID int32, status DocumentStatus) (int64, error)
+	CountByStatus(ctx context.Context, orgID int32, viewerID *int32, status DocumentStatus) (int64, error)
+}
+
+// DocumentJobRepository defines the persistent document processing queue.
+//
+// Running jobs are leased to a worker. State changes on a running job take
+// the worker's ID and return ErrDocumentJobLeaseLost when the lease has
+// passed to another worker.
+type DocumentJobRepository interface {
+	// Enqueue queues a job for a document. It returns ErrDocumentJobActive when
+	// the document already has a queued or running job.
+	Enqueue(ctx context.Context, orgID, docID, maxAttempts int32) (*DocumentJob, error)
+
+	// EnqueueOrphaned queues up to limit documents that have been pending or
+	// processing for longer than grace without an active job
+	EnqueueOrphaned(ctx context.Context, maxAttempts int32, grace time.Duration, limit int32) ([]*DocumentJob, error)
+
+	// Claim leases the next runnable job of an organization with fewer than
+	// orgConcurrency running jobs. It returns ErrNoDocumentJob when none is runnable.
+	Claim(ctx context.Context, workerID string, lease time.Duration, orgConcurrency int32) (*DocumentJob, error)
+
+	// ExtendLease keeps a running job leased to the worker
+	ExtendLease(ctx context.Context, jobID int32, workerID string, lease time.Duration) error
+
+	// Complete marks a running job succeeded
+	Complete(ctx context.Context, jobID int32, workerID string) error
+
+	// Retry returns a running job to the queue, runnable after delay
+	Retry(ctx context.Context, jobID int32, workerID string, delay time.Duration, lastError string) error
+
+	// Fail marks a running job failed
+	Fail(ctx context.Context, jobID int32, workerID string, lastError string) error
+
+	// Release returns a running job to the queue without using up an attempt
+	Release(ctx context.Context, jobID int32, workerID string) error
+
+	// RequeueExpired returns running jobs with an expired lease to the queue, or
+	// fails them when their attempts are used up
+	RequeueExpired(ctx context.Context) ([]*DocumentJob, error)
+
+	// GetByID retrieves a job by ID
+	GetByID(ctx context.Context, orgID, jobID int32) (*DocumentJob, error)
+
+	// ListByDocument retrieves a document's jobs, newest first
+	ListByDocument(ctx context.Context, orgID, docID, limit int32) ([]*DocumentJob, error)
+}
+
+// DocumentPageRepository stores the per-page text and layout of processed
+// documents.
+type DocumentPageRepository interface {
+	// ReplaceAll stores the pages of a document, replacing those of an earlier run
+	ReplaceAll(ctx context.Context, orgID, docID int32, pages []*DocumentPage) error
+
+	// GetByNumber retrieves a page by its 1-based number
+	GetByNumber(ctx context.Context, orgID, docID, number int32) (*DocumentPage, error)
+
+	// Count returns the number of stored pages of a document
+	Count(ctx context.Context, orgID, docID int32) (int64, error)
+}
+
+// DocumentTypeRepository stores the custom document types of organizations.
+type DocumentTypeRepository interface {
+	// Create adds a custom type. It returns ErrDocumentTypeExists when the
+	// organization already has a type with the name.
+	Create(ctx context.Context, orgID int32, req *CreateDocumentTypeRequest) (*DocumentType, error)
+
+	// GetByID retrieves a custom type
+	GetByID(ctx context.Context, orgID, typeID int32) (*DocumentType, error)
+
+	// List retrieves the custom types of an organization by name
+	List(ctx context.Context, orgID int32) ([]*DocumentType, error)
+
+	// Count returns the number of custom types of an organization
+	Count(ctx context.Context, orgID int32) (int64, error)
+
+	// Update changes the description of a custom type
+	Update(ctx context.Context, orgID, typeID int32, req *UpdateDocumentTypeRequest) (*DocumentType, error)
+
+	// Delete removes a custom type. Documents classified with it keep the name.
+	Delete(ctx context.Context, orgID, typeID int32) error
+}
+
+// DocumentFieldRepository stores the fields extracted from documents.
+type DocumentFieldRepository interface {
+	// ReplaceAll stores the fields of a document, replacing those of an earlier extraction
+	ReplaceAll(ctx context.Context, orgID, docID int32, fields []*DocumentField) error
+
+	// List retrieves the fields of a document by name
+	List(ctx context.Context, orgID, docID int32) ([]*DocumentField, error)
 }
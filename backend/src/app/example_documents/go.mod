@@ -8,6 +8,8 @@ require (
 	github.com/moasq/backend/pkg/db v0.0.0-00010101000000-000000000000
 	github.com/moasq/backend/pkg/eventbus v0.0.0-00010101000000-000000000000
 	github.com/moasq/backend/pkg/file_manager v0.0.0-00010101000000-000000000000
+	github.com/moasq/backend/pkg/lifecycle v0.0.0
+	github.com/moasq/backend/pkg/llm v0.0.0-00010101000000-000000000000
 	github.com/moasq/backend/pkg/logger v0.0.0
 	github.com/moasq/backend/pkg/ocr v0.0.0-00010101000000-000000000000
 	go.uber.org/dig v1.19.0
@@ -15,6 +17,8 @@ require (
 
 require (
 	github.com/gabriel-vasile/mimetype v1.4.10 // indirect
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
 	github.com/jackc/puddle/v2 v2.2.2 // indirect
@@ -23,6 +27,10 @@ require (
 	github.com/pgvector/pgvector-go v0.3.0 // indirect
 	github.com/rs/zerolog v1.33.0 // indirect
 	github.com/shopspring/decimal v1.4.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	go.opentelemetry.io/otel v1.38.0
+	go.opentelemetry.io/otel/metric v1.38.0 // indirect
+	go.opentelemetry.io/otel/trace v1.38.0
 	golang.org/x/crypto v0.36.0 // indirect
 	golang.org/x/sync v0.12.0 // indirect
 	golang.org/x/sys v0.31.0 // indirect
@@ -36,6 +44,10 @@ replace github.com/moasq/backend/pkg/eventbus => ../../pkg/eventbus
 
 replace github.com/moasq/backend/pkg/file_manager => ../../pkg/file_manager
 
+replace github.com/moasq/backend/pkg/lifecycle => ../../pkg/lifecycle
+
+replace github.com/moasq/backend/pkg/llm => ../../pkg/llm
+
 replace github.com/moasq/backend/pkg/logger => ../../pkg/logger
 
 replace github.com/moasq/backend/pkg/ocr => ../../pkg/ocr
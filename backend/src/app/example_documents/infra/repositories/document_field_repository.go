@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/moasq/backend/app/example_documents/domain"
+	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/db/postgres"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+type documentFieldRepository struct {
+	store adapters.DocumentFieldStore
+}
+
+func NewDocumentFieldRepository(store adapters.DocumentFieldStore) domain.DocumentFieldRepository {
+	return &documentFieldRepository{store: store}
+}
+
+func (r *documentFieldRepository) ReplaceAll(ctx context.Context, orgID, docID int32, fields []*domain.DocumentField) error {
+	// Upsert, then trim: a failed run leaves the previous fields readable
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return fmt.Errorf("failed to encode field %s: %w", field.Name, err)
+		}
+
+		if err := r.store.UpsertDocumentField(ctx, sqlc.UpsertDocumentFieldParams{
+			DocumentID:     docID,
+			OrganizationID: orgID,
+			Name:           field.Name,
+			Value:          value,
+			Confidence:     field.Confidence,
+			PageNumber:     postgres.PgInt4(field.PageNumber),
+		}); err != nil {
+			return fmt.Errorf("failed to save field %s: %w", field.Name, err)
+		}
+		names = append(names, field.Name)
+	}
+
+	if err := r.store.DeleteDocumentFieldsExcept(ctx, sqlc.DeleteDocumentFieldsExceptParams{
+		DocumentID: docID,
+		Names:      names,
+	}); err != nil {
+		return fmt.Errorf("failed to delete stale fields: %w", err)
+	}
+
+	return nil
+}
+
+func (r *documentFieldRepository) List(ctx context.Context, orgID, docID int32) ([]*domain.DocumentField, error) {
+	results, err := r.store.ListDocumentFields(ctx, sqlc.ListDocumentFieldsParams{
+		DocumentID:     docID,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document fields: %w", err)
+	}
+
+	fields := make([]*domain.DocumentField, len(results))
+	for i := range results {
+		if fields[i], err = r.mapToDomain(&results[i]); err != nil {
+			return nil, err
+		}
+	}
+	return fields, nil
+}
+
+// mapToDomain maps a database field to a domain field
+func (r *documentFieldRepository) mapToDomain(field *sqlc.DocumentsDocumentField) (*domain.DocumentField, error) {
+	result := &domain.DocumentField{
+		Name:       field.Name,
+		Confidence: field.Confidence,
+		PageNumber: postgres.Int32Ptr(field.PageNumber),
+		UpdatedAt:  field.UpdatedAt.Time,
+	}
+	if err := json.Unmarshal(field.Value, &result.Value); err != nil {
+		return nil, fmt.Errorf("failed to decode field %s: %w", field.Name, err)
+	}
+
+	return result, nil
+}
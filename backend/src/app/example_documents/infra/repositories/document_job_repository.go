@@ -0,0 +1,196 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/moasq/backend/app/example_documents/domain"
+	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/db/postgres"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+type documentJobRepository struct {
+	store adapters.DocumentJobStore
+}
+
+func NewDocumentJobRepository(store adapters.DocumentJobStore) domain.DocumentJobRepository {
+	return &documentJobRepository{store: store}
+}
+
+func (r *documentJobRepository) Enqueue(ctx context.Context, orgID, docID, maxAttempts int32) (*domain.DocumentJob, error) {
+	result, err := r.store.EnqueueDocumentJob(ctx, sqlc.EnqueueDocumentJobParams{
+		DocumentID:     docID,
+		OrganizationID: orgID,
+		MaxAttempts:    maxAttempts,
+	})
+	if err != nil {
+		// ON CONFLICT DO NOTHING returns no row for an active job
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return nil, domain.ErrDocumentJobActive
+		}
+		return nil, fmt.Errorf("failed to enqueue document job: %w", err)
+	}
+
+	return r.mapToDomain(&result), nil
+}
+
+func (r *documentJobRepository) EnqueueOrphaned(ctx context.Context, maxAttempts int32, grace time.Duration, limit int32) ([]*domain.DocumentJob, error) {
+	results, err := r.store.EnqueueOrphanedDocumentJobs(ctx, sqlc.EnqueueOrphanedDocumentJobsParams{
+		MaxAttempts:  maxAttempts,
+		GraceSeconds: seconds(grace),
+		Limit:        limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue orphaned documents: %w", err)
+	}
+
+	return r.mapAllToDomain(results), nil
+}
+
+func (r *documentJobRepository) Claim(ctx context.Context, workerID string, lease time.Duration, orgConcurrency int32) (*domain.DocumentJob, error) {
+	result, err := r.store.ClaimDocumentJob(ctx, sqlc.ClaimDocumentJobParams{
+		WorkerID:       workerID,
+		LeaseSeconds:   seconds(lease),
+		OrgConcurrency: orgConcurrency,
+	})
+	if err != nil {
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return nil, domain.ErrNoDocumentJob
+		}
+		return nil, fmt.Errorf("failed to claim document job: %w", err)
+	}
+
+	return r.mapToDomain(&result), nil
+}
+
+func (r *documentJobRepository) ExtendLease(ctx context.Context, jobID int32, workerID string, lease time.Duration) error {
+	rows, err := r.store.ExtendDocumentJobLease(ctx, sqlc.ExtendDocumentJobLeaseParams{
+		LeaseSeconds: seconds(lease),
+		ID:           jobID,
+		WorkerID:     workerID,
+	})
+	return leased(rows, err, "extend document job lease")
+}
+
+func (r *documentJobRepository) Complete(ctx context.Context, jobID int32, workerID string) error {
+	rows, err := r.store.CompleteDocumentJob(ctx, sqlc.CompleteDocumentJobParams{
+		ID:       jobID,
+		WorkerID: workerID,
+	})
+	return leased(rows, err, "complete document job")
+}
+
+func (r *documentJobRepository) Retry(ctx context.Context, jobID int32, workerID string, delay time.Duration, lastError string) error {
+	rows, err := r.store.RetryDocumentJob(ctx, sqlc.RetryDocumentJobParams{
+		LastError:    toPgText(lastError),
+		DelaySeconds: seconds(delay),
+		ID:           jobID,
+		WorkerID:     workerID,
+	})
+	return leased(rows, err, "retry document job")
+}
+
+func (r *documentJobRepository) Fail(ctx context.Context, jobID int32, workerID string, lastError string) error {
+	rows, err := r.store.FailDocumentJob(ctx, sqlc.FailDocumentJobParams{
+		LastError: toPgText(lastError),
+		ID:        jobID,
+		WorkerID:  workerID,
+	})
+	return leased(rows, err, "fail document job")
+}
+
+func (r *documentJobRepository) Release(ctx context.Context, jobID int32, workerID string) error {
+	rows, err := r.store.ReleaseDocumentJob(ctx, sqlc.ReleaseDocumentJobParams{
+		ID:       jobID,
+		WorkerID: workerID,
+	})
+	return leased(rows, err, "release document job")
+}
+
+func (r *documentJobRepository) RequeueExpired(ctx context.Context) ([]*domain.DocumentJob, error) {
+	results, err := r.store.RequeueExpiredDocumentJobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue expired document jobs: %w", err)
+	}
+
+	return r.mapAllToDomain(results), nil
+}
+
+func (r *documentJobRepository) GetByID(ctx context.Context, orgID, jobID int32) (*domain.DocumentJob, error) {
+	result, err := r.store.GetDocumentJobByID(ctx, sqlc.GetDocumentJobByIDParams{
+		ID:             jobID,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return nil, domain.ErrDocumentJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get document job: %w", err)
+	}
+
+	return r.mapToDomain(&result), nil
+}
+
+func (r *documentJobRepository) ListByDocument(ctx context.Context, orgID, docID, limit int32) ([]*domain.DocumentJob, error) {
+	results, err := r.store.ListDocumentJobsByDocument(ctx, sqlc.ListDocumentJobsByDocumentParams{
+		DocumentID:     docID,
+		OrganizationID: orgID,
+		Limit:          limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document jobs: %w", err)
+	}
+
+	return r.mapAllToDomain(results), nil
+}
+
+func (r *documentJobRepository) mapAllToDomain(results []sqlc.DocumentsDocumentJob) []*domain.DocumentJob {
+	jobs := make([]*domain.DocumentJob, len(results))
+	for i, result := range results {
+		jobs[i] = r.mapToDomain(&result)
+	}
+	return jobs
+}
+
+// mapToDomain maps a database job to a domain job
+func (r *documentJobRepository) mapToDomain(job *sqlc.DocumentsDocumentJob) *domain.DocumentJob {
+	return &domain.DocumentJob{
+		ID:             job.ID,
+		DocumentID:     job.DocumentID,
+		OrganizationID: job.OrganizationID,
+		Status:         domain.JobStatus(job.Status),
+		Attempts:       job.Attempts,
+		MaxAttempts:    job.MaxAttempts,
+		RunAfter:       job.RunAfter.Time,
+		LastError:      fromPgText(job.LastError),
+		StartedAt:      postgres.TimeStampPtr(job.StartedAt),
+		FinishedAt:     postgres.TimeStampPtr(job.FinishedAt),
+		CreatedAt:      job.CreatedAt.Time,
+		UpdatedAt:      job.UpdatedAt.Time,
+	}
+}
+
+// leased maps the affected row count of a state change on a running job:
+// no row means the worker no longer holds the lease.
+func leased(rows int64, err error, op string) error {
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", op, err)
+	}
+	if rows == 0 {
+		return domain.ErrDocumentJobLeaseLost
+	}
+	return nil
+}
+
+// seconds converts a duration to whole seconds for interval arithmetic in
+// SQL, rounding up so short durations are not truncated to zero.
+func seconds(d time.Duration) int32 {
+	if d <= 0 {
+		return 0
+	}
+	return int32(math.Min(math.Ceil(d.Seconds()), math.MaxInt32))
+}
@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/moasq/backend/app/example_documents/domain"
+	"github.com/moasq/backend/pkg/db/adapters"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+type documentPageRepository struct {
+	store adapters.DocumentPageStore
+}
+
+func NewDocumentPageRepository(store adapters.DocumentPageStore) domain.DocumentPageRepository {
+	return &documentPageRepository{store: store}
+}
+
+func (r *documentPageRepository) ReplaceAll(ctx context.Context, orgID, docID int32, pages []*domain.DocumentPage) error {
+	// Upsert, then trim: a failed run leaves the previous pages readable
+	for _, page := range pages {
+		blocks, err := json.Marshal(nonNil(page.Blocks))
+		if err != nil {
+			return fmt.Errorf("failed to encode page %d blocks: %w", page.PageNumber, err)
+		}
+		tables, err := json.Marshal(nonNil(page.Tables))
+		if err != nil {
+			return fmt.Errorf("failed to encode page %d tables: %w", page.PageNumber, err)
+		}
+
+		if err := r.store.UpsertDocumentPage(ctx, sqlc.UpsertDocumentPageParams{
+			DocumentID:     docID,
+			OrganizationID: orgID,
+			PageNumber:     page.PageNumber,
+			Text:           page.Text,
+			Extractor:      page.Extractor,
+			Width:          page.Width,
+			Height:         page.Height,
+			Blocks:         blocks,
+			Tables:         tables,
+		}); err != nil {
+			return fmt.Errorf("failed to save page %d: %w", page.PageNumber, err)
+		}
+	}
+
+	if err := r.store.DeleteDocumentPagesAfter(ctx, sqlc.DeleteDocumentPagesAfterParams{
+		DocumentID: docID,
+		PageNumber: int32(len(pages)),
+	}); err != nil {
+		return fmt.Errorf("failed to delete stale pages: %w", err)
+	}
+
+	return nil
+}
+
+func (r *documentPageRepository) GetByNumber(ctx context.Context, orgID, docID, number int32) (*domain.DocumentPage, error) {
+	result, err := r.store.GetDocumentPage(ctx, sqlc.GetDocumentPageParams{
+		DocumentID:     docID,
+		OrganizationID: orgID,
+		PageNumber:     number,
+	})
+	if err != nil {
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return nil, domain.ErrDocumentPageNotFound
+		}
+		return nil, fmt.Errorf("failed to get document page: %w", err)
+	}
+
+	return r.mapToDomain(&result)
+}
+
+func (r *documentPageRepository) Count(ctx context.Context, orgID, docID int32) (int64, error) {
+	count, err := r.store.CountDocumentPages(ctx, sqlc.CountDocumentPagesParams{
+		DocumentID:     docID,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count document pages: %w", err)
+	}
+
+	return count, nil
+}
+
+// mapToDomain maps a database page to a domain page
+func (r *documentPageRepository) mapToDomain(page *sqlc.DocumentsDocumentPage) (*domain.DocumentPage, error) {
+	result := &domain.DocumentPage{
+		DocumentID: page.DocumentID,
+		PageNumber: page.PageNumber,
+		Text:       page.Text,
+		Extractor:  page.Extractor,
+		Width:      page.Width,
+		Height:     page.Height,
+		UpdatedAt:  page.UpdatedAt.Time,
+	}
+	if err := json.Unmarshal(page.Blocks, &result.Blocks); err != nil {
+		return nil, fmt.Errorf("failed to decode page blocks: %w", err)
+	}
+	if err := json.Unmarshal(page.Tables, &result.Tables); err != nil {
+		return nil, fmt.Errorf("failed to decode page tables: %w", err)
+	}
+
+	return result, nil
+}
+
+// nonNil stores empty lists as [] rather than null
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
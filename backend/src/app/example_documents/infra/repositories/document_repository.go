@@ -2,11 +2,14 @@ package repositories
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/moasq/backend/app/example_documents/domain"
 	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/db/postgres"
 	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
 )
 
@@ -29,6 +32,9 @@ func (r *documentRepository) Create(ctx context.Context, doc *domain.Document) (
 		ExtractedText:  toPgText(doc.ExtractedText),
 		Status:         string(doc.Status),
 		Metadata:       toJSONB(doc.Metadata),
+		TeamID:         postgres.PgInt4(doc.TeamID),
+		// Set on upload only; ownership never changes
+		CreatedByAccountID: postgres.PgInt4(doc.CreatedByAccountID),
 	}
 
 	result, err := r.store.CreateDocument(ctx, params)
@@ -39,39 +45,50 @@ func (r *documentRepository) Create(ctx context.Context, doc *domain.Document) (
 	return r.mapToDomain(&result), nil
 }
 
-func (r *documentRepository) GetByID(ctx context.Context, orgID, docID int32) (*domain.Document, error) {
+func (r *documentRepository) GetByID(ctx context.Context, orgID, docID int32, viewerID *int32) (*domain.Document, error) {
 	params := sqlc.GetDocumentByIDParams{
-		ID:             docID,
-		OrganizationID: orgID,
+		ID:              docID,
+		OrganizationID:  orgID,
+		ViewerAccountID: postgres.PgInt4(viewerID),
 	}
 
 	result, err := r.store.GetDocumentByID(ctx, params)
 	if err != nil {
+		// Documents of other teams are reported as missing
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return nil, domain.ErrDocumentNotFound
+		}
 		return nil, fmt.Errorf("failed to get document: %w", err)
 	}
 
 	return r.mapToDomain(&result), nil
 }
 
-func (r *documentRepository) GetByFileAssetID(ctx context.Context, orgID, fileAssetID int32) (*domain.Document, error) {
+func (r *documentRepository) GetByFileAssetID(ctx context.Context, orgID, fileAssetID int32, viewerID *int32) (*domain.Document, error) {
 	params := sqlc.GetDocumentByFileAssetIDParams{
-		FileAssetID:    fileAssetID,
-		OrganizationID: orgID,
+		FileAssetID:     fileAssetID,
+		OrganizationID:  orgID,
+		ViewerAccountID: postgres.PgInt4(viewerID),
 	}
 
 	result, err := r.store.GetDocumentByFileAssetID(ctx, params)
 	if err != nil {
+		// Documents of other teams are reported as missing
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return nil, domain.ErrDocumentNotFound
+		}
 		return nil, fmt.Errorf("failed to get document by file asset: %w", err)
 	}
 
 	return r.mapToDomain(&result), nil
 }
 
-func (r *documentRepository) List(ctx context.Context, orgID int32, limit, offset int32) ([]*domain.Document, error) {
+func (r *documentRepository) List(ctx context.Context, orgID int32, viewerID *int32, limit, offset int32) ([]*domain.Document, error) {
 	params := sqlc.ListDocumentsByOrganizationParams{
-		OrganizationID: orgID,
-		Limit:          limit,
-		Offset:         offset,
+		OrganizationID:  orgID,
+		ViewerAccountID: postgres.PgInt4(viewerID),
+		Limit:           limit,
+		Offset:          offset,
 	}
 
 	results, err := r.store.ListDocumentsByOrganization(ctx, params)
@@ -87,12 +104,13 @@ func (r *documentRepository) List(ctx context.Context, orgID int32, limit, offse
 	return docs, nil
 }
 
-func (r *documentRepository) ListByStatus(ctx context.Context, orgID int32, status domain.DocumentStatus, limit, offset int32) ([]*domain.Document, error) {
+func (r *documentRepository) ListByStatus(ctx context.Context, orgID int32, viewerID *int32, status domain.DocumentStatus, limit, offset int32) ([]*domain.Document, error) {
 	params := sqlc.ListDocumentsByStatusParams{
-		OrganizationID: orgID,
-		Status:         string(status),
-		Limit:          limit,
-		Offset:         offset,
+		OrganizationID:  orgID,
+		Status:          string(status),
+		ViewerAccountID: postgres.PgInt4(viewerID),
+		Limit:           limit,
+		Offset:          offset,
 	}
 
 	results, err := r.store.ListDocumentsByStatus(ctx, params)
@@ -167,8 +185,13 @@ func (r *documentRepository) Delete(ctx context.Context, orgID, docID int32) err
 	return nil
 }
 
-func (r *documentRepository) Count(ctx context.Context, orgID int32) (int64, error) {
-	count, err := r.store.CountDocumentsByOrganization(ctx, orgID)
+func (r *documentRepository) Count(ctx context.Context, orgID int32, viewerID *int32) (int64, error) {
+	params := sqlc.CountDocumentsByOrganizationParams{
+		OrganizationID:  orgID,
+		ViewerAccountID: postgres.PgInt4(viewerID),
+	}
+
+	count, err := r.store.CountDocumentsByOrganization(ctx, params)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count documents: %w", err)
 	}
@@ -176,10 +199,11 @@ func (r *documentRepository) Count(ctx context.Context, orgID int32) (int64, err
 	return count, nil
 }
 
-func (r *documentRepository) CountByStatus(ctx context.Context, orgID int32, status domain.DocumentStatus) (int64, error) {
+func (r *documentRepository) CountByStatus(ctx context.Context, orgID int32, viewerID *int32, status domain.DocumentStatus) (int64, error) {
 	params := sqlc.CountDocumentsByStatusParams{
-		OrganizationID: orgID,
-		Status:         string(status),
+		OrganizationID:  orgID,
+		Status:          string(status),
+		ViewerAccountID: postgres.PgInt4(viewerID),
 	}
 
 	count, err := r.store.CountDocumentsByStatus(ctx, params)
@@ -196,6 +220,7 @@ func (r *documentRepository) mapToDomain(doc *sqlc.DocumentsDocument) *domain.Do
 		ID:             doc.ID,
 		OrganizationID: doc.OrganizationID,
 		FileAssetID:    doc.FileAssetID,
+		TeamID:         postgres.Int32Ptr(doc.TeamID),
 		Title:          doc.Title,
 		FileName:       doc.FileName,
 		ContentType:    doc.ContentType,
@@ -205,6 +230,8 @@ func (r *documentRepository) mapToDomain(doc *sqlc.DocumentsDocument) *domain.Do
 		Metadata:       fromJSONB(doc.Metadata),
 		CreatedAt:      doc.CreatedAt.Time,
 		UpdatedAt:      doc.UpdatedAt.Time,
+		// NULL for documents uploaded before ownership was recorded
+		CreatedByAccountID: postgres.Int32Ptr(doc.CreatedByAccountID),
 	}
 }
 
@@ -227,14 +254,20 @@ func toJSONB(m map[string]interface{}) []byte {
 	if m == nil {
 		return []byte("{}")
 	}
-	// In production, use proper JSON marshaling
-	return []byte("{}")
+	b, err := json.Marshal(m)
+	if err != nil {
+		return []byte("{}")
+	}
+	return b
 }
 
 func fromJSONB(b []byte) map[string]interface{} {
-	if b == nil {
+	if len(b) == 0 {
 		return nil
 	}
-	// In production, use proper JSON unmarshaling
-	return nil
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil
+	}
+	return m
 }
@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/moasq/backend/app/example_documents/domain"
+	"github.com/moasq/backend/pkg/db/adapters"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+	llmdomain "github.com/moasq/backend/pkg/llm/domain"
+)
+
+type documentTypeRepository struct {
+	store adapters.DocumentTypeStore
+}
+
+func NewDocumentTypeRepository(store adapters.DocumentTypeStore) domain.DocumentTypeRepository {
+	return &documentTypeRepository{store: store}
+}
+
+func (r *documentTypeRepository) Create(ctx context.Context, orgID int32, req *domain.CreateDocumentTypeRequest) (*domain.DocumentType, error) {
+	schema, err := encodeSchema(req.ExtractionSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.store.CreateDocumentType(ctx, sqlc.CreateDocumentTypeParams{
+		OrganizationID:   orgID,
+		Name:             req.Name,
+		Description:      req.Description,
+		ExtractionSchema: schema,
+	})
+	if err != nil {
+		if sqlc.ErrorCode(err) == sqlc.UniqueViolation {
+			return nil, domain.ErrDocumentTypeExists
+		}
+		return nil, fmt.Errorf("failed to create document type: %w", err)
+	}
+
+	return r.mapToDomain(&result)
+}
+
+func (r *documentTypeRepository) GetByID(ctx context.Context, orgID, typeID int32) (*domain.DocumentType, error) {
+	result, err := r.store.GetDocumentType(ctx, sqlc.GetDocumentTypeParams{
+		ID:             typeID,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return nil, domain.ErrDocumentTypeNotFound
+		}
+		return nil, fmt.Errorf("failed to get document type: %w", err)
+	}
+
+	return r.mapToDomain(&result)
+}
+
+func (r *documentTypeRepository) List(ctx context.Context, orgID int32) ([]*domain.DocumentType, error) {
+	results, err := r.store.ListDocumentTypes(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document types: %w", err)
+	}
+
+	types := make([]*domain.DocumentType, len(results))
+	for i := range results {
+		if types[i], err = r.mapToDomain(&results[i]); err != nil {
+			return nil, err
+		}
+	}
+	return types, nil
+}
+
+func (r *documentTypeRepository) Count(ctx context.Context, orgID int32) (int64, error) {
+	count, err := r.store.CountDocumentTypes(ctx, orgID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count document types: %w", err)
+	}
+	return count, nil
+}
+
+func (r *documentTypeRepository) Update(ctx context.Context, orgID, typeID int32, req *domain.UpdateDocumentTypeRequest) (*domain.DocumentType, error) {
+	schema, err := encodeSchema(req.ExtractionSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.store.UpdateDocumentType(ctx, sqlc.UpdateDocumentTypeParams{
+		ID:               typeID,
+		OrganizationID:   orgID,
+		Description:      req.Description,
+		ExtractionSchema: schema,
+	})
+	if err != nil {
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return nil, domain.ErrDocumentTypeNotFound
+		}
+		return nil, fmt.Errorf("failed to update document type: %w", err)
+	}
+
+	return r.mapToDomain(&result)
+}
+
+func (r *documentTypeRepository) Delete(ctx context.Context, orgID, typeID int32) error {
+	deleted, err := r.store.DeleteDocumentType(ctx, sqlc.DeleteDocumentTypeParams{
+		ID:             typeID,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete document type: %w", err)
+	}
+	if deleted == 0 {
+		return domain.ErrDocumentTypeNotFound
+	}
+	return nil
+}
+
+func (r *documentTypeRepository) mapToDomain(t *sqlc.DocumentsDocumentType) (*domain.DocumentType, error) {
+	createdAt := t.CreatedAt.Time
+	updatedAt := t.UpdatedAt.Time
+	result := &domain.DocumentType{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		CreatedAt:   &createdAt,
+		UpdatedAt:   &updatedAt,
+	}
+	if t.ExtractionSchema != nil {
+		if err := json.Unmarshal(t.ExtractionSchema, &result.ExtractionSchema); err != nil {
+			return nil, fmt.Errorf("failed to decode extraction schema of document type %d: %w", t.ID, err)
+		}
+	}
+
+	return result, nil
+}
+
+// encodeSchema stores a missing schema as NULL
+func encodeSchema(schema *llmdomain.Schema) ([]byte, error) {
+	if schema == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode extraction schema: %w", err)
+	}
+	return data, nil
+}
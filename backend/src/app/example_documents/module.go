@@ -1,14 +1,21 @@
 package documents
 
 import (
+	"context"
+
 	"go.uber.org/dig"
 
+	audit "github.com/moasq/backend/app/audit/domain"
 	"github.com/moasq/backend/app/example_documents/app/services"
 	"github.com/moasq/backend/app/example_documents/domain"
+	"github.com/moasq/backend/app/example_documents/infra/ai"
 	"github.com/moasq/backend/app/example_documents/infra/repositories"
+	"github.com/moasq/backend/pkg/auth"
 	"github.com/moasq/backend/pkg/db/adapters"
 	"github.com/moasq/backend/pkg/eventbus"
 	filedomain "github.com/moasq/backend/pkg/file_manager/domain"
+	"github.com/moasq/backend/pkg/lifecycle"
+	llmdomain "github.com/moasq/backend/pkg/llm/domain"
 	"github.com/moasq/backend/pkg/logger"
 	ocrdomain "github.com/moasq/backend/pkg/ocr/domain"
 )
@@ -35,18 +42,148 @@ func (m *Module) RegisterDependencies() error {
 		return err
 	}
 
+	// Register document job repository
+	if err := m.container.Provide(func(
+		jobStore adapters.DocumentJobStore,
+	) domain.DocumentJobRepository {
+		return repositories.NewDocumentJobRepository(jobStore)
+	}); err != nil {
+		return err
+	}
+
+	// Register document page repository
+	if err := m.container.Provide(func(
+		pageStore adapters.DocumentPageStore,
+	) domain.DocumentPageRepository {
+		return repositories.NewDocumentPageRepository(pageStore)
+	}); err != nil {
+		return err
+	}
+
+	// Register document type repository
+	if err := m.container.Provide(func(
+		typeStore adapters.DocumentTypeStore,
+	) domain.DocumentTypeRepository {
+		return repositories.NewDocumentTypeRepository(typeStore)
+	}); err != nil {
+		return err
+	}
+
+	// Register document field repository
+	if err := m.container.Provide(func(
+		fieldStore adapters.DocumentFieldStore,
+	) domain.DocumentFieldRepository {
+		return repositories.NewDocumentFieldRepository(fieldStore)
+	}); err != nil {
+		return err
+	}
+
+	// Register LLM type classifier (infra layer)
+	if err := m.container.Provide(func(
+		llmClient llmdomain.LLMClient,
+	) domain.TypeClassifier {
+		return ai.NewTypeClassifier(llmClient)
+	}); err != nil {
+		return err
+	}
+
+	// Register classifier configuration
+	if err := m.container.Provide(services.NewClassifierConfig); err != nil {
+		return err
+	}
+
+	// Register document type service
+	if err := m.container.Provide(services.NewDocumentTypeService); err != nil {
+		return err
+	}
+
+	// Register LLM field extractor (infra layer)
+	if err := m.container.Provide(func(
+		llmClient llmdomain.LLMClient,
+	) domain.FieldExtractor {
+		return ai.NewFieldExtractor(llmClient)
+	}); err != nil {
+		return err
+	}
+
+	// Register extraction configuration
+	if err := m.container.Provide(services.NewExtractionConfig); err != nil {
+		return err
+	}
+
+	// Register document field service
+	if err := m.container.Provide(services.NewDocumentFieldService); err != nil {
+		return err
+	}
+
+	// Register job queue configuration
+	if err := m.container.Provide(services.NewJobQueueConfig); err != nil {
+		return err
+	}
+
+	// Register job queue
+	if err := m.container.Provide(services.NewDocumentJobQueue); err != nil {
+		return err
+	}
+
 	// Register document service
 	if err := m.container.Provide(func(
 		docRepo domain.DocumentRepository,
 		fileService filedomain.FileService,
 		ocrService ocrdomain.OCRService,
+		teamAccess auth.TeamAccess,
 		eventBus eventbus.EventBus,
+		auditRecorder audit.Recorder,
 		logger logger.Logger,
+		queue *services.DocumentJobQueue,
+		jobs domain.DocumentJobRepository,
+		pages domain.DocumentPageRepository,
+		types services.DocumentTypeService,
+		fields services.DocumentFieldService,
 	) services.DocumentService {
-		return services.NewDocumentService(docRepo, fileService, ocrService, eventBus, logger)
+		return services.NewDocumentService(docRepo, fileService, ocrService, teamAccess, eventBus, auditRecorder, logger, queue, jobs, pages, types, fields)
 	}); err != nil {
 		return err
 	}
 
+	// Register worker pool
+	if err := m.container.Provide(services.NewDocumentWorkerPool); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// RegisterAuthResources registers the document loader with the auth
+// resource registry and the document policy with the policy engine, so
+// policies and permission checks can load documents.
+func (m *Module) RegisterAuthResources() error {
+	return m.container.Invoke(func(
+		registry *auth.ResourceRegistry,
+		policies *auth.PolicyEngine,
+		service services.DocumentService,
+	) {
+		registry.Register(services.AuthDocumentType, auth.ResourceLoaderFunc(service.LoadAuthAttributes))
+		policies.Register(services.DocumentPolicy)
+	})
+}
+
+// StartBackgroundJobs registers the document workers with the lifecycle
+// manager.
+func (m *Module) StartBackgroundJobs() error {
+	return m.container.Invoke(func(
+		pool *services.DocumentWorkerPool,
+		config services.JobQueueConfig,
+		manager *lifecycle.Manager,
+	) error {
+		return manager.Append(lifecycle.Hook{
+			Name: "documents.workers",
+			OnStart: func(context.Context) error {
+				pool.Start()
+				return nil
+			},
+			OnStop:  pool.Stop,
+			Timeout: config.StopTimeout(),
+		})
+	})
+}
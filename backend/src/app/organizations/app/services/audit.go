@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+
+	audit "github.com/moasq/backend/app/audit/domain"
+	loggerDomain "github.com/moasq/backend/pkg/logger"
+)
+
+// Audit actions recorded directly by organization services. Actions for
+// published events use the event name (see domain/events/audit.go).
+const (
+	auditActionMemberAdded          = "member.added"
+	auditActionMemberRemoved        = "member.removed"
+	auditActionMemberJITProvisioned = "member.jit_provisioned"
+	auditActionSettingsUpdated      = "organization.settings_updated"
+
+	auditActionSecurityPolicyUpdated = "organization.security_policy_updated"
+
+	auditActionSSOConnectionCreated    = "sso.connection_created"
+	auditActionSSOConnectionUpdated    = "sso.connection_updated"
+	auditActionSSOConnectionDeleted    = "sso.connection_deleted"
+	auditActionSSODefaultConnectionSet = "sso.default_connection_set"
+	auditActionSSORequiredUpdated      = "sso.required_updated"
+
+	auditActionDomainClaimed    = "domain.claimed"
+	auditActionDomainVerified   = "domain.verified"
+	auditActionDomainJITUpdated = "domain.jit_updated"
+	auditActionDomainRemoved    = "domain.removed"
+
+	auditActionTeamCreated           = "team.created"
+	auditActionTeamUpdated           = "team.updated"
+	auditActionTeamDeleted           = "team.deleted"
+	auditActionTeamMemberAdded       = "team.member_added"
+	auditActionTeamMemberRoleUpdated = "team.member_role_updated"
+	auditActionTeamMemberRemoved     = "team.member_removed"
+
+	auditTargetMember        = "member"
+	auditTargetSSOConnection = "sso_connection"
+	auditTargetTeam          = "team"
+)
+
+// recordAudit writes an audit entry. The audited change has already been
+// applied, so a failure is logged rather than returned to the caller.
+func recordAudit(ctx context.Context, recorder audit.Recorder, logger loggerDomain.Logger, entry audit.Entry) {
+	if err := recorder.Record(ctx, entry); err != nil {
+		logger.Warn("failed to record audit entry", loggerDomain.Fields{
+			"organization_id": entry.OrganizationID,
+			"action":          entry.Action,
+			"error":           err.Error(),
+		})
+	}
+}
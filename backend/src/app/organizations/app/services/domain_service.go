@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/auth"
+)
+
+// DomainService manages the email domains organizations claim and the
+// just-in-time (JIT) membership verified domains enable.
+type DomainService interface {
+	// ListDomains returns the organization's claimed domains.
+	ListDomains(ctx context.Context, orgID int32) ([]*domain.OrganizationDomain, error)
+
+	// ClaimDomain claims an unverified domain. The returned verification record
+	// must be published in DNS before VerifyDomain succeeds.
+	ClaimDomain(ctx context.Context, orgID int32, req *domain.ClaimDomainRequest) (*domain.OrganizationDomain, error)
+
+	// VerifyDomain checks the domain's DNS TXT record and marks it verified.
+	// A domain can be verified by only one organization.
+	VerifyDomain(ctx context.Context, orgID, domainID int32) (*domain.OrganizationDomain, error)
+
+	// UpdateJIT turns JIT provisioning on or off and sets the role provisioned
+	// members receive. Enabling it requires a verified domain.
+	UpdateJIT(ctx context.Context, orgID, domainID int32, req *domain.UpdateDomainJITRequest) (*domain.OrganizationDomain, error)
+
+	// RemoveDomain deletes the claim. Existing members are not affected.
+	RemoveDomain(ctx context.Context, orgID, domainID int32) error
+
+	// FindDomainOwner returns the organization that verified the email's
+	// domain, or nil when no organization has.
+	FindDomainOwner(ctx context.Context, email string) (*domain.DomainOwner, error)
+
+	// ProvisionAccount creates an account for an authenticated user from a
+	// verified JIT domain, within the organization's seat limit.
+	// It implements auth.AccountProvisioner.
+	ProvisionAccount(ctx context.Context, orgID int32, identity *auth.Identity) (int32, error)
+}
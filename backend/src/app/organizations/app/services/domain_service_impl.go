@@ -0,0 +1,387 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	audit "github.com/moasq/backend/app/audit/domain"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/app/organizations/domain/events"
+	"github.com/moasq/backend/pkg/auth"
+	loggerDomain "github.com/moasq/backend/pkg/logger"
+)
+
+// domainVerificationTokenBytes is the entropy of a domain verification token.
+const domainVerificationTokenBytes = 24
+
+type domainService struct {
+	domainRepo       domain.OrganizationDomainRepository
+	verifier         domain.DomainVerifier
+	seatLimits       domain.SeatLimitRepository
+	authOrgRepo      domain.AuthOrganizationRepository
+	authMemberRepo   domain.AuthMemberRepository
+	localOrgRepo     domain.OrganizationRepository
+	localAccountRepo domain.AccountRepository
+	auditRecorder    audit.Recorder
+	logger           loggerDomain.Logger
+}
+
+func NewDomainService(
+	domainRepo domain.OrganizationDomainRepository,
+	verifier domain.DomainVerifier,
+	seatLimits domain.SeatLimitRepository,
+	authOrgRepo domain.AuthOrganizationRepository,
+	authMemberRepo domain.AuthMemberRepository,
+	localOrgRepo domain.OrganizationRepository,
+	localAccountRepo domain.AccountRepository,
+	auditRecorder audit.Recorder,
+	logger loggerDomain.Logger,
+) DomainService {
+	return &domainService{
+		domainRepo:       domainRepo,
+		verifier:         verifier,
+		seatLimits:       seatLimits,
+		authOrgRepo:      authOrgRepo,
+		authMemberRepo:   authMemberRepo,
+		localOrgRepo:     localOrgRepo,
+		localAccountRepo: localAccountRepo,
+		auditRecorder:    auditRecorder,
+		logger:           logger,
+	}
+}
+
+func (s *domainService) ListDomains(ctx context.Context, orgID int32) ([]*domain.OrganizationDomain, error) {
+	return s.domainRepo.List(ctx, orgID)
+}
+
+func (s *domainService) ClaimDomain(ctx context.Context, orgID int32, req *domain.ClaimDomainRequest) (*domain.OrganizationDomain, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if owner, err := s.domainRepo.GetVerified(ctx, req.Domain); err == nil && owner.OrganizationID != orgID {
+		return nil, domain.ErrDomainOwnedElsewhere
+	} else if err != nil && !errors.Is(err, domain.ErrDomainNotFound) {
+		return nil, err
+	}
+
+	token, err := newDomainVerificationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := s.domainRepo.Create(ctx, orgID, req.Domain, token)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("organization domain claimed", loggerDomain.Fields{
+		"organization_id": orgID,
+		"domain":          claimed.Domain,
+	})
+
+	s.recordDomainAudit(ctx, auditActionDomainClaimed, claimed, nil)
+
+	return claimed, nil
+}
+
+func (s *domainService) VerifyDomain(ctx context.Context, orgID, domainID int32) (*domain.OrganizationDomain, error) {
+	claimed, err := s.domainRepo.Get(ctx, orgID, domainID)
+	if err != nil {
+		return nil, err
+	}
+	if claimed.Verified {
+		return claimed, nil
+	}
+
+	ok, err := s.verifier.Verify(ctx, claimed.Domain, claimed.VerificationToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify domain: %w", err)
+	}
+	if !ok {
+		return nil, domain.ErrDomainVerificationFailed
+	}
+
+	verified, err := s.domainRepo.MarkVerified(ctx, orgID, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("organization domain verified", loggerDomain.Fields{
+		"organization_id": orgID,
+		"domain":          verified.Domain,
+	})
+
+	s.recordDomainAudit(ctx, auditActionDomainVerified, verified, nil)
+
+	return verified, nil
+}
+
+func (s *domainService) UpdateJIT(ctx context.Context, orgID, domainID int32, req *domain.UpdateDomainJITRequest) (*domain.OrganizationDomain, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	before, err := s.domainRepo.Get(ctx, orgID, domainID)
+	if err != nil {
+		return nil, err
+	}
+	if *req.JITEnabled && !before.Verified {
+		return nil, domain.ErrDomainNotVerified
+	}
+
+	updated, err := s.domainRepo.UpdateJIT(ctx, orgID, domainID, *req.JITEnabled, req.DefaultRole)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.syncAuthJITDomains(ctx, orgID); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("organization domain jit settings updated", loggerDomain.Fields{
+		"organization_id": orgID,
+		"domain":          updated.Domain,
+		"jit_enabled":     updated.JITEnabled,
+		"default_role":    updated.DefaultRole,
+	})
+
+	changes := audit.Diff(
+		map[string]any{"jit_enabled": before.JITEnabled, "default_role": before.DefaultRole},
+		map[string]any{"jit_enabled": updated.JITEnabled, "default_role": updated.DefaultRole},
+	)
+	if len(changes) > 0 {
+		s.recordDomainAudit(ctx, auditActionDomainJITUpdated, updated, changes)
+	}
+
+	return updated, nil
+}
+
+func (s *domainService) RemoveDomain(ctx context.Context, orgID, domainID int32) error {
+	claimed, err := s.domainRepo.Get(ctx, orgID, domainID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.domainRepo.Delete(ctx, orgID, domainID); err != nil {
+		return err
+	}
+
+	if claimed.JITActive() {
+		if err := s.syncAuthJITDomains(ctx, orgID); err != nil {
+			return err
+		}
+	}
+
+	s.logger.Info("organization domain removed", loggerDomain.Fields{
+		"organization_id": orgID,
+		"domain":          claimed.Domain,
+	})
+
+	s.recordDomainAudit(ctx, auditActionDomainRemoved, claimed, nil)
+
+	return nil
+}
+
+func (s *domainService) FindDomainOwner(ctx context.Context, email string) (*domain.DomainOwner, error) {
+	emailDomain := domain.EmailDomain(email)
+	if emailDomain == "" {
+		return nil, nil
+	}
+
+	claimed, err := s.domainRepo.GetVerified(ctx, emailDomain)
+	if err != nil {
+		if errors.Is(err, domain.ErrDomainNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	org, err := s.localOrgRepo.GetByID(ctx, claimed.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain organization: %w", err)
+	}
+
+	return &domain.DomainOwner{
+		OrganizationID: org.ID,
+		Slug:           org.Slug,
+		Name:           org.Name,
+		Domain:         claimed.Domain,
+		JITEnabled:     claimed.JITEnabled,
+	}, nil
+}
+
+func (s *domainService) ProvisionAccount(ctx context.Context, orgID int32, identity *auth.Identity) (int32, error) {
+	// Another request (or the auth provider's webhook) may have created it already
+	if existing, err := s.localAccountRepo.GetByEmail(ctx, orgID, identity.Email); err == nil {
+		return existing.ID, nil
+	} else if !errors.Is(err, domain.ErrAccountNotFound) {
+		return 0, err
+	}
+
+	// The user must have proven control of an address in a verified JIT domain
+	emailDomain := domain.EmailDomain(identity.Email)
+	if emailDomain == "" || !emailProven(identity) {
+		return 0, auth.ErrAccountNotFound
+	}
+	claimed, err := s.domainRepo.GetVerified(ctx, emailDomain)
+	if err != nil {
+		if errors.Is(err, domain.ErrDomainNotFound) {
+			return 0, auth.ErrAccountNotFound
+		}
+		return 0, err
+	}
+	if claimed.OrganizationID != orgID || !claimed.JITActive() {
+		return 0, auth.ErrAccountNotFound
+	}
+
+	if err := s.checkSeatAvailable(ctx, orgID); err != nil {
+		return 0, err
+	}
+
+	account, err := s.localAccountRepo.Create(ctx, &domain.Account{
+		OrganizationID:      orgID,
+		Email:               identity.Email,
+		FullName:            identity.Email,
+		StytchMemberID:      identity.UserID,
+		StytchRoleSlug:      claimed.DefaultRole,
+		StytchEmailVerified: identity.EmailVerified,
+		Role:                mapRoleSlugToAccountRole(claimed.DefaultRole),
+		Status:              "active",
+	})
+	if err != nil {
+		// Lost a race with a concurrent request for the same user
+		if existing, getErr := s.localAccountRepo.GetByEmail(ctx, orgID, identity.Email); getErr == nil {
+			return existing.ID, nil
+		}
+		return 0, fmt.Errorf("failed to create jit account: %w", err)
+	}
+
+	// The auth provider gives new members its default role; keep it in step so
+	// reconciliation does not revert the local role.
+	if claimed.DefaultRole != domain.DefaultJITRole && identity.OrganizationID != "" {
+		if err := s.authMemberRepo.AssignRoles(ctx, &domain.AssignAuthRolesRequest{
+			OrganizationID: identity.OrganizationID,
+			MemberID:       identity.UserID,
+			Roles:          []string{claimed.DefaultRole},
+		}); err != nil {
+			s.logger.Warn("failed to assign jit role at auth provider", loggerDomain.Fields{
+				"organization_id": orgID,
+				"member_id":       identity.UserID,
+				"role":            claimed.DefaultRole,
+				"error":           err.Error(),
+			})
+		}
+	}
+
+	s.logger.Info("member provisioned just in time", loggerDomain.Fields{
+		"organization_id": orgID,
+		"account_id":      account.ID,
+		"domain":          claimed.Domain,
+		"role":            claimed.DefaultRole,
+	})
+
+	recordAudit(ctx, s.auditRecorder, s.logger, audit.Entry{
+		OrganizationID: orgID,
+		Action:         auditActionMemberJITProvisioned,
+		TargetType:     auditTargetMember,
+		TargetID:       identity.UserID,
+		Actor:          &audit.Actor{Type: audit.ActorUser, AccountID: account.ID, Email: identity.Email},
+		Changes:        audit.FieldChange("role", nil, claimed.DefaultRole),
+		Metadata:       map[string]any{"email": identity.Email, "account_id": account.ID, "domain": claimed.Domain},
+	})
+
+	return account.ID, nil
+}
+
+// checkSeatAvailable returns auth.ErrSeatLimitReached when the organization's
+// active accounts already fill its subscription's seats.
+func (s *domainService) checkSeatAvailable(ctx context.Context, orgID int32) error {
+	maxSeats, err := s.seatLimits.GetMaxSeats(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if maxSeats <= 0 {
+		return nil
+	}
+
+	stats, err := s.localOrgRepo.GetStats(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to count organization seats: %w", err)
+	}
+	if stats.ActiveAccountCount >= int64(maxSeats) {
+		s.logger.Warn("jit provisioning refused, seat limit reached", loggerDomain.Fields{
+			"organization_id": orgID,
+			"max_seats":       maxSeats,
+			"active_accounts": stats.ActiveAccountCount,
+		})
+		return auth.ErrSeatLimitReached
+	}
+	return nil
+}
+
+// syncAuthJITDomains pushes the organization's verified JIT domains to the auth
+// provider so it lets users from those domains sign in. Organizations not
+// linked to the provider are skipped.
+func (s *domainService) syncAuthJITDomains(ctx context.Context, orgID int32) error {
+	org, err := s.localOrgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if org.StytchOrgID == "" {
+		return nil
+	}
+
+	claims, err := s.domainRepo.List(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	domains := make([]string, 0, len(claims))
+	for _, claimed := range claims {
+		if claimed.JITActive() {
+			domains = append(domains, claimed.Domain)
+		}
+	}
+
+	if err := s.authOrgRepo.SetEmailJITDomains(ctx, org.StytchOrgID, domains); err != nil {
+		return fmt.Errorf("failed to update auth provider jit domains: %w", err)
+	}
+	return nil
+}
+
+func (s *domainService) recordDomainAudit(ctx context.Context, action string, claimed *domain.OrganizationDomain, changes map[string]audit.Change) {
+	recordAudit(ctx, s.auditRecorder, s.logger, audit.Entry{
+		OrganizationID: claimed.OrganizationID,
+		Action:         action,
+		TargetType:     events.AuditTargetOrganization,
+		TargetID:       strconv.FormatInt(int64(claimed.OrganizationID), 10),
+		Changes:        changes,
+		Metadata:       map[string]any{"domain": claimed.Domain, "domain_id": claimed.ID},
+	})
+}
+
+// emailProven reports whether the session proves the user controls their email
+// address: the provider marked it verified, or the user logged in through it.
+func emailProven(identity *auth.Identity) bool {
+	if identity.EmailVerified {
+		return true
+	}
+	for _, method := range identity.AuthMethods {
+		if method == auth.AuthMethodMagicLink || method == auth.AuthMethodEmailOTP {
+			return true
+		}
+	}
+	return false
+}
+
+func newDomainVerificationToken() (string, error) {
+	buf := make([]byte, domainVerificationTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate domain verification token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
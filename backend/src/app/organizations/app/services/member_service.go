@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"strings"
+
+	"github.com/moasq/backend/app/organizations/domain"
 )
 
 // MemberService defines the core authentication and member management operations
@@ -158,3 +160,10 @@ type ProfileOrganization struct {
 type CheckEmailRequest struct {
 	Email string `form:"email" binding:"required,email"`
 }
+
+// CheckEmailResponse reports whether an email has an account and which
+// organization, if any, verified its domain
+type CheckEmailResponse struct {
+	Exists             bool                `json:"exists"`
+	DomainOrganization *domain.DomainOwner `json:"domain_organization,omitempty"`
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	audit "github.com/moasq/backend/app/audit/domain"
 	"github.com/moasq/backend/app/organizations/domain"
 	loggerDomain "github.com/moasq/backend/pkg/logger"
 )
@@ -41,6 +43,8 @@ type memberService struct {
 	authRoleRepo     domain.AuthRoleRepository
 	localOrgRepo     domain.OrganizationRepository
 	localAccountRepo domain.AccountRepository
+	revocations      domain.SessionRevocationStore
+	auditRecorder    audit.Recorder
 	logger           loggerDomain.Logger
 }
 
@@ -50,6 +54,8 @@ func NewMemberService(
 	authRoleRepo domain.AuthRoleRepository,
 	localOrgRepo domain.OrganizationRepository,
 	localAccountRepo domain.AccountRepository,
+	revocations domain.SessionRevocationStore,
+	auditRecorder audit.Recorder,
 	logger loggerDomain.Logger,
 ) MemberService {
 	return &memberService{
@@ -58,6 +64,8 @@ func NewMemberService(
 		authRoleRepo:     authRoleRepo,
 		localOrgRepo:     localOrgRepo,
 		localAccountRepo: localAccountRepo,
+		revocations:      revocations,
+		auditRecorder:    auditRecorder,
 		logger:           logger,
 	}
 }
@@ -348,6 +356,15 @@ func (s *memberService) AddMemberDirect(
 		"invite_sent": true,
 	})
 
+	recordAudit(ctx, s.auditRecorder, s.logger, audit.Entry{
+		OrganizationID: localOrgID,
+		Action:         auditActionMemberAdded,
+		TargetType:     auditTargetMember,
+		TargetID:       member.MemberID,
+		Changes:        audit.FieldChange("role", nil, roleSlug),
+		Metadata:       map[string]any{"email": member.Email, "account_id": localAccount.ID},
+	})
+
 	return &AddMemberResponse{
 		MemberID:   member.MemberID,
 		Email:      member.Email,
@@ -522,11 +539,35 @@ func (s *memberService) DeleteOrganizationMember(
 		return fmt.Errorf("failed to remove member: %w", err)
 	}
 
+	// Removing the member ends its provider sessions, but locally verified JWTs
+	// stay valid until they expire unless they are denylisted as well.
+	if err := s.revocations.RevokeMember(ctx, memberID, time.Now()); err != nil {
+		s.logger.Warn("failed to revoke removed member's tokens", map[string]interface{}{
+			"org_id":    orgID,
+			"member_id": memberID,
+			"error":     err.Error(),
+		})
+	}
+
 	s.logger.Info("member successfully deleted from organization", map[string]interface{}{
 		"org_id":    orgID,
 		"member_id": memberID,
 	})
 
+	if localOrgID, err := s.resolveLocalOrganizationID(ctx, orgID); err != nil {
+		s.logger.Warn("failed to resolve organization for audit entry", loggerDomain.Fields{
+			"org_id": orgID,
+			"error":  err.Error(),
+		})
+	} else {
+		recordAudit(ctx, s.auditRecorder, s.logger, audit.Entry{
+			OrganizationID: localOrgID,
+			Action:         auditActionMemberRemoved,
+			TargetType:     auditTargetMember,
+			TargetID:       memberID,
+		})
+	}
+
 	return nil
 }
 
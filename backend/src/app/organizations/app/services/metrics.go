@@ -0,0 +1,32 @@
+package services
+
+import (
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/metrics"
+)
+
+// WebhookMetricsProvider is the provider label for auth webhook metrics.
+const WebhookMetricsProvider = "stytch"
+
+// webhookEventType builds a bounded event_type label such as
+// "member.update"; unrecognized objects or actions become "other".
+func webhookEventType(event *domain.AuthWebhookEvent) string {
+	if event == nil {
+		return "other"
+	}
+	switch event.ObjectType {
+	case domain.AuthWebhookObjectMember, domain.AuthWebhookObjectOrganization:
+	default:
+		return "other"
+	}
+	switch event.Action {
+	case domain.AuthWebhookActionCreate, domain.AuthWebhookActionUpdate, domain.AuthWebhookActionDelete:
+	default:
+		return "other"
+	}
+	return string(event.ObjectType) + "." + string(event.Action)
+}
+
+func observeWebhook(event *domain.AuthWebhookEvent, outcome string) {
+	metrics.ObserveWebhook(WebhookMetricsProvider, webhookEventType(event), outcome)
+}
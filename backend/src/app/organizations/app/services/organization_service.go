@@ -5,17 +5,29 @@ import (
 	"fmt"
 
 	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/app/organizations/domain/events"
+	"github.com/moasq/backend/pkg/eventbus"
+	loggerDomain "github.com/moasq/backend/pkg/logger"
 )
 
 type organizationService struct {
 	orgRepo     domain.OrganizationRepository
 	accountRepo domain.AccountRepository
+	eventBus    eventbus.EventBus
+	logger      loggerDomain.Logger
 }
 
-func NewOrganizationService(orgRepo domain.OrganizationRepository, accountRepo domain.AccountRepository) OrganizationService {
+func NewOrganizationService(
+	orgRepo domain.OrganizationRepository,
+	accountRepo domain.AccountRepository,
+	eventBus eventbus.EventBus,
+	logger loggerDomain.Logger,
+) OrganizationService {
 	return &organizationService{
 		orgRepo:     orgRepo,
 		accountRepo: accountRepo,
+		eventBus:    eventBus,
+		logger:      logger,
 	}
 }
 
@@ -169,6 +181,8 @@ func (s *organizationService) UpdateAccount(ctx context.Context, orgID, accountI
 	if err != nil {
 		return nil, err
 	}
+	previousRole := account.Role
+	previousStatus := account.Status
 
 	// Update fields
 	account.FullName = req.FullName
@@ -184,11 +198,27 @@ func (s *organizationService) UpdateAccount(ctx context.Context, orgID, accountI
 		account.StytchEmailVerified = *req.StytchEmailVerified
 	}
 
-	return s.accountRepo.Update(ctx, account)
+	updated, err := s.accountRepo.Update(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, events.NewAccountUpdatedEvent(updated, previousRole, previousStatus))
+	return updated, nil
 }
 
 func (s *organizationService) DeleteAccount(ctx context.Context, orgID, accountID int32) error {
-	return s.accountRepo.Delete(ctx, orgID, accountID)
+	account, err := s.accountRepo.GetByID(ctx, orgID, accountID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.accountRepo.Delete(ctx, orgID, accountID); err != nil {
+		return err
+	}
+
+	s.publish(ctx, events.NewAccountDeletedEvent(account.ID, account.OrganizationID, account.Email, account.StytchMemberID))
+	return nil
 }
 
 func (s *organizationService) UpdateAccountLastLogin(ctx context.Context, orgID, accountID int32) (*domain.Account, error) {
@@ -202,3 +232,13 @@ func (s *organizationService) CheckAccountPermission(ctx context.Context, orgID,
 func (s *organizationService) GetAccountStats(ctx context.Context, accountID int32) (*domain.AccountStats, error) {
 	return s.accountRepo.GetStats(ctx, accountID)
 }
+
+// publish emits a domain event; the write already happened so failures are only logged.
+func (s *organizationService) publish(ctx context.Context, event eventbus.Event) {
+	if err := s.eventBus.Publish(ctx, event); err != nil {
+		s.logger.Warn("failed to publish organization event", loggerDomain.Fields{
+			"event": event.EventName(),
+			"error": err.Error(),
+		})
+	}
+}
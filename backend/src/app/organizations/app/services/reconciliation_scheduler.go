@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	loggerDomain "github.com/moasq/backend/pkg/logger"
+)
+
+// ReconciliationScheduler runs ReconcileAll on a fixed interval in the background.
+type ReconciliationScheduler struct {
+	service  ReconciliationService
+	interval time.Duration
+	dryRun   bool
+	logger   loggerDomain.Logger
+
+	mu      sync.Mutex
+	ticker  *time.Ticker
+	done    chan struct{}
+	running bool
+	// loops tracks the background loop so Stop can wait for an in-flight run
+	loops sync.WaitGroup
+}
+
+func NewReconciliationScheduler(
+	service ReconciliationService,
+	interval time.Duration,
+	dryRun bool,
+	logger loggerDomain.Logger,
+) *ReconciliationScheduler {
+	return &ReconciliationScheduler{
+		service:  service,
+		interval: interval,
+		dryRun:   dryRun,
+		logger:   logger,
+	}
+}
+
+// Start launches the background loop. It is a no-op when the interval is not positive
+// or the scheduler is already running.
+func (s *ReconciliationScheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running || s.interval <= 0 {
+		return
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.done = make(chan struct{})
+	s.running = true
+
+	s.loops.Add(1)
+	go func(ticker *time.Ticker, done chan struct{}) {
+		defer s.loops.Done()
+		s.loop(ticker, done)
+	}(s.ticker, s.done)
+
+	s.logger.Info("member reconciliation scheduler started", loggerDomain.Fields{
+		"interval": s.interval.String(),
+		"dry_run":  s.dryRun,
+	})
+}
+
+// Stop should be called when the server is shutting down. It cancels an
+// in-flight run and waits for it to return.
+func (s *ReconciliationScheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.ticker.Stop()
+	close(s.done)
+	s.running = false
+	s.loops.Wait()
+}
+
+func (s *ReconciliationScheduler) loop(ticker *time.Ticker, done chan struct{}) {
+	for {
+		select {
+		case <-ticker.C:
+			s.runOnce(done)
+		case <-done:
+			return
+		}
+	}
+}
+
+// runOnce executes a single pass, bounded by the interval so runs never overlap.
+func (s *ReconciliationScheduler) runOnce(done chan struct{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.interval)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	reports, err := s.service.ReconcileAll(ctx, s.dryRun)
+	if err != nil {
+		s.logger.Error("scheduled member reconciliation failed", loggerDomain.Fields{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	drifted := 0
+	for _, report := range reports {
+		if report.HasDrift() {
+			drifted++
+		}
+	}
+
+	s.logger.Info("scheduled member reconciliation completed", loggerDomain.Fields{
+		"organizations": len(reports),
+		"drifted":       drifted,
+		"dry_run":       s.dryRun,
+	})
+}
@@ -0,0 +1,20 @@
+package services
+
+import (
+	"context"
+
+	"github.com/moasq/backend/app/organizations/domain"
+)
+
+// ReconciliationService keeps local accounts in sync with auth provider members.
+// Role changes and removals made directly in the provider dashboard never reach our
+// API, so this service diffs both sides and either applies or reports the drift.
+type ReconciliationService interface {
+	// ReconcileOrganization diffs a single organization's members against its local accounts.
+	// When dryRun is true drift is reported but nothing is written.
+	ReconcileOrganization(ctx context.Context, orgID int32, dryRun bool) (*domain.ReconciliationReport, error)
+
+	// ReconcileAll runs ReconcileOrganization for every organization linked to the auth provider.
+	// Failures for one organization are logged and do not stop the run.
+	ReconcileAll(ctx context.Context, dryRun bool) ([]*domain.ReconciliationReport, error)
+}
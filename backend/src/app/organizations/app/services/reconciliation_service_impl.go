@@ -0,0 +1,388 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/moasq/backend/app/organizations/domain"
+	loggerDomain "github.com/moasq/backend/pkg/logger"
+)
+
+const (
+	// reconcileMemberPageSize is the page size used when walking auth provider members.
+	reconcileMemberPageSize = 100
+	// reconcileOrgPageSize is the page size used when walking local organizations.
+	reconcileOrgPageSize = 100
+	// remoteMemberStatusDeleted marks members the auth provider keeps only as tombstones.
+	remoteMemberStatusDeleted = "deleted"
+	// accountStatusInactive is applied to local accounts whose member was removed upstream.
+	accountStatusInactive = "inactive"
+)
+
+type reconciliationService struct {
+	authMemberRepo   domain.AuthMemberRepository
+	localOrgRepo     domain.OrganizationRepository
+	localAccountRepo domain.AccountRepository
+	logger           loggerDomain.Logger
+}
+
+func NewReconciliationService(
+	authMemberRepo domain.AuthMemberRepository,
+	localOrgRepo domain.OrganizationRepository,
+	localAccountRepo domain.AccountRepository,
+	logger loggerDomain.Logger,
+) ReconciliationService {
+	return &reconciliationService{
+		authMemberRepo:   authMemberRepo,
+		localOrgRepo:     localOrgRepo,
+		localAccountRepo: localAccountRepo,
+		logger:           logger,
+	}
+}
+
+// ReconcileAll walks every local organization linked to the auth provider.
+func (s *reconciliationService) ReconcileAll(ctx context.Context, dryRun bool) ([]*domain.ReconciliationReport, error) {
+	var reports []*domain.ReconciliationReport
+
+	for offset := int32(0); ; offset += reconcileOrgPageSize {
+		orgs, err := s.localOrgRepo.List(ctx, reconcileOrgPageSize, offset)
+		if err != nil {
+			return reports, fmt.Errorf("failed to list organizations: %w", err)
+		}
+
+		for _, org := range orgs {
+			if ctx.Err() != nil {
+				return reports, ctx.Err()
+			}
+			if org.StytchOrgID == "" {
+				continue
+			}
+
+			report, err := s.reconcile(ctx, org, dryRun)
+			if err != nil {
+				s.logger.Error("organization reconciliation failed", loggerDomain.Fields{
+					"org_id":      org.ID,
+					"auth_org_id": org.StytchOrgID,
+					"error":       err.Error(),
+				})
+				continue
+			}
+			reports = append(reports, report)
+		}
+
+		if len(orgs) < reconcileOrgPageSize {
+			break
+		}
+	}
+
+	return reports, nil
+}
+
+// ReconcileOrganization diffs one organization against the auth provider.
+func (s *reconciliationService) ReconcileOrganization(ctx context.Context, orgID int32, dryRun bool) (*domain.ReconciliationReport, error) {
+	org, err := s.localOrgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	if org.StytchOrgID == "" {
+		return nil, domain.ErrAuthOrganizationIDRequired
+	}
+
+	return s.reconcile(ctx, org, dryRun)
+}
+
+func (s *reconciliationService) reconcile(ctx context.Context, org *domain.Organization, dryRun bool) (*domain.ReconciliationReport, error) {
+	report := &domain.ReconciliationReport{
+		OrganizationID: org.ID,
+		ProviderOrgID:  org.StytchOrgID,
+		DryRun:         dryRun,
+		StartedAt:      time.Now().UTC(),
+		Drifts:         []domain.MemberDrift{},
+	}
+
+	members, complete, err := s.listAllMembers(ctx, org.StytchOrgID)
+	if err != nil {
+		return nil, err
+	}
+	report.OrphansSkipped = !complete
+
+	accounts, err := s.localAccountRepo.ListByOrganization(ctx, org.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list local accounts: %w", err)
+	}
+
+	report.RemoteMembers = len(members)
+	report.LocalAccounts = len(accounts)
+
+	byMemberID := make(map[string]*domain.Account, len(accounts))
+	byEmail := make(map[string]*domain.Account, len(accounts))
+	for _, account := range accounts {
+		if account.StytchMemberID != "" {
+			byMemberID[account.StytchMemberID] = account
+		}
+		byEmail[strings.ToLower(account.Email)] = account
+	}
+
+	matched := make(map[int32]bool, len(accounts))
+
+	for _, member := range members {
+		account := byMemberID[member.MemberID]
+		if account == nil {
+			account = byEmail[strings.ToLower(member.Email)]
+		}
+
+		if account == nil {
+			drift := domain.MemberDrift{
+				Type:        domain.DriftMissingLocal,
+				Email:       member.Email,
+				MemberID:    member.MemberID,
+				RemoteValue: primaryRoleSlug(member.Roles),
+			}
+			if !dryRun {
+				drift.Applied, drift.Error = s.createMissingAccount(ctx, org.ID, member)
+			}
+			report.AddDrift(drift)
+			continue
+		}
+
+		matched[account.ID] = true
+		s.diffAccount(ctx, report, account, member, dryRun)
+	}
+
+	for _, account := range accounts {
+		if report.OrphansSkipped {
+			// An account missing from a partial list may still be a member
+			break
+		}
+		if matched[account.ID] || account.Status == accountStatusInactive {
+			continue
+		}
+
+		drift := domain.MemberDrift{
+			Type:       domain.DriftOrphanedLocal,
+			Email:      account.Email,
+			MemberID:   account.StytchMemberID,
+			AccountID:  account.ID,
+			LocalValue: account.Status,
+		}
+		if !dryRun {
+			updated := *account
+			updated.Status = accountStatusInactive
+			if _, err := s.localAccountRepo.Update(ctx, &updated); err != nil {
+				drift.Error = err.Error()
+			} else {
+				drift.Applied = true
+			}
+		}
+		report.AddDrift(drift)
+	}
+
+	report.CompletedAt = time.Now().UTC()
+
+	logFields := loggerDomain.Fields{
+		"org_id":         org.ID,
+		"auth_org_id":    org.StytchOrgID,
+		"dry_run":        dryRun,
+		"remote_members": report.RemoteMembers,
+		"local_accounts": report.LocalAccounts,
+		"drifts":         len(report.Drifts),
+		"applied":        report.Applied,
+		"failed":         report.Failed,
+	}
+	if report.OrphansSkipped {
+		s.logger.Warn("auth provider member list incomplete, orphan check skipped", logFields)
+	}
+	if report.HasDrift() {
+		s.logger.Warn("member drift detected", logFields)
+	} else {
+		s.logger.Debug("organization members in sync", logFields)
+	}
+
+	return report, nil
+}
+
+// diffAccount compares a matched account with its auth provider member and records
+// role, member ID and email verification drift. All fields are fixed with one write.
+func (s *reconciliationService) diffAccount(
+	ctx context.Context,
+	report *domain.ReconciliationReport,
+	account *domain.Account,
+	member *domain.AuthMember,
+	dryRun bool,
+) {
+	remoteSlug := primaryRoleSlug(member.Roles)
+	remoteRole := mapRoleSlugToAccountRole(remoteSlug)
+
+	var drifts []domain.MemberDrift
+	if account.StytchMemberID != member.MemberID {
+		drifts = append(drifts, domain.MemberDrift{
+			Type:        domain.DriftMemberIDMismatch,
+			LocalValue:  account.StytchMemberID,
+			RemoteValue: member.MemberID,
+		})
+	}
+	if remoteSlug != "" && (account.StytchRoleSlug != remoteSlug || mapRoleSlugToAccountRole(account.Role) != remoteRole) {
+		drifts = append(drifts, domain.MemberDrift{
+			Type:        domain.DriftRoleMismatch,
+			LocalValue:  account.Role,
+			RemoteValue: remoteSlug,
+		})
+	}
+	if account.StytchEmailVerified != member.EmailVerified {
+		drifts = append(drifts, domain.MemberDrift{
+			Type:        domain.DriftEmailVerifiedMismatch,
+			LocalValue:  fmt.Sprintf("%t", account.StytchEmailVerified),
+			RemoteValue: fmt.Sprintf("%t", member.EmailVerified),
+		})
+	}
+	if len(drifts) == 0 {
+		return
+	}
+
+	var applied bool
+	var applyErr string
+	if !dryRun {
+		applied, applyErr = s.syncAccount(ctx, account, member, remoteSlug, remoteRole)
+	}
+
+	for _, drift := range drifts {
+		drift.Email = account.Email
+		drift.MemberID = member.MemberID
+		drift.AccountID = account.ID
+		drift.Applied = applied
+		drift.Error = applyErr
+		report.AddDrift(drift)
+	}
+}
+
+func (s *reconciliationService) syncAccount(
+	ctx context.Context,
+	account *domain.Account,
+	member *domain.AuthMember,
+	remoteSlug, remoteRole string,
+) (bool, string) {
+	roleSlug := account.StytchRoleSlug
+	if remoteSlug != "" {
+		roleSlug = remoteSlug
+	}
+
+	updated, err := s.localAccountRepo.UpdateStytchInfo(
+		ctx,
+		account.OrganizationID,
+		account.ID,
+		member.MemberID,
+		roleSlug,
+		roleSlug,
+		member.EmailVerified,
+	)
+	if err != nil {
+		return false, err.Error()
+	}
+
+	if remoteSlug != "" && updated.Role != remoteRole {
+		updated.Role = remoteRole
+		if _, err := s.localAccountRepo.Update(ctx, updated); err != nil {
+			return false, err.Error()
+		}
+	}
+
+	return true, ""
+}
+
+func (s *reconciliationService) createMissingAccount(ctx context.Context, orgID int32, member *domain.AuthMember) (bool, string) {
+	roleSlug := primaryRoleSlug(member.Roles)
+	if roleSlug == "" {
+		roleSlug = "member"
+	}
+
+	fullName := strings.TrimSpace(member.Name)
+	if fullName == "" {
+		fullName = member.Email
+	}
+
+	account, err := s.localAccountRepo.Create(ctx, &domain.Account{
+		OrganizationID: orgID,
+		Email:          member.Email,
+		FullName:       fullName,
+		Role:           mapRoleSlugToAccountRole(roleSlug),
+		Status:         "active",
+	})
+	if err != nil {
+		return false, err.Error()
+	}
+
+	if _, err := s.localAccountRepo.UpdateStytchInfo(
+		ctx,
+		orgID,
+		account.ID,
+		member.MemberID,
+		roleSlug,
+		roleSlug,
+		member.EmailVerified,
+	); err != nil {
+		return false, err.Error()
+	}
+
+	return true, ""
+}
+
+// listAllMembers follows the auth provider's search cursor to the last page.
+// complete is false when fewer members came back than the provider counted,
+// or the cursor stopped advancing.
+func (s *reconciliationService) listAllMembers(ctx context.Context, authOrgID string) (members []*domain.AuthMember, complete bool, err error) {
+	seen := 0
+	total := -1
+	cursor := ""
+
+	for {
+		page, err := s.authMemberRepo.ListMembersPage(ctx, authOrgID, cursor, reconcileMemberPageSize)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list auth members: %w", err)
+		}
+
+		seen += len(page.Members)
+		if page.Total >= 0 {
+			total = page.Total
+		}
+		for _, member := range page.Members {
+			if member.Status == remoteMemberStatusDeleted {
+				continue
+			}
+			members = append(members, member)
+		}
+
+		if page.NextCursor == "" {
+			break
+		}
+		if page.NextCursor == cursor || len(page.Members) == 0 {
+			return members, false, nil
+		}
+		cursor = page.NextCursor
+	}
+
+	return members, total < 0 || seen >= total, nil
+}
+
+// primaryRoleSlug picks the most privileged role a member holds. Members carry the
+// implicit stytch_member role alongside any assigned role, so the highest one wins.
+func primaryRoleSlug(roles []string) string {
+	best, bestRank := "", 0
+	for _, role := range roles {
+		slug := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(role)), "stytch_")
+		rank := 0
+		switch mapRoleSlugToAccountRole(slug) {
+		case "admin":
+			rank = 3
+		case "approver":
+			rank = 2
+		case "member":
+			rank = 1
+		}
+		if rank > bestRank {
+			best, bestRank = slug, rank
+		}
+	}
+	return best
+}
@@ -0,0 +1,23 @@
+package services
+
+import (
+	"context"
+
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/auth"
+)
+
+// SecurityPolicyService manages per-organization security policies
+// (required MFA, allowed auth methods, IP allowlist).
+type SecurityPolicyService interface {
+	// GetPolicy returns the organization's policy. Organizations without one are unrestricted.
+	GetPolicy(ctx context.Context, orgID int32) (*domain.SecurityPolicy, error)
+
+	// UpdatePolicy replaces the organization's policy. A policy that would deny
+	// the caller's own session is rejected with ErrSecurityPolicyLockout.
+	UpdatePolicy(ctx context.Context, orgID int32, req *domain.UpdateSecurityPolicyRequest) (*domain.SecurityPolicy, error)
+
+	// GetSecurityPolicy returns the policy in the form enforced by the auth
+	// middleware. It implements auth.SecurityPolicyProvider.
+	GetSecurityPolicy(ctx context.Context, orgID int32) (*auth.SecurityPolicy, error)
+}
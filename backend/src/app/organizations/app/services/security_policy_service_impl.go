@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	audit "github.com/moasq/backend/app/audit/domain"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/app/organizations/domain/events"
+	"github.com/moasq/backend/pkg/auth"
+	loggerDomain "github.com/moasq/backend/pkg/logger"
+)
+
+type securityPolicyService struct {
+	repo          domain.SecurityPolicyRepository
+	auditRecorder audit.Recorder
+	logger        loggerDomain.Logger
+}
+
+func NewSecurityPolicyService(
+	repo domain.SecurityPolicyRepository,
+	auditRecorder audit.Recorder,
+	logger loggerDomain.Logger,
+) SecurityPolicyService {
+	return &securityPolicyService{
+		repo:          repo,
+		auditRecorder: auditRecorder,
+		logger:        logger,
+	}
+}
+
+func (s *securityPolicyService) GetPolicy(ctx context.Context, orgID int32) (*domain.SecurityPolicy, error) {
+	return s.repo.Get(ctx, orgID)
+}
+
+func (s *securityPolicyService) UpdatePolicy(ctx context.Context, orgID int32, req *domain.UpdateSecurityPolicyRequest) (*domain.SecurityPolicy, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Refuse a policy that would lock the admin making the change out.
+	if reqCtx := auth.RequestContextFromContext(ctx); reqCtx != nil && reqCtx.Identity != nil {
+		policy, err := toAuthSecurityPolicy(req.RequireMFA, req.AllowedAuthMethods, req.IPAllowlist)
+		if err != nil {
+			return nil, err
+		}
+		if reason := policy.Evaluate(reqCtx.Identity, reqCtx.ClientIP); reason != "" {
+			return nil, &domain.SecurityPolicyLockoutError{Reason: reason}
+		}
+	}
+
+	before, err := s.repo.Get(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Save(ctx, orgID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("organization security policy updated", loggerDomain.Fields{
+		"organization_id":      orgID,
+		"require_mfa":          updated.RequireMFA,
+		"allowed_auth_methods": updated.AllowedAuthMethods,
+		"ip_allowlist_entries": len(updated.IPAllowlist),
+	})
+
+	if changes := audit.Diff(securityPolicyAuditFields(before), securityPolicyAuditFields(updated)); len(changes) > 0 {
+		recordAudit(ctx, s.auditRecorder, s.logger, audit.Entry{
+			OrganizationID: orgID,
+			Action:         auditActionSecurityPolicyUpdated,
+			TargetType:     events.AuditTargetOrganization,
+			TargetID:       strconv.FormatInt(int64(orgID), 10),
+			Changes:        changes,
+		})
+	}
+
+	return updated, nil
+}
+
+func (s *securityPolicyService) GetSecurityPolicy(ctx context.Context, orgID int32) (*auth.SecurityPolicy, error) {
+	policy, err := s.repo.Get(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return toAuthSecurityPolicy(policy.RequireMFA, policy.AllowedAuthMethods, policy.IPAllowlist)
+}
+
+// toAuthSecurityPolicy converts stored policy values to the form enforced by the auth middleware.
+func toAuthSecurityPolicy(requireMFA bool, methods, cidrs []string) (*auth.SecurityPolicy, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid CIDR %q", domain.ErrInvalidSecurityPolicy, cidr)
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return &auth.SecurityPolicy{
+		RequireMFA:         requireMFA,
+		AllowedAuthMethods: methods,
+		IPAllowlist:        prefixes,
+	}, nil
+}
+
+func securityPolicyAuditFields(policy *domain.SecurityPolicy) map[string]any {
+	return map[string]any{
+		"require_mfa":          policy.RequireMFA,
+		"allowed_auth_methods": policy.AllowedAuthMethods,
+		"ip_allowlist":         policy.IPAllowlist,
+	}
+}
@@ -0,0 +1,27 @@
+package services
+
+import (
+	"context"
+
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/eventbus"
+)
+
+// SessionService lists and revokes an account's auth provider sessions.
+// Revocations are applied to the local token denylist as well as the provider,
+// so already-issued JWTs stop working immediately instead of at expiry.
+type SessionService interface {
+	// ListAccountSessions returns the account's active sessions. The session matching
+	// currentSessionID (the caller's own session, if any) is flagged as current.
+	ListAccountSessions(ctx context.Context, orgID, accountID int32, currentSessionID string) ([]*domain.AuthSession, error)
+
+	// RevokeAccountSession revokes a single session belonging to the account.
+	RevokeAccountSession(ctx context.Context, orgID, accountID int32, sessionID string) error
+
+	// RevokeAllAccountSessions revokes every session and outstanding token for the account.
+	RevokeAllAccountSessions(ctx context.Context, orgID, accountID int32) error
+
+	// HandleAccountEvent revokes tokens when an account's role or status changes or the
+	// account is deleted. It is subscribed to account events on the event bus.
+	HandleAccountEvent(ctx context.Context, event eventbus.Event) error
+}
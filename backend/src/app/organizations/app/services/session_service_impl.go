@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/app/organizations/domain/events"
+	"github.com/moasq/backend/pkg/eventbus"
+	loggerDomain "github.com/moasq/backend/pkg/logger"
+)
+
+const accountStatusActive = "active"
+
+type sessionService struct {
+	authSessionRepo  domain.AuthSessionRepository
+	revocations      domain.SessionRevocationStore
+	localOrgRepo     domain.OrganizationRepository
+	localAccountRepo domain.AccountRepository
+	logger           loggerDomain.Logger
+}
+
+func NewSessionService(
+	authSessionRepo domain.AuthSessionRepository,
+	revocations domain.SessionRevocationStore,
+	localOrgRepo domain.OrganizationRepository,
+	localAccountRepo domain.AccountRepository,
+	logger loggerDomain.Logger,
+) SessionService {
+	return &sessionService{
+		authSessionRepo:  authSessionRepo,
+		revocations:      revocations,
+		localOrgRepo:     localOrgRepo,
+		localAccountRepo: localAccountRepo,
+		logger:           logger,
+	}
+}
+
+func (s *sessionService) ListAccountSessions(ctx context.Context, orgID, accountID int32, currentSessionID string) ([]*domain.AuthSession, error) {
+	org, account, err := s.resolveMember(ctx, orgID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.authSessionRepo.ListSessions(ctx, org.StytchOrgID, account.StytchMemberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	for _, session := range sessions {
+		session.Current = currentSessionID != "" && session.SessionID == currentSessionID
+	}
+
+	return sessions, nil
+}
+
+func (s *sessionService) RevokeAccountSession(ctx context.Context, orgID, accountID int32, sessionID string) error {
+	if sessionID == "" {
+		return domain.ErrAuthSessionIDRequired
+	}
+
+	org, account, err := s.resolveMember(ctx, orgID, accountID)
+	if err != nil {
+		return err
+	}
+
+	// Only sessions that belong to this account may be revoked through it.
+	sessions, err := s.authSessionRepo.ListSessions(ctx, org.StytchOrgID, account.StytchMemberID)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+	owned := false
+	for _, session := range sessions {
+		if session.SessionID == sessionID {
+			owned = true
+			break
+		}
+	}
+	if !owned {
+		return domain.ErrAuthSessionNotFound
+	}
+
+	if err := s.revocations.RevokeSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to denylist session: %w", err)
+	}
+	if err := s.authSessionRepo.RevokeSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	s.logger.Info("session revoked", loggerDomain.Fields{
+		"org_id":     orgID,
+		"account_id": accountID,
+		"session_id": sessionID,
+	})
+
+	return nil
+}
+
+func (s *sessionService) RevokeAllAccountSessions(ctx context.Context, orgID, accountID int32) error {
+	_, account, err := s.resolveMember(ctx, orgID, accountID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.revokeMember(ctx, account.StytchMemberID, true); err != nil {
+		return err
+	}
+
+	s.logger.Info("all sessions revoked", loggerDomain.Fields{
+		"org_id":     orgID,
+		"account_id": accountID,
+	})
+
+	return nil
+}
+
+func (s *sessionService) HandleAccountEvent(ctx context.Context, event eventbus.Event) error {
+	switch e := event.(type) {
+	case *events.AccountUpdatedEvent:
+		account := e.Account
+		if account == nil || account.StytchMemberID == "" {
+			return nil
+		}
+		if account.Status != e.PreviousStatus && account.Status != accountStatusActive {
+			// Suspended: end provider sessions too so the member cannot refresh.
+			return s.revokeMember(ctx, account.StytchMemberID, true)
+		}
+		if account.Role != e.PreviousRole {
+			// Role changed: force a token refresh so the new role takes effect now.
+			return s.revokeMember(ctx, account.StytchMemberID, false)
+		}
+	case *events.AccountDeletedEvent:
+		if e.MemberID != "" {
+			return s.revokeMember(ctx, e.MemberID, false)
+		}
+	}
+	return nil
+}
+
+// revokeMember rejects every token issued to the member so far and, when
+// endSessions is set, revokes the member's provider sessions as well.
+func (s *sessionService) revokeMember(ctx context.Context, memberID string, endSessions bool) error {
+	if err := s.revocations.RevokeMember(ctx, memberID, time.Now()); err != nil {
+		return fmt.Errorf("failed to denylist member tokens: %w", err)
+	}
+
+	if endSessions {
+		if err := s.authSessionRepo.RevokeMemberSessions(ctx, memberID); err != nil {
+			return fmt.Errorf("failed to revoke member sessions: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *sessionService) resolveMember(ctx context.Context, orgID, accountID int32) (*domain.Organization, *domain.Account, error) {
+	org, err := s.localOrgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account, err := s.localAccountRepo.GetByID(ctx, orgID, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if account.StytchMemberID == "" || org.StytchOrgID == "" {
+		return nil, nil, domain.ErrAccountNotLinkedToAuth
+	}
+
+	return org, account, nil
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+
+	"github.com/moasq/backend/app/organizations/domain"
+)
+
+// SettingsService reads and updates per-organization settings, such as the
+// flags consumed by authorization policies.
+type SettingsService interface {
+	// GetSettings returns the organization's settings (empty if never set).
+	GetSettings(ctx context.Context, orgID int32) (*domain.OrganizationSettings, error)
+
+	// UpdateSettings merges patch into the stored settings. A null value removes the key.
+	UpdateSettings(ctx context.Context, orgID int32, patch map[string]any) (*domain.OrganizationSettings, error)
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	audit "github.com/moasq/backend/app/audit/domain"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/app/organizations/domain/events"
+	loggerDomain "github.com/moasq/backend/pkg/logger"
+)
+
+type settingsService struct {
+	settingsRepo  domain.OrganizationSettingsRepository
+	auditRecorder audit.Recorder
+	logger        loggerDomain.Logger
+}
+
+func NewSettingsService(
+	settingsRepo domain.OrganizationSettingsRepository,
+	auditRecorder audit.Recorder,
+	logger loggerDomain.Logger,
+) SettingsService {
+	return &settingsService{
+		settingsRepo:  settingsRepo,
+		auditRecorder: auditRecorder,
+		logger:        logger,
+	}
+}
+
+func (s *settingsService) GetSettings(ctx context.Context, orgID int32) (*domain.OrganizationSettings, error) {
+	return s.settingsRepo.Get(ctx, orgID)
+}
+
+func (s *settingsService) UpdateSettings(ctx context.Context, orgID int32, patch map[string]any) (*domain.OrganizationSettings, error) {
+	for key, value := range patch {
+		if value == nil {
+			continue
+		}
+		if err := domain.ValidateSettingValue(key, value); err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := s.settingsRepo.Get(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := make(map[string]any, len(current.Values))
+	for key, value := range current.Values {
+		before[key] = value
+	}
+
+	values := current.Values
+	for key, value := range patch {
+		if value == nil {
+			delete(values, key)
+			continue
+		}
+		values[key] = value
+	}
+
+	updated, err := s.settingsRepo.Save(ctx, orgID, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update organization settings: %w", err)
+	}
+
+	s.logger.Info("organization settings updated", loggerDomain.Fields{
+		"organization_id": orgID,
+		"keys":            len(patch),
+	})
+
+	if changes := audit.Diff(before, updated.Values); len(changes) > 0 {
+		recordAudit(ctx, s.auditRecorder, s.logger, audit.Entry{
+			OrganizationID: orgID,
+			Action:         auditActionSettingsUpdated,
+			TargetType:     events.AuditTargetOrganization,
+			TargetID:       strconv.FormatInt(int64(orgID), 10),
+			Changes:        changes,
+		})
+	}
+
+	return updated, nil
+}
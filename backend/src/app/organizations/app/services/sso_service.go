@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+
+	"github.com/moasq/backend/app/organizations/domain"
+)
+
+// SSOService manages an organization's SAML and OIDC connections at the auth provider.
+// Organizations are addressed by their local ID and must be linked to the provider.
+type SSOService interface {
+	// GetSSO returns the organization's connections, default connection, and SSO requirement.
+	GetSSO(ctx context.Context, orgID int32) (*domain.SSOSettings, error)
+
+	// CreateConnection creates a pending connection. The returned ACS/redirect URLs are
+	// given to the IdP before the connection is configured.
+	CreateConnection(ctx context.Context, orgID int32, req *domain.CreateSSOConnectionRequest) (*domain.SSOConnection, error)
+
+	// UpdateSAMLConnection configures a SAML connection from a metadata URL or explicit IdP values.
+	UpdateSAMLConnection(ctx context.Context, orgID int32, req *domain.UpdateSAMLConnectionRequest) (*domain.SSOConnection, error)
+
+	// UpdateOIDCConnection configures an OIDC connection.
+	UpdateOIDCConnection(ctx context.Context, orgID int32, req *domain.UpdateOIDCConnectionRequest) (*domain.SSOConnection, error)
+
+	// DeleteConnection deletes a connection. The default connection cannot be
+	// deleted while SSO is required.
+	DeleteConnection(ctx context.Context, orgID int32, connectionID string) error
+
+	// SetDefaultConnection sets the connection used for SSO login.
+	SetDefaultConnection(ctx context.Context, orgID int32, connectionID string) error
+
+	// SetSSORequired restricts member login to SSO, or lifts the restriction.
+	// Requiring SSO needs an active default connection.
+	SetSSORequired(ctx context.Context, orgID int32, required bool) error
+}
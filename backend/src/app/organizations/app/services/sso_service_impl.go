@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	audit "github.com/moasq/backend/app/audit/domain"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/app/organizations/domain/events"
+	loggerDomain "github.com/moasq/backend/pkg/logger"
+)
+
+type ssoService struct {
+	authSSORepo   domain.AuthSSORepository
+	localOrgRepo  domain.OrganizationRepository
+	auditRecorder audit.Recorder
+	logger        loggerDomain.Logger
+}
+
+func NewSSOService(
+	authSSORepo domain.AuthSSORepository,
+	localOrgRepo domain.OrganizationRepository,
+	auditRecorder audit.Recorder,
+	logger loggerDomain.Logger,
+) SSOService {
+	return &ssoService{
+		authSSORepo:   authSSORepo,
+		localOrgRepo:  localOrgRepo,
+		auditRecorder: auditRecorder,
+		logger:        logger,
+	}
+}
+
+func (s *ssoService) GetSSO(ctx context.Context, orgID int32) (*domain.SSOSettings, error) {
+	org, err := s.resolveOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.authSSORepo.GetSSOSettings(ctx, org.StytchOrgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sso settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (s *ssoService) CreateConnection(ctx context.Context, orgID int32, req *domain.CreateSSOConnectionRequest) (*domain.SSOConnection, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	org, err := s.resolveOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.authSSORepo.CreateConnection(ctx, org.StytchOrgID, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sso connection: %w", err)
+	}
+
+	s.logger.Info("sso connection created", loggerDomain.Fields{
+		"org_id":        orgID,
+		"connection_id": conn.ConnectionID,
+		"type":          conn.Type,
+	})
+	s.recordConnectionAudit(ctx, orgID, auditActionSSOConnectionCreated, conn, nil)
+
+	return conn, nil
+}
+
+func (s *ssoService) UpdateSAMLConnection(ctx context.Context, orgID int32, req *domain.UpdateSAMLConnectionRequest) (*domain.SSOConnection, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	org, previous, err := s.resolveConnection(ctx, orgID, req.ConnectionID, domain.SSOConnectionTypeSAML)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.authSSORepo.UpdateSAMLConnection(ctx, org.StytchOrgID, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update saml connection: %w", err)
+	}
+
+	s.logger.Info("saml connection updated", loggerDomain.Fields{
+		"org_id":        orgID,
+		"connection_id": conn.ConnectionID,
+		"status":        conn.Status,
+	})
+	s.recordConnectionAudit(ctx, orgID, auditActionSSOConnectionUpdated, conn, previous)
+
+	return conn, nil
+}
+
+func (s *ssoService) UpdateOIDCConnection(ctx context.Context, orgID int32, req *domain.UpdateOIDCConnectionRequest) (*domain.SSOConnection, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	org, previous, err := s.resolveConnection(ctx, orgID, req.ConnectionID, domain.SSOConnectionTypeOIDC)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.authSSORepo.UpdateOIDCConnection(ctx, org.StytchOrgID, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update oidc connection: %w", err)
+	}
+
+	s.logger.Info("oidc connection updated", loggerDomain.Fields{
+		"org_id":        orgID,
+		"connection_id": conn.ConnectionID,
+		"status":        conn.Status,
+	})
+	s.recordConnectionAudit(ctx, orgID, auditActionSSOConnectionUpdated, conn, previous)
+
+	return conn, nil
+}
+
+func (s *ssoService) DeleteConnection(ctx context.Context, orgID int32, connectionID string) error {
+	if connectionID == "" {
+		return domain.ErrSSOConnectionIDRequired
+	}
+
+	org, err := s.resolveOrganization(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	settings, err := s.authSSORepo.GetSSOSettings(ctx, org.StytchOrgID)
+	if err != nil {
+		return fmt.Errorf("failed to get sso settings: %w", err)
+	}
+	conn := settings.Connection(connectionID)
+	if conn == nil {
+		return domain.ErrSSOConnectionNotFound
+	}
+	// Deleting the only way in would lock every member out.
+	if settings.SSORequired && conn.IsDefault {
+		return domain.ErrSSONoActiveConnection
+	}
+
+	if err := s.authSSORepo.DeleteConnection(ctx, org.StytchOrgID, connectionID); err != nil {
+		return fmt.Errorf("failed to delete sso connection: %w", err)
+	}
+
+	if conn.IsDefault && org.StytchConnectionID == connectionID {
+		if _, err := s.localOrgRepo.UpdateStytchInfo(ctx, org.ID, org.StytchOrgID, "", ""); err != nil {
+			s.logger.Warn("failed to clear local default sso connection", loggerDomain.Fields{
+				"org_id": orgID,
+				"error":  err.Error(),
+			})
+		}
+	}
+
+	s.logger.Info("sso connection deleted", loggerDomain.Fields{
+		"org_id":        orgID,
+		"connection_id": connectionID,
+	})
+	s.recordConnectionAudit(ctx, orgID, auditActionSSOConnectionDeleted, nil, conn)
+
+	return nil
+}
+
+func (s *ssoService) SetDefaultConnection(ctx context.Context, orgID int32, connectionID string) error {
+	if connectionID == "" {
+		return domain.ErrSSOConnectionIDRequired
+	}
+
+	org, err := s.resolveOrganization(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	settings, err := s.authSSORepo.GetSSOSettings(ctx, org.StytchOrgID)
+	if err != nil {
+		return fmt.Errorf("failed to get sso settings: %w", err)
+	}
+	conn := settings.Connection(connectionID)
+	if conn == nil {
+		return domain.ErrSSOConnectionNotFound
+	}
+
+	if err := s.authSSORepo.SetDefaultConnection(ctx, org.StytchOrgID, connectionID); err != nil {
+		return fmt.Errorf("failed to set default sso connection: %w", err)
+	}
+
+	// Keep the local copy used at login in sync with the provider.
+	if _, err := s.localOrgRepo.UpdateStytchInfo(ctx, org.ID, org.StytchOrgID, conn.ConnectionID, conn.DisplayName); err != nil {
+		return fmt.Errorf("failed to update organization sso connection: %w", err)
+	}
+
+	s.logger.Info("default sso connection set", loggerDomain.Fields{
+		"org_id":        orgID,
+		"connection_id": connectionID,
+	})
+	recordAudit(ctx, s.auditRecorder, s.logger, audit.Entry{
+		OrganizationID: orgID,
+		Action:         auditActionSSODefaultConnectionSet,
+		TargetType:     events.AuditTargetOrganization,
+		TargetID:       strconv.FormatInt(int64(orgID), 10),
+		Changes:        audit.FieldChange("default_connection_id", settings.DefaultConnectionID, connectionID),
+	})
+
+	return nil
+}
+
+func (s *ssoService) SetSSORequired(ctx context.Context, orgID int32, required bool) error {
+	org, err := s.resolveOrganization(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	settings, err := s.authSSORepo.GetSSOSettings(ctx, org.StytchOrgID)
+	if err != nil {
+		return fmt.Errorf("failed to get sso settings: %w", err)
+	}
+	if settings.SSORequired == required {
+		return nil
+	}
+	if required {
+		conn := settings.Connection(settings.DefaultConnectionID)
+		if conn == nil || conn.Status != domain.SSOConnectionStatusActive {
+			return domain.ErrSSONoActiveConnection
+		}
+	}
+
+	if err := s.authSSORepo.SetSSORequired(ctx, org.StytchOrgID, required); err != nil {
+		return fmt.Errorf("failed to update sso requirement: %w", err)
+	}
+
+	recordAudit(ctx, s.auditRecorder, s.logger, audit.Entry{
+		OrganizationID: orgID,
+		Action:         auditActionSSORequiredUpdated,
+		TargetType:     events.AuditTargetOrganization,
+		TargetID:       strconv.FormatInt(int64(orgID), 10),
+		Changes:        audit.FieldChange("sso_required", settings.SSORequired, required),
+	})
+
+	return nil
+}
+
+func (s *ssoService) resolveOrganization(ctx context.Context, orgID int32) (*domain.Organization, error) {
+	org, err := s.localOrgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if org.StytchOrgID == "" {
+		return nil, domain.ErrOrganizationNotLinkedToAuth
+	}
+	return org, nil
+}
+
+// resolveConnection loads the connection being updated and checks its type.
+func (s *ssoService) resolveConnection(ctx context.Context, orgID int32, connectionID string, connType domain.SSOConnectionType) (*domain.Organization, *domain.SSOConnection, error) {
+	org, err := s.resolveOrganization(ctx, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings, err := s.authSSORepo.GetSSOSettings(ctx, org.StytchOrgID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get sso settings: %w", err)
+	}
+	conn := settings.Connection(connectionID)
+	if conn == nil {
+		return nil, nil, domain.ErrSSOConnectionNotFound
+	}
+	if conn.Type != connType {
+		return nil, nil, domain.ErrSSOConnectionTypeMismatch
+	}
+
+	return org, conn, nil
+}
+
+func (s *ssoService) recordConnectionAudit(ctx context.Context, orgID int32, action string, after, before *domain.SSOConnection) {
+	entry := audit.Entry{
+		OrganizationID: orgID,
+		Action:         action,
+		TargetType:     auditTargetSSOConnection,
+	}
+	if after != nil {
+		entry.TargetID = after.ConnectionID
+	} else if before != nil {
+		entry.TargetID = before.ConnectionID
+	}
+	entry.Changes = audit.Diff(connectionAuditFields(before), connectionAuditFields(after))
+	recordAudit(ctx, s.auditRecorder, s.logger, entry)
+}
+
+// connectionAuditFields returns the audited, non-secret fields of a connection.
+func connectionAuditFields(conn *domain.SSOConnection) map[string]any {
+	if conn == nil {
+		return map[string]any{}
+	}
+	return map[string]any{
+		"type":          string(conn.Type),
+		"display_name":  conn.DisplayName,
+		"status":        conn.Status,
+		"idp_entity_id": conn.IdPEntityID,
+		"idp_sso_url":   conn.IdPSSOURL,
+		"issuer":        conn.Issuer,
+		"client_id":     conn.ClientID,
+	}
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+
+	"github.com/moasq/backend/app/organizations/domain"
+)
+
+// TeamService manages teams and their memberships. Organization managers
+// (auth.PermTeamVisibilityBypass) manage every team; team leads manage the
+// membership and details of their own teams.
+type TeamService interface {
+	// ListTeams returns every team for organization managers, and the caller's
+	// own teams otherwise.
+	ListTeams(ctx context.Context, orgID int32) ([]*domain.Team, error)
+
+	// GetTeam returns a team the caller can see.
+	GetTeam(ctx context.Context, orgID, teamID int32) (*domain.Team, error)
+
+	CreateTeam(ctx context.Context, orgID int32, req *domain.CreateTeamRequest) (*domain.Team, error)
+
+	// UpdateTeam renames a team. Requires an organization manager or team lead.
+	UpdateTeam(ctx context.Context, orgID, teamID int32, req *domain.UpdateTeamRequest) (*domain.Team, error)
+
+	// DeleteTeam removes a team. Fails with ErrTeamInUse while documents or
+	// resources are assigned to it.
+	DeleteTeam(ctx context.Context, orgID, teamID int32) error
+
+	// ListMembers returns the members of a team the caller can see.
+	ListMembers(ctx context.Context, orgID, teamID int32) ([]*domain.TeamMember, error)
+
+	// SetMember adds an organization account to a team or changes its team
+	// role. Requires an organization manager or team lead.
+	SetMember(ctx context.Context, orgID, teamID, accountID int32, req *domain.SetTeamMemberRequest) (*domain.TeamMember, error)
+
+	// RemoveMember removes an account from a team. Requires an organization
+	// manager or team lead.
+	RemoveMember(ctx context.Context, orgID, teamID, accountID int32) error
+
+	// AuthorizeTeam implements auth.TeamAccess.
+	AuthorizeTeam(ctx context.Context, orgID, teamID int32) error
+}
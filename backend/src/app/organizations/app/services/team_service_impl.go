@@ -0,0 +1,271 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	audit "github.com/moasq/backend/app/audit/domain"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/auth"
+	loggerDomain "github.com/moasq/backend/pkg/logger"
+)
+
+type teamService struct {
+	teamRepo      domain.TeamRepository
+	accountRepo   domain.AccountRepository
+	auditRecorder audit.Recorder
+	logger        loggerDomain.Logger
+}
+
+func NewTeamService(
+	teamRepo domain.TeamRepository,
+	accountRepo domain.AccountRepository,
+	auditRecorder audit.Recorder,
+	logger loggerDomain.Logger,
+) TeamService {
+	return &teamService{
+		teamRepo:      teamRepo,
+		accountRepo:   accountRepo,
+		auditRecorder: auditRecorder,
+		logger:        logger,
+	}
+}
+
+func (s *teamService) ListTeams(ctx context.Context, orgID int32) ([]*domain.Team, error) {
+	viewer := auth.TeamScope(ctx)
+	if viewer == nil {
+		return s.teamRepo.List(ctx, orgID)
+	}
+	return s.teamRepo.ListByAccount(ctx, orgID, *viewer)
+}
+
+func (s *teamService) GetTeam(ctx context.Context, orgID, teamID int32) (*domain.Team, error) {
+	team, err := s.teamRepo.Get(ctx, orgID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.callerMembership(ctx, teamID); err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+func (s *teamService) CreateTeam(ctx context.Context, orgID int32, req *domain.CreateTeamRequest) (*domain.Team, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	team, err := s.teamRepo.Create(ctx, orgID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("team created", loggerDomain.Fields{
+		"organization_id": orgID,
+		"team_id":         team.ID,
+	})
+
+	s.recordTeamAudit(ctx, auditActionTeamCreated, orgID, team.ID, nil, team)
+
+	return team, nil
+}
+
+func (s *teamService) UpdateTeam(ctx context.Context, orgID, teamID int32, req *domain.UpdateTeamRequest) (*domain.Team, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	before, err := s.teamRepo.Get(ctx, orgID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireLead(ctx, teamID); err != nil {
+		return nil, err
+	}
+
+	team, err := s.teamRepo.Update(ctx, orgID, teamID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordTeamAudit(ctx, auditActionTeamUpdated, orgID, teamID, before, team)
+
+	return team, nil
+}
+
+func (s *teamService) DeleteTeam(ctx context.Context, orgID, teamID int32) error {
+	before, err := s.teamRepo.Get(ctx, orgID, teamID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.teamRepo.Delete(ctx, orgID, teamID); err != nil {
+		return err
+	}
+
+	s.logger.Info("team deleted", loggerDomain.Fields{
+		"organization_id": orgID,
+		"team_id":         teamID,
+	})
+
+	s.recordTeamAudit(ctx, auditActionTeamDeleted, orgID, teamID, before, nil)
+
+	return nil
+}
+
+func (s *teamService) ListMembers(ctx context.Context, orgID, teamID int32) ([]*domain.TeamMember, error) {
+	if _, err := s.teamRepo.Get(ctx, orgID, teamID); err != nil {
+		return nil, err
+	}
+	if _, err := s.callerMembership(ctx, teamID); err != nil {
+		return nil, err
+	}
+	return s.teamRepo.ListMembers(ctx, teamID)
+}
+
+func (s *teamService) SetMember(ctx context.Context, orgID, teamID, accountID int32, req *domain.SetTeamMemberRequest) (*domain.TeamMember, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.teamRepo.Get(ctx, orgID, teamID); err != nil {
+		return nil, err
+	}
+	if err := s.requireLead(ctx, teamID); err != nil {
+		return nil, err
+	}
+
+	// Only accounts of the same organization can join its teams
+	if _, err := s.accountRepo.GetByID(ctx, orgID, accountID); err != nil {
+		return nil, err
+	}
+
+	previousRole := ""
+	existing, err := s.teamRepo.GetMember(ctx, teamID, accountID)
+	if err == nil {
+		if existing.Role == req.Role {
+			return existing, nil
+		}
+		previousRole = existing.Role
+	} else if !errors.Is(err, domain.ErrTeamMemberNotFound) {
+		return nil, err
+	}
+
+	member, err := s.teamRepo.SetMember(ctx, teamID, accountID, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	action := auditActionTeamMemberAdded
+	if previousRole != "" {
+		action = auditActionTeamMemberRoleUpdated
+	}
+	recordAudit(ctx, s.auditRecorder, s.logger, audit.Entry{
+		OrganizationID: orgID,
+		Action:         action,
+		TargetType:     auditTargetTeam,
+		TargetID:       strconv.FormatInt(int64(teamID), 10),
+		Changes:        audit.FieldChange("role", previousRole, member.Role),
+		Metadata:       map[string]any{"account_id": accountID},
+	})
+
+	return member, nil
+}
+
+func (s *teamService) RemoveMember(ctx context.Context, orgID, teamID, accountID int32) error {
+	if _, err := s.teamRepo.Get(ctx, orgID, teamID); err != nil {
+		return err
+	}
+	if err := s.requireLead(ctx, teamID); err != nil {
+		return err
+	}
+
+	existing, err := s.teamRepo.GetMember(ctx, teamID, accountID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.teamRepo.RemoveMember(ctx, teamID, accountID); err != nil {
+		return err
+	}
+
+	recordAudit(ctx, s.auditRecorder, s.logger, audit.Entry{
+		OrganizationID: orgID,
+		Action:         auditActionTeamMemberRemoved,
+		TargetType:     auditTargetTeam,
+		TargetID:       strconv.FormatInt(int64(teamID), 10),
+		Changes:        audit.FieldChange("role", existing.Role, ""),
+		Metadata:       map[string]any{"account_id": accountID},
+	})
+
+	return nil
+}
+
+func (s *teamService) AuthorizeTeam(ctx context.Context, orgID, teamID int32) error {
+	if _, err := s.teamRepo.Get(ctx, orgID, teamID); err != nil {
+		if errors.Is(err, domain.ErrTeamNotFound) {
+			return auth.ErrTeamNotFound
+		}
+		return err
+	}
+	if _, err := s.callerMembership(ctx, teamID); err != nil {
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return auth.ErrForbidden
+		}
+		return err
+	}
+	return nil
+}
+
+// callerMembership returns the caller's membership in the team, or nil when
+// the caller sees every team. Returns ErrPermissionDenied when the caller is
+// not a member.
+func (s *teamService) callerMembership(ctx context.Context, teamID int32) (*domain.TeamMember, error) {
+	viewer := auth.TeamScope(ctx)
+	if viewer == nil {
+		return nil, nil
+	}
+	member, err := s.teamRepo.GetMember(ctx, teamID, *viewer)
+	if err != nil {
+		if errors.Is(err, domain.ErrTeamMemberNotFound) {
+			return nil, domain.ErrPermissionDenied
+		}
+		return nil, err
+	}
+	return member, nil
+}
+
+// requireLead returns ErrPermissionDenied unless the caller sees every team
+// or leads this one.
+func (s *teamService) requireLead(ctx context.Context, teamID int32) error {
+	member, err := s.callerMembership(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if member != nil && !member.IsLead() {
+		return domain.ErrPermissionDenied
+	}
+	return nil
+}
+
+func (s *teamService) recordTeamAudit(ctx context.Context, action string, orgID, teamID int32, before, after *domain.Team) {
+	recordAudit(ctx, s.auditRecorder, s.logger, audit.Entry{
+		OrganizationID: orgID,
+		Action:         action,
+		TargetType:     auditTargetTeam,
+		TargetID:       strconv.FormatInt(int64(teamID), 10),
+		Changes:        audit.Diff(teamAuditFields(before), teamAuditFields(after)),
+	})
+}
+
+// teamAuditFields returns the audited fields of a team.
+func teamAuditFields(team *domain.Team) map[string]any {
+	if team == nil {
+		return nil
+	}
+	return map[string]any{
+		"name":        team.Name,
+		"description": team.Description,
+	}
+}
@@ -0,0 +1,19 @@
+package services
+
+import "context"
+
+// WebhookService applies auth provider webhooks to local organizations and accounts.
+type WebhookService interface {
+	// ProcessAuthWebhook decodes a verified webhook body and syncs the affected records.
+	// Redelivered events are detected by event ID and acknowledged without side effects.
+	ProcessAuthWebhook(ctx context.Context, payload []byte) (*WebhookResult, error)
+}
+
+// WebhookResult describes how an inbound webhook was handled.
+type WebhookResult struct {
+	EventID    string `json:"event_id"`
+	Action     string `json:"action"`
+	ObjectType string `json:"object_type"`
+	Duplicate  bool   `json:"duplicate"`
+	Changed    bool   `json:"changed"`
+}
@@ -0,0 +1,303 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	audit "github.com/moasq/backend/app/audit/domain"
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/app/organizations/domain/events"
+	"github.com/moasq/backend/pkg/eventbus"
+	loggerDomain "github.com/moasq/backend/pkg/logger"
+	"github.com/moasq/backend/pkg/metrics"
+	"github.com/moasq/backend/pkg/redis"
+)
+
+const (
+	// webhookDedupKeyPrefix namespaces processed webhook event IDs in Redis.
+	webhookDedupKeyPrefix = "organizations:webhooks:auth:event:"
+	// webhookDedupTTL covers the provider's retry window.
+	webhookDedupTTL = 72 * time.Hour
+	// webhookSourceAPI marks changes made through our own API calls. Those flows
+	// create the local account themselves, so the webhook must not race them.
+	webhookSourceAPI = "API"
+)
+
+type webhookService struct {
+	decoder          domain.AuthWebhookDecoder
+	localOrgRepo     domain.OrganizationRepository
+	localAccountRepo domain.AccountRepository
+	eventBus         eventbus.EventBus
+	cache            redis.Client
+	logger           loggerDomain.Logger
+}
+
+func NewWebhookService(
+	decoder domain.AuthWebhookDecoder,
+	localOrgRepo domain.OrganizationRepository,
+	localAccountRepo domain.AccountRepository,
+	eventBus eventbus.EventBus,
+	cache redis.Client,
+	logger loggerDomain.Logger,
+) WebhookService {
+	return &webhookService{
+		decoder:          decoder,
+		localOrgRepo:     localOrgRepo,
+		localAccountRepo: localAccountRepo,
+		eventBus:         eventBus,
+		cache:            cache,
+		logger:           logger,
+	}
+}
+
+// ProcessAuthWebhook decodes and applies a single webhook delivery.
+func (s *webhookService) ProcessAuthWebhook(ctx context.Context, payload []byte) (*WebhookResult, error) {
+	event, err := s.decoder.Decode(ctx, payload)
+	if err != nil {
+		observeWebhook(nil, metrics.WebhookInvalid)
+		return nil, err
+	}
+
+	// Changes synced from the auth provider are attributed to the webhook in the audit log
+	ctx = audit.WithActor(ctx, audit.Actor{Type: audit.ActorWebhook})
+
+	result := &WebhookResult{
+		EventID:    event.EventID,
+		Action:     string(event.Action),
+		ObjectType: string(event.ObjectType),
+	}
+
+	dedupKey := webhookDedupKeyPrefix + event.EventID
+	firstSeen, err := s.cache.SetNX(ctx, dedupKey, "1", webhookDedupTTL)
+	if err != nil {
+		// Handlers are state based, so reprocessing is safe when Redis is unavailable.
+		s.logger.Warn("webhook dedup check failed, processing anyway", loggerDomain.Fields{
+			"event_id": event.EventID,
+			"error":    err.Error(),
+		})
+		firstSeen = true
+	}
+	if !firstSeen {
+		s.logger.Info("duplicate webhook event ignored", loggerDomain.Fields{
+			"event_id": event.EventID,
+		})
+		result.Duplicate = true
+		observeWebhook(event, metrics.WebhookDuplicate)
+		return result, nil
+	}
+
+	outcome := metrics.WebhookProcessed
+	switch event.ObjectType {
+	case domain.AuthWebhookObjectMember:
+		result.Changed, err = s.handleMemberEvent(ctx, event)
+	case domain.AuthWebhookObjectOrganization:
+		result.Changed, err = s.handleOrganizationEvent(ctx, event)
+	default:
+		outcome = metrics.WebhookIgnored
+		s.logger.Debug("unhandled webhook object type", loggerDomain.Fields{
+			"event_id":    event.EventID,
+			"object_type": event.ObjectType,
+		})
+	}
+
+	if err != nil {
+		// Release the dedup key so the provider's retry is processed.
+		if delErr := s.cache.Delete(ctx, dedupKey); delErr != nil {
+			s.logger.Warn("failed to release webhook dedup key", loggerDomain.Fields{
+				"event_id": event.EventID,
+				"error":    delErr.Error(),
+			})
+		}
+		observeWebhook(event, metrics.WebhookFailed)
+		return nil, err
+	}
+	observeWebhook(event, outcome)
+
+	s.logger.Info("webhook event processed", loggerDomain.Fields{
+		"event_id":    event.EventID,
+		"action":      event.Action,
+		"object_type": event.ObjectType,
+		"source":      event.Source,
+		"changed":     result.Changed,
+	})
+
+	return result, nil
+}
+
+func (s *webhookService) handleMemberEvent(ctx context.Context, event *domain.AuthWebhookEvent) (bool, error) {
+	member := event.Member
+
+	org, err := s.localOrgRepo.GetByStytchID(ctx, member.OrganizationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrOrganizationNotFound) {
+			s.logger.Warn("webhook for unknown organization ignored", loggerDomain.Fields{
+				"event_id":    event.EventID,
+				"auth_org_id": member.OrganizationID,
+			})
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to resolve local organization: %w", err)
+	}
+
+	account, err := s.localAccountRepo.GetByEmail(ctx, org.ID, member.Email)
+	if err != nil && !errors.Is(err, domain.ErrAccountNotFound) {
+		return false, fmt.Errorf("failed to get local account: %w", err)
+	}
+	if errors.Is(err, domain.ErrAccountNotFound) {
+		account = nil
+	}
+
+	if event.Action == domain.AuthWebhookActionDelete || member.Status == remoteMemberStatusDeleted {
+		return s.deleteAccount(ctx, account)
+	}
+
+	if account == nil {
+		if event.Source == webhookSourceAPI {
+			return false, nil
+		}
+		return s.createAccount(ctx, org.ID, member)
+	}
+
+	return s.updateAccount(ctx, account, member)
+}
+
+func (s *webhookService) createAccount(ctx context.Context, orgID int32, member *domain.AuthMember) (bool, error) {
+	roleSlug := primaryRoleSlug(member.Roles)
+	if roleSlug == "" {
+		roleSlug = "member"
+	}
+
+	fullName := strings.TrimSpace(member.Name)
+	if fullName == "" {
+		fullName = member.Email
+	}
+
+	account, err := s.localAccountRepo.Create(ctx, &domain.Account{
+		OrganizationID: orgID,
+		Email:          member.Email,
+		FullName:       fullName,
+		Role:           mapRoleSlugToAccountRole(roleSlug),
+		Status:         "active",
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create local account: %w", err)
+	}
+
+	account, err = s.localAccountRepo.UpdateStytchInfo(ctx, orgID, account.ID, member.MemberID, roleSlug, roleSlug, member.EmailVerified)
+	if err != nil {
+		return false, fmt.Errorf("failed to map auth member locally: %w", err)
+	}
+
+	s.publish(ctx, events.NewAccountCreatedEvent(account))
+	return true, nil
+}
+
+func (s *webhookService) updateAccount(ctx context.Context, account *domain.Account, member *domain.AuthMember) (bool, error) {
+	previousRole := account.Role
+	previousStatus := account.Status
+
+	roleSlug := primaryRoleSlug(member.Roles)
+	if roleSlug == "" {
+		roleSlug = account.StytchRoleSlug
+	}
+
+	updated := account
+	changed := false
+
+	if account.StytchMemberID != member.MemberID ||
+		account.StytchRoleSlug != roleSlug ||
+		account.StytchEmailVerified != member.EmailVerified {
+		var err error
+		updated, err = s.localAccountRepo.UpdateStytchInfo(ctx, account.OrganizationID, account.ID, member.MemberID, roleSlug, roleSlug, member.EmailVerified)
+		if err != nil {
+			return false, fmt.Errorf("failed to update auth member mapping: %w", err)
+		}
+		changed = true
+	}
+
+	fullName := strings.TrimSpace(member.Name)
+	if fullName == "" {
+		fullName = updated.FullName
+	}
+	role := updated.Role
+	if roleSlug != "" {
+		role = mapRoleSlugToAccountRole(roleSlug)
+	}
+
+	if updated.Role != role || updated.FullName != fullName {
+		next := *updated
+		next.Role = role
+		next.FullName = fullName
+		result, err := s.localAccountRepo.Update(ctx, &next)
+		if err != nil {
+			return false, fmt.Errorf("failed to update local account: %w", err)
+		}
+		updated = result
+		changed = true
+	}
+
+	if changed {
+		s.publish(ctx, events.NewAccountUpdatedEvent(updated, previousRole, previousStatus))
+	}
+	return changed, nil
+}
+
+func (s *webhookService) deleteAccount(ctx context.Context, account *domain.Account) (bool, error) {
+	if account == nil {
+		return false, nil
+	}
+
+	if err := s.localAccountRepo.Delete(ctx, account.OrganizationID, account.ID); err != nil {
+		if errors.Is(err, domain.ErrAccountNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to delete local account: %w", err)
+	}
+
+	s.publish(ctx, events.NewAccountDeletedEvent(account.ID, account.OrganizationID, account.Email, account.StytchMemberID))
+	return true, nil
+}
+
+func (s *webhookService) handleOrganizationEvent(ctx context.Context, event *domain.AuthWebhookEvent) (bool, error) {
+	if event.Action != domain.AuthWebhookActionUpdate {
+		return false, nil
+	}
+
+	authOrg := event.Organization
+	org, err := s.localOrgRepo.GetByStytchID(ctx, authOrg.OrganizationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrOrganizationNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to resolve local organization: %w", err)
+	}
+
+	name := strings.TrimSpace(authOrg.DisplayName)
+	if name == "" || name == org.Name {
+		return false, nil
+	}
+
+	previousName := org.Name
+	next := *org
+	next.Name = name
+	updated, err := s.localOrgRepo.Update(ctx, &next)
+	if err != nil {
+		return false, fmt.Errorf("failed to update local organization: %w", err)
+	}
+
+	s.publish(ctx, events.NewOrganizationUpdatedEvent(updated, previousName))
+	return true, nil
+}
+
+// publish emits a domain event; the local write already happened so failures are only logged.
+func (s *webhookService) publish(ctx context.Context, event eventbus.Event) {
+	if err := s.eventBus.Publish(ctx, event); err != nil {
+		s.logger.Warn("failed to publish organization event", loggerDomain.Fields{
+			"event": event.EventName(),
+			"error": err.Error(),
+		})
+	}
+}
@@ -8,5 +8,13 @@ import (
 
 func Init(container *dig.Container) error {
 	module := organizations.NewModule(container)
-	return module.RegisterDependencies()
+	if err := module.RegisterDependencies(); err != nil {
+		return err
+	}
+
+	if err := module.RegisterEventHandlers(); err != nil {
+		return err
+	}
+
+	return module.StartBackgroundJobs()
 }
\ No newline at end of file
@@ -19,6 +19,14 @@ type AuthMember struct {
 	UpdatedAt      time.Time `json:"updated_at"`
 }
 
+// AuthMemberPage is one page of auth provider members. NextCursor is empty on
+// the last page; Total is -1 when the provider does not count results.
+type AuthMemberPage struct {
+	Members    []*AuthMember
+	NextCursor string
+	Total      int
+}
+
 // AuthOrganization represents an organization (tenant) from the auth provider.
 type AuthOrganization struct {
 	OrganizationID string    `json:"organization_id"`
@@ -168,6 +176,9 @@ type AuthOrganizationRepository interface {
 	GetOrganization(ctx context.Context, organizationID string) (*AuthOrganization, error)
 	DeleteOrganization(ctx context.Context, organizationID string) error
 	CheckEmailExists(ctx context.Context, email string) (bool, error)
+	// SetEmailJITDomains lets users from domains join the organization just in
+	// time at the auth provider. An empty list turns email JIT provisioning off.
+	SetEmailJITDomains(ctx context.Context, organizationID string, domains []string) error
 }
 
 // AuthMemberRepository defines auth provider member operations.
@@ -177,6 +188,8 @@ type AuthMemberRepository interface {
 	GetMember(ctx context.Context, organizationID, memberID string) (*AuthMember, error)
 	GetMemberByEmail(ctx context.Context, organizationID, email string) (*AuthMember, error)
 	ListMembers(ctx context.Context, organizationID string, limit, offset int) ([]*AuthMember, error)
+	// ListMembersPage returns the page of members starting at cursor ("" for the first page).
+	ListMembersPage(ctx context.Context, organizationID, cursor string, limit int) (*AuthMemberPage, error)
 	RemoveMembers(ctx context.Context, req *RemoveAuthMembersRequest) error
 	AssignRoles(ctx context.Context, req *AssignAuthRolesRequest) error
 	SendMagicLink(ctx context.Context, req *SendMagicLinkRequest) error
@@ -4,12 +4,15 @@ import "errors"
 
 // Organization errors
 var (
-	ErrOrganizationNotFound      = errors.New("organization not found")
-	ErrOrganizationNameRequired  = errors.New("organization name is required")
-	ErrOrganizationSlugRequired  = errors.New("organization slug is required")
-	ErrOrganizationSlugTooShort  = errors.New("organization slug must be at least 3 characters")
-	ErrOrganizationSlugTaken     = errors.New("organization slug is already taken")
-	ErrOrganizationInactive      = errors.New("organization is inactive")
+	ErrOrganizationNotFound     = errors.New("organization not found")
+	ErrOrganizationNameRequired = errors.New("organization name is required")
+	ErrOrganizationSlugRequired = errors.New("organization slug is required")
+	ErrOrganizationSlugTooShort = errors.New("organization slug must be at least 3 characters")
+	ErrOrganizationSlugTaken    = errors.New("organization slug is already taken")
+	ErrOrganizationInactive     = errors.New("organization is inactive")
+	ErrInvalidSetting           = errors.New("invalid organization setting")
+	ErrInvalidSecurityPolicy    = errors.New("invalid security policy")
+	ErrSecurityPolicyLockout    = errors.New("security policy would block your current session")
 )
 
 // Account errors
@@ -51,6 +54,13 @@ var (
 	ErrAuthOrganizationIDRequired          = errors.New("auth organization ID is required")
 )
 
+// Auth provider session-related errors
+var (
+	ErrAuthSessionNotFound    = errors.New("auth session not found")
+	ErrAuthSessionIDRequired  = errors.New("session ID is required")
+	ErrAccountNotLinkedToAuth = errors.New("account is not linked to an auth member")
+)
+
 // Auth provider role-related errors
 var (
 	ErrAuthRoleNotFound    = errors.New("auth role not found")
@@ -65,6 +75,48 @@ var (
 	ErrAuthRateLimit    = errors.New("auth provider rate limit exceeded")
 )
 
+// Auth provider SSO errors
+var (
+	ErrSSOConnectionNotFound       = errors.New("sso connection not found")
+	ErrSSOConnectionIDRequired     = errors.New("sso connection ID is required")
+	ErrSSOInvalidConnectionType    = errors.New("sso connection type must be saml or oidc")
+	ErrSSODisplayNameRequired      = errors.New("sso connection display name is required")
+	ErrSSOConnectionTypeMismatch   = errors.New("sso connection has a different type")
+	ErrSSOMetadataRequired         = errors.New("metadata_url, or idp_entity_id, idp_sso_url, and x509_certificate are required")
+	ErrSSOMetadataConflict         = errors.New("metadata_url cannot be combined with individual IdP values")
+	ErrSSOInvalidURL               = errors.New("sso URLs must be absolute https URLs")
+	ErrSSONoActiveConnection       = errors.New("an active sso connection is required")
+	ErrOrganizationNotLinkedToAuth = errors.New("organization is not linked to the auth provider")
+)
+
+// Email domain and JIT provisioning errors
+var (
+	ErrDomainNotFound           = errors.New("domain not found")
+	ErrInvalidDomain            = errors.New("invalid domain")
+	ErrPublicEmailDomain        = errors.New("public email provider domains cannot be claimed")
+	ErrDomainAlreadyClaimed     = errors.New("domain is already claimed by this organization")
+	ErrDomainOwnedElsewhere     = errors.New("domain is verified by another organization")
+	ErrDomainVerificationFailed = errors.New("domain verification record not found")
+	ErrDomainNotVerified        = errors.New("domain must be verified first")
+	ErrInvalidJITRole           = errors.New("default role must be member or approver")
+)
+
+// Team errors
+var (
+	ErrTeamNotFound       = errors.New("team not found")
+	ErrTeamNameRequired   = errors.New("team name is required")
+	ErrInvalidTeam        = errors.New("invalid team")
+	ErrTeamNameTaken      = errors.New("team name is already taken")
+	ErrTeamInUse          = errors.New("team still owns documents or resources")
+	ErrInvalidTeamRole    = errors.New("team role must be lead or member")
+	ErrTeamMemberNotFound = errors.New("team member not found")
+)
+
+// Auth provider webhook errors
+var (
+	ErrAuthWebhookPayloadInvalid = errors.New("auth webhook payload is invalid")
+)
+
 // OrganizationError represents a domain-specific organization error
 type OrganizationError struct {
 	Type           string `json:"type"`
@@ -115,4 +167,4 @@ func NewAccountError(errorType, message string, accountID, orgID *int32, cause e
 		OrganizationID: orgID,
 		Cause:          cause,
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,96 @@
+package events
+
+import (
+	"strconv"
+
+	audit "github.com/moasq/backend/app/audit/domain"
+)
+
+// Audit target types for organization events
+const (
+	AuditTargetOrganization = "organization"
+	AuditTargetAccount      = "account"
+)
+
+func (e *OrganizationCreatedEvent) AuditEntry() (audit.Entry, bool) {
+	if e.Organization == nil {
+		return audit.Entry{}, false
+	}
+	entry := audit.Entry{
+		OrganizationID: e.Organization.ID,
+		Action:         e.Name,
+		TargetType:     AuditTargetOrganization,
+		TargetID:       formatID(e.Organization.ID),
+		Metadata:       map[string]any{"name": e.Organization.Name, "slug": e.Organization.Slug},
+	}
+	if e.OwnerAccount != nil {
+		entry.Metadata["owner_email"] = e.OwnerAccount.Email
+	}
+	return entry, true
+}
+
+func (e *OrganizationUpdatedEvent) AuditEntry() (audit.Entry, bool) {
+	if e.Organization == nil {
+		return audit.Entry{}, false
+	}
+	return audit.Entry{
+		OrganizationID: e.Organization.ID,
+		Action:         e.Name,
+		TargetType:     AuditTargetOrganization,
+		TargetID:       formatID(e.Organization.ID),
+		Changes:        audit.FieldChange("name", e.PreviousName, e.Organization.Name),
+	}, true
+}
+
+func (e *AccountCreatedEvent) AuditEntry() (audit.Entry, bool) {
+	if e.Account == nil {
+		return audit.Entry{}, false
+	}
+	return audit.Entry{
+		OrganizationID: e.OrganizationID,
+		Action:         e.Name,
+		TargetType:     AuditTargetAccount,
+		TargetID:       formatID(e.Account.ID),
+		Metadata:       map[string]any{"email": e.Account.Email, "role": e.Account.Role},
+	}, true
+}
+
+func (e *AccountUpdatedEvent) AuditEntry() (audit.Entry, bool) {
+	if e.Account == nil {
+		return audit.Entry{}, false
+	}
+	return audit.Entry{
+		OrganizationID: e.OrganizationID,
+		Action:         e.Name,
+		TargetType:     AuditTargetAccount,
+		TargetID:       formatID(e.Account.ID),
+		Changes: audit.Diff(
+			map[string]any{"role": e.PreviousRole, "status": e.PreviousStatus},
+			map[string]any{"role": e.Account.Role, "status": e.Account.Status},
+		),
+		Metadata: map[string]any{"email": e.Account.Email},
+	}, true
+}
+
+func (e *AccountDeletedEvent) AuditEntry() (audit.Entry, bool) {
+	return audit.Entry{
+		OrganizationID: e.OrganizationID,
+		Action:         e.Name,
+		TargetType:     AuditTargetAccount,
+		TargetID:       formatID(e.AccountID),
+		Metadata:       map[string]any{"email": e.Email},
+	}, true
+}
+
+func (e *AccountLoginEvent) AuditEntry() (audit.Entry, bool) {
+	return audit.Entry{
+		OrganizationID: e.OrganizationID,
+		Action:         e.Name,
+		TargetType:     AuditTargetAccount,
+		TargetID:       formatID(e.AccountID),
+	}, true
+}
+
+func formatID(id int32) string {
+	return strconv.FormatInt(int64(id), 10)
+}
@@ -3,7 +3,9 @@ package events
 import (
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/eventbus"
 )
 
 const (
@@ -16,53 +18,104 @@ const (
 )
 
 type OrganizationCreatedEvent struct {
-	EventID       string                `json:"event_id"`
-	EventType     string                `json:"event_type"`
-	Timestamp     time.Time             `json:"timestamp"`
-	Organization  *domain.Organization  `json:"organization"`
-	OwnerAccount  *domain.Account       `json:"owner_account"`
+	eventbus.BaseEvent
+	Organization *domain.Organization `json:"organization"`
+	OwnerAccount *domain.Account      `json:"owner_account"`
 }
 
 type OrganizationUpdatedEvent struct {
-	EventID      string               `json:"event_id"`
-	EventType    string               `json:"event_type"`
-	Timestamp    time.Time            `json:"timestamp"`
+	eventbus.BaseEvent
 	Organization *domain.Organization `json:"organization"`
 	PreviousName string               `json:"previous_name"`
 }
 
 type AccountCreatedEvent struct {
-	EventID        string              `json:"event_id"`
-	EventType      string              `json:"event_type"`
-	Timestamp      time.Time           `json:"timestamp"`
-	Account        *domain.Account     `json:"account"`
-	OrganizationID int32               `json:"organization_id"`
+	eventbus.BaseEvent
+	Account        *domain.Account `json:"account"`
+	OrganizationID int32           `json:"organization_id"`
 }
 
 type AccountUpdatedEvent struct {
-	EventID        string              `json:"event_id"`
-	EventType      string              `json:"event_type"`
-	Timestamp      time.Time           `json:"timestamp"`
-	Account        *domain.Account     `json:"account"`
-	OrganizationID int32               `json:"organization_id"`
-	PreviousRole   string              `json:"previous_role"`
-	PreviousStatus string              `json:"previous_status"`
+	eventbus.BaseEvent
+	Account        *domain.Account `json:"account"`
+	OrganizationID int32           `json:"organization_id"`
+	PreviousRole   string          `json:"previous_role"`
+	PreviousStatus string          `json:"previous_status"`
 }
 
 type AccountDeletedEvent struct {
-	EventID        string              `json:"event_id"`
-	EventType      string              `json:"event_type"`
-	Timestamp      time.Time           `json:"timestamp"`
-	AccountID      int32               `json:"account_id"`
-	OrganizationID int32               `json:"organization_id"`
-	Email          string              `json:"email"`
+	eventbus.BaseEvent
+	AccountID      int32  `json:"account_id"`
+	OrganizationID int32  `json:"organization_id"`
+	Email          string `json:"email"`
+	MemberID       string `json:"member_id,omitempty"`
 }
 
 type AccountLoginEvent struct {
-	EventID        string              `json:"event_id"`
-	EventType      string              `json:"event_type"`
-	Timestamp      time.Time           `json:"timestamp"`
-	AccountID      int32               `json:"account_id"`
-	OrganizationID int32               `json:"organization_id"`
-	Email          string              `json:"email"`
-}
\ No newline at end of file
+	eventbus.BaseEvent
+	AccountID      int32  `json:"account_id"`
+	OrganizationID int32  `json:"organization_id"`
+	Email          string `json:"email"`
+}
+
+func newBaseEvent(name string) eventbus.BaseEvent {
+	return eventbus.BaseEvent{
+		ID:        uuid.New().String(),
+		Name:      name,
+		CreatedAt: time.Now(),
+		Meta:      make(map[string]interface{}),
+	}
+}
+
+func NewOrganizationCreatedEvent(org *domain.Organization, owner *domain.Account) *OrganizationCreatedEvent {
+	return &OrganizationCreatedEvent{
+		BaseEvent:    newBaseEvent(OrganizationCreatedEventType),
+		Organization: org,
+		OwnerAccount: owner,
+	}
+}
+
+func NewOrganizationUpdatedEvent(org *domain.Organization, previousName string) *OrganizationUpdatedEvent {
+	return &OrganizationUpdatedEvent{
+		BaseEvent:    newBaseEvent(OrganizationUpdatedEventType),
+		Organization: org,
+		PreviousName: previousName,
+	}
+}
+
+func NewAccountCreatedEvent(account *domain.Account) *AccountCreatedEvent {
+	return &AccountCreatedEvent{
+		BaseEvent:      newBaseEvent(AccountCreatedEventType),
+		Account:        account,
+		OrganizationID: account.OrganizationID,
+	}
+}
+
+func NewAccountUpdatedEvent(account *domain.Account, previousRole, previousStatus string) *AccountUpdatedEvent {
+	return &AccountUpdatedEvent{
+		BaseEvent:      newBaseEvent(AccountUpdatedEventType),
+		Account:        account,
+		OrganizationID: account.OrganizationID,
+		PreviousRole:   previousRole,
+		PreviousStatus: previousStatus,
+	}
+}
+
+func NewAccountDeletedEvent(accountID, organizationID int32, email, memberID string) *AccountDeletedEvent {
+	return &AccountDeletedEvent{
+		BaseEvent:      newBaseEvent(AccountDeletedEventType),
+		AccountID:      accountID,
+		OrganizationID: organizationID,
+		Email:          email,
+		MemberID:       memberID,
+	}
+}
+
+func NewAccountLoginEvent(accountID, organizationID int32, email string) *AccountLoginEvent {
+	return &AccountLoginEvent{
+		BaseEvent:      newBaseEvent(AccountLoginEventType),
+		AccountID:      accountID,
+		OrganizationID: organizationID,
+		Email:          email,
+	}
+}
@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DNS TXT record an organization publishes to prove it owns a domain:
+// _saas-verification.<domain> TXT "saas-verification=<token>".
+const (
+	DomainVerificationRecordType  = "TXT"
+	DomainVerificationRecordLabel = "_saas-verification"
+	DomainVerificationValuePrefix = "saas-verification="
+	DefaultJITRole                = "member"
+	maxDomainLength               = 253
+)
+
+// Roles just-in-time provisioned members may receive. Admin is deliberately
+// excluded: elevated roles are granted by an existing admin.
+var jitRoles = map[string]struct{}{
+	"member":   {},
+	"approver": {},
+}
+
+var domainPattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+// publicEmailDomains are shared mailbox providers no organization can claim.
+var publicEmailDomains = map[string]struct{}{
+	"gmail.com":      {},
+	"googlemail.com": {},
+	"outlook.com":    {},
+	"hotmail.com":    {},
+	"live.com":       {},
+	"msn.com":        {},
+	"yahoo.com":      {},
+	"icloud.com":     {},
+	"me.com":         {},
+	"aol.com":        {},
+	"proton.me":      {},
+	"protonmail.com": {},
+	"gmx.com":        {},
+	"mail.com":       {},
+	"yandex.com":     {},
+}
+
+// OrganizationDomain is an email domain claimed by an organization. Users
+// from a verified domain with JIT enabled join the organization with
+// DefaultRole the first time they authenticate.
+type OrganizationDomain struct {
+	ID                 int32                    `json:"id"`
+	OrganizationID     int32                    `json:"organization_id"`
+	Domain             string                   `json:"domain"`
+	Verified           bool                     `json:"verified"`
+	VerifiedAt         *time.Time               `json:"verified_at,omitempty"`
+	JITEnabled         bool                     `json:"jit_enabled"`
+	DefaultRole        string                   `json:"default_role"`
+	VerificationRecord DomainVerificationRecord `json:"verification_record"`
+	CreatedAt          time.Time                `json:"created_at"`
+	UpdatedAt          time.Time                `json:"updated_at"`
+
+	VerificationToken string `json:"-"`
+}
+
+// JITActive reports whether users from the domain are provisioned just in time.
+func (d *OrganizationDomain) JITActive() bool {
+	return d.Verified && d.JITEnabled
+}
+
+// DomainVerificationRecord is the DNS record that proves domain ownership.
+type DomainVerificationRecord struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// NewDomainVerificationRecord returns the TXT record for a domain and token.
+func NewDomainVerificationRecord(domain, token string) DomainVerificationRecord {
+	return DomainVerificationRecord{
+		Type:  DomainVerificationRecordType,
+		Name:  DomainVerificationRecordLabel + "." + domain,
+		Value: DomainVerificationValuePrefix + token,
+	}
+}
+
+// DomainOwner is the organization that owns a verified email domain.
+type DomainOwner struct {
+	OrganizationID int32  `json:"-"`
+	Slug           string `json:"slug"`
+	Name           string `json:"name"`
+	Domain         string `json:"domain"`
+	JITEnabled     bool   `json:"jit_enabled"`
+}
+
+// ClaimDomainRequest claims an email domain for the caller's organization.
+type ClaimDomainRequest struct {
+	Domain string `json:"domain" binding:"required"`
+}
+
+// Validate checks the request and normalizes the domain in place.
+func (r *ClaimDomainRequest) Validate() error {
+	domain, err := NormalizeDomain(r.Domain)
+	if err != nil {
+		return err
+	}
+	r.Domain = domain
+	return nil
+}
+
+// UpdateDomainJITRequest turns just-in-time provisioning on or off for a domain.
+type UpdateDomainJITRequest struct {
+	JITEnabled *bool `json:"jit_enabled" binding:"required"`
+	// DefaultRole is given to provisioned members (member or approver). Defaults to member.
+	DefaultRole string `json:"default_role,omitempty"`
+}
+
+// Validate checks the request and defaults the role in place.
+func (r *UpdateDomainJITRequest) Validate() error {
+	if r.JITEnabled == nil {
+		return fmt.Errorf("%w: jit_enabled is required", ErrInvalidDomain)
+	}
+	r.DefaultRole = strings.ToLower(strings.TrimSpace(r.DefaultRole))
+	if r.DefaultRole == "" {
+		r.DefaultRole = DefaultJITRole
+	}
+	if _, ok := jitRoles[r.DefaultRole]; !ok {
+		return fmt.Errorf("%w: %q", ErrInvalidJITRole, r.DefaultRole)
+	}
+	return nil
+}
+
+// NormalizeDomain lowercases and validates a domain name. Shared mailbox
+// providers (gmail.com, outlook.com, ...) are rejected.
+func NormalizeDomain(raw string) (string, error) {
+	domain := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(raw)), ".")
+	if len(domain) > maxDomainLength || !domainPattern.MatchString(domain) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidDomain, raw)
+	}
+	if _, public := publicEmailDomains[domain]; public {
+		return "", ErrPublicEmailDomain
+	}
+	return domain, nil
+}
+
+// EmailDomain returns the lowercased domain part of an email address, or "".
+func EmailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(email[at+1:]))
+}
+
+// OrganizationDomainRepository stores organizations' claimed email domains.
+type OrganizationDomainRepository interface {
+	Create(ctx context.Context, orgID int32, domain, verificationToken string) (*OrganizationDomain, error)
+	Get(ctx context.Context, orgID, domainID int32) (*OrganizationDomain, error)
+	// GetVerified returns the verified claim on domain, across organizations.
+	GetVerified(ctx context.Context, domain string) (*OrganizationDomain, error)
+	List(ctx context.Context, orgID int32) ([]*OrganizationDomain, error)
+	MarkVerified(ctx context.Context, orgID, domainID int32) (*OrganizationDomain, error)
+	UpdateJIT(ctx context.Context, orgID, domainID int32, enabled bool, defaultRole string) (*OrganizationDomain, error)
+	Delete(ctx context.Context, orgID, domainID int32) error
+}
+
+// DomainVerifier checks that a domain publishes its verification token.
+// The DNS implementation lives in infra; tests can substitute a fake.
+type DomainVerifier interface {
+	// Verify reports whether the domain's verification record carries token.
+	Verify(ctx context.Context, domain, token string) (bool, error)
+}
+
+// SeatLimitRepository reads an organization's seat limit from its subscription.
+type SeatLimitRepository interface {
+	// GetMaxSeats returns the organization's seat limit, or 0 when it has none.
+	GetMaxSeats(ctx context.Context, orgID int32) (int32, error)
+}
@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+// DriftType classifies a difference between the auth provider and local accounts.
+type DriftType string
+
+const (
+	// DriftMissingLocal means the auth provider has a member with no local account.
+	DriftMissingLocal DriftType = "missing_local"
+	// DriftOrphanedLocal means a local account has no matching auth provider member.
+	DriftOrphanedLocal DriftType = "orphaned_local"
+	// DriftRoleMismatch means the local role differs from the auth provider role.
+	DriftRoleMismatch DriftType = "role_mismatch"
+	// DriftMemberIDMismatch means the local account is linked to a different (or no) member ID.
+	DriftMemberIDMismatch DriftType = "member_id_mismatch"
+	// DriftEmailVerifiedMismatch means the cached email verification flag is stale.
+	DriftEmailVerifiedMismatch DriftType = "email_verified_mismatch"
+)
+
+// MemberDrift describes a single drift entry found during reconciliation.
+type MemberDrift struct {
+	Type        DriftType `json:"type"`
+	Email       string    `json:"email"`
+	MemberID    string    `json:"member_id,omitempty"`
+	AccountID   int32     `json:"account_id,omitempty"`
+	LocalValue  string    `json:"local_value,omitempty"`
+	RemoteValue string    `json:"remote_value,omitempty"`
+	Applied     bool      `json:"applied"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// ReconciliationReport summarizes a reconciliation run for one organization.
+type ReconciliationReport struct {
+	OrganizationID int32         `json:"organization_id"`
+	ProviderOrgID  string        `json:"provider_org_id"`
+	DryRun         bool          `json:"dry_run"`
+	StartedAt      time.Time     `json:"started_at"`
+	CompletedAt    time.Time     `json:"completed_at"`
+	RemoteMembers  int           `json:"remote_members"`
+	LocalAccounts  int           `json:"local_accounts"`
+	Drifts         []MemberDrift `json:"drifts"`
+	Applied        int           `json:"applied"`
+	Failed         int           `json:"failed"`
+	// OrphansSkipped is set when the member list may be incomplete, so local
+	// accounts were not checked for orphans rather than deactivated wrongly.
+	OrphansSkipped bool `json:"orphans_skipped,omitempty"`
+}
+
+// AddDrift records a drift entry and updates the applied/failed counters.
+func (r *ReconciliationReport) AddDrift(drift MemberDrift) {
+	r.Drifts = append(r.Drifts, drift)
+	if drift.Applied {
+		r.Applied++
+	} else if drift.Error != "" {
+		r.Failed++
+	}
+}
+
+// HasDrift reports whether any drift was detected.
+func (r *ReconciliationReport) HasDrift() bool {
+	return len(r.Drifts) > 0
+}
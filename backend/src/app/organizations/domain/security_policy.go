@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+// Login methods an organization can allow. Values mirror the AuthMethod constants in pkg/auth.
+const (
+	AuthMethodPassword  = "password"
+	AuthMethodMagicLink = "magic_link"
+	AuthMethodEmailOTP  = "email_otp"
+	AuthMethodOAuth     = "oauth"
+	AuthMethodSSO       = "sso"
+)
+
+var allowedAuthMethods = map[string]struct{}{
+	AuthMethodPassword:  {},
+	AuthMethodMagicLink: {},
+	AuthMethodEmailOTP:  {},
+	AuthMethodOAuth:     {},
+	AuthMethodSSO:       {},
+}
+
+// MaxIPAllowlistEntries bounds the IP allowlist checked on every request.
+const MaxIPAllowlistEntries = 100
+
+// SecurityPolicy is an organization's login and network restrictions,
+// enforced on every authenticated request. Empty lists impose no restriction.
+type SecurityPolicy struct {
+	OrganizationID     int32      `json:"organization_id"`
+	RequireMFA         bool       `json:"require_mfa"`
+	AllowedAuthMethods []string   `json:"allowed_auth_methods"`
+	IPAllowlist        []string   `json:"ip_allowlist"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
+}
+
+// UpdateSecurityPolicyRequest replaces an organization's security policy.
+type UpdateSecurityPolicyRequest struct {
+	RequireMFA         bool     `json:"require_mfa"`
+	AllowedAuthMethods []string `json:"allowed_auth_methods"`
+	// IPAllowlist holds CIDR blocks (e.g. 203.0.113.0/24) or single IPs.
+	IPAllowlist []string `json:"ip_allowlist"`
+}
+
+// Validate checks the request and normalizes it in place: methods are
+// lowercased and deduplicated, IPs become canonical CIDR blocks.
+func (r *UpdateSecurityPolicyRequest) Validate() error {
+	methods := make([]string, 0, len(r.AllowedAuthMethods))
+	seen := make(map[string]struct{}, len(r.AllowedAuthMethods))
+	for _, method := range r.AllowedAuthMethods {
+		method = strings.ToLower(strings.TrimSpace(method))
+		if _, ok := allowedAuthMethods[method]; !ok {
+			return fmt.Errorf("%w: unknown auth method %q", ErrInvalidSecurityPolicy, method)
+		}
+		if _, dup := seen[method]; dup {
+			continue
+		}
+		seen[method] = struct{}{}
+		methods = append(methods, method)
+	}
+	r.AllowedAuthMethods = methods
+
+	if len(r.IPAllowlist) > MaxIPAllowlistEntries {
+		return fmt.Errorf("%w: ip_allowlist cannot have more than %d entries", ErrInvalidSecurityPolicy, MaxIPAllowlistEntries)
+	}
+	cidrs := make([]string, 0, len(r.IPAllowlist))
+	seen = make(map[string]struct{}, len(r.IPAllowlist))
+	for _, raw := range r.IPAllowlist {
+		prefix, err := parseCIDR(raw)
+		if err != nil {
+			return fmt.Errorf("%w: invalid IP or CIDR %q", ErrInvalidSecurityPolicy, raw)
+		}
+		cidr := prefix.String()
+		if _, dup := seen[cidr]; dup {
+			continue
+		}
+		seen[cidr] = struct{}{}
+		cidrs = append(cidrs, cidr)
+	}
+	r.IPAllowlist = cidrs
+
+	return nil
+}
+
+// parseCIDR parses a CIDR block or a single IP (as a /32 or /128).
+func parseCIDR(raw string) (netip.Prefix, error) {
+	raw = strings.TrimSpace(raw)
+	if !strings.Contains(raw, "/") {
+		addr, err := netip.ParseAddr(raw)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(raw)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
+
+// SecurityPolicyLockoutError is returned when a policy update would deny the
+// caller's own session. Reason is the deny reason the auth middleware would report.
+type SecurityPolicyLockoutError struct {
+	Reason string
+}
+
+func (e *SecurityPolicyLockoutError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrSecurityPolicyLockout, e.Reason)
+}
+
+func (e *SecurityPolicyLockoutError) Unwrap() error {
+	return ErrSecurityPolicyLockout
+}
+
+// SecurityPolicyRepository persists organization security policies
+type SecurityPolicyRepository interface {
+	// Get returns the organization's policy, or an unrestricted policy if none is stored.
+	Get(ctx context.Context, orgID int32) (*SecurityPolicy, error)
+	// Save replaces the organization's policy.
+	Save(ctx context.Context, orgID int32, req *UpdateSecurityPolicyRequest) (*SecurityPolicy, error)
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// AuthSession represents an active member session at the auth provider.
+type AuthSession struct {
+	SessionID      string     `json:"session_id"`
+	MemberID       string     `json:"member_id"`
+	OrganizationID string     `json:"organization_id"`
+	AuthMethods    []string   `json:"auth_methods"`
+	StartedAt      *time.Time `json:"started_at,omitempty"`
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	Current        bool       `json:"current"`
+}
+
+// AuthSessionRepository defines auth provider session operations.
+type AuthSessionRepository interface {
+	ListSessions(ctx context.Context, organizationID, memberID string) ([]*AuthSession, error)
+	RevokeSession(ctx context.Context, sessionID string) error
+	RevokeMemberSessions(ctx context.Context, memberID string) error
+}
+
+// SessionRevocationStore rejects already-issued tokens for revoked sessions and members.
+// Provider-side revocation alone does not invalidate JWTs that are verified locally.
+type SessionRevocationStore interface {
+	RevokeSession(ctx context.Context, sessionID string) error
+	RevokeMember(ctx context.Context, memberID string, before time.Time) error
+}
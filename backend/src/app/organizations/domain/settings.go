@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Settings read by authorization policies. Keys mirror the constants in pkg/auth.
+const (
+	SettingAllowSelfApproval    = "allow_self_approval"
+	SettingEditOwnResourcesOnly = "edit_own_resources_only"
+)
+
+// booleanSettings lists the known settings that must hold a boolean value.
+var booleanSettings = map[string]struct{}{
+	SettingAllowSelfApproval:    {},
+	SettingEditOwnResourcesOnly: {},
+}
+
+// OrganizationSettings is an organization's free-form settings object.
+// Organizations without stored settings have an empty Values map.
+type OrganizationSettings struct {
+	OrganizationID int32          `json:"organization_id"`
+	Values         map[string]any `json:"values"`
+	UpdatedAt      *time.Time     `json:"updated_at,omitempty"`
+}
+
+// ValidateSettingValue checks the type of a known setting. Unknown keys are accepted.
+func ValidateSettingValue(key string, value any) error {
+	if _, ok := booleanSettings[key]; ok {
+		if _, isBool := value.(bool); !isBool {
+			return fmt.Errorf("%w: %s must be a boolean", ErrInvalidSetting, key)
+		}
+	}
+	return nil
+}
+
+// OrganizationSettingsRepository persists organization settings
+type OrganizationSettingsRepository interface {
+	// Get returns the organization's settings, or empty settings if none are stored.
+	Get(ctx context.Context, orgID int32) (*OrganizationSettings, error)
+	// Save replaces the organization's settings.
+	Save(ctx context.Context, orgID int32, values map[string]any) (*OrganizationSettings, error)
+}
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// SSOConnectionType is the protocol of an SSO connection.
+type SSOConnectionType string
+
+const (
+	SSOConnectionTypeSAML SSOConnectionType = "saml"
+	SSOConnectionTypeOIDC SSOConnectionType = "oidc"
+)
+
+// SSO connection statuses reported by the auth provider. A connection stays
+// pending until the IdP details it needs are configured.
+const (
+	SSOConnectionStatusPending = "pending"
+	SSOConnectionStatusActive  = "active"
+)
+
+// SSOConnection is an organization's SAML or OIDC connection at the auth provider.
+// Secrets (OIDC client secret, SAML private keys) are never returned.
+type SSOConnection struct {
+	ConnectionID     string            `json:"connection_id"`
+	Type             SSOConnectionType `json:"type"`
+	DisplayName      string            `json:"display_name"`
+	Status           string            `json:"status"`
+	IdentityProvider string            `json:"identity_provider,omitempty"`
+	IsDefault        bool              `json:"is_default"`
+
+	// SAML: service provider values to give the IdP, and the configured IdP values
+	ACSURL      string `json:"acs_url,omitempty"`
+	AudienceURI string `json:"audience_uri,omitempty"`
+	IdPEntityID string `json:"idp_entity_id,omitempty"`
+	IdPSSOURL   string `json:"idp_sso_url,omitempty"`
+
+	// OIDC: redirect URL to give the IdP, and the configured IdP values
+	RedirectURL      string `json:"redirect_url,omitempty"`
+	Issuer           string `json:"issuer,omitempty"`
+	ClientID         string `json:"client_id,omitempty"`
+	AuthorizationURL string `json:"authorization_url,omitempty"`
+	TokenURL         string `json:"token_url,omitempty"`
+	UserinfoURL      string `json:"userinfo_url,omitempty"`
+	JWKSURL          string `json:"jwks_url,omitempty"`
+}
+
+// SSOSettings is an organization's SSO configuration.
+type SSOSettings struct {
+	Connections         []*SSOConnection `json:"connections"`
+	DefaultConnectionID string           `json:"default_connection_id,omitempty"`
+	// SSORequired restricts member login to SSO.
+	SSORequired bool `json:"sso_required"`
+}
+
+// Connection returns the connection with the given ID, or nil.
+func (s *SSOSettings) Connection(connectionID string) *SSOConnection {
+	for _, conn := range s.Connections {
+		if conn.ConnectionID == connectionID {
+			return conn
+		}
+	}
+	return nil
+}
+
+// CreateSSOConnectionRequest creates a new, unconfigured SSO connection.
+type CreateSSOConnectionRequest struct {
+	Type             SSOConnectionType `json:"type" binding:"required,oneof=saml oidc"`
+	DisplayName      string            `json:"display_name" binding:"required,max=100"`
+	IdentityProvider string            `json:"identity_provider,omitempty"`
+}
+
+// UpdateSAMLConnectionRequest configures a SAML connection from the IdP's
+// metadata, either by URL or with the individual values.
+type UpdateSAMLConnectionRequest struct {
+	ConnectionID     string         `json:"-"`
+	MetadataURL      string         `json:"metadata_url,omitempty"`
+	IdPEntityID      string         `json:"idp_entity_id,omitempty"`
+	IdPSSOURL        string         `json:"idp_sso_url,omitempty"`
+	X509Certificate  string         `json:"x509_certificate,omitempty"`
+	AttributeMapping map[string]any `json:"attribute_mapping,omitempty"`
+}
+
+// UpdateOIDCConnectionRequest configures an OIDC connection. Endpoints not
+// provided are discovered by the auth provider from the issuer.
+type UpdateOIDCConnectionRequest struct {
+	ConnectionID     string `json:"-"`
+	Issuer           string `json:"issuer" binding:"required"`
+	ClientID         string `json:"client_id" binding:"required"`
+	ClientSecret     string `json:"client_secret" binding:"required"`
+	AuthorizationURL string `json:"authorization_url,omitempty"`
+	TokenURL         string `json:"token_url,omitempty"`
+	UserinfoURL      string `json:"userinfo_url,omitempty"`
+	JWKSURL          string `json:"jwks_url,omitempty"`
+}
+
+// SetDefaultSSOConnectionRequest sets the connection used for SSO login.
+type SetDefaultSSOConnectionRequest struct {
+	ConnectionID string `json:"connection_id" binding:"required"`
+}
+
+// SetSSORequiredRequest turns the SSO login requirement on or off.
+type SetSSORequiredRequest struct {
+	Required *bool `json:"required" binding:"required"`
+}
+
+// Validate validates the CreateSSOConnectionRequest.
+func (r *CreateSSOConnectionRequest) Validate() error {
+	if r.Type != SSOConnectionTypeSAML && r.Type != SSOConnectionTypeOIDC {
+		return ErrSSOInvalidConnectionType
+	}
+	if strings.TrimSpace(r.DisplayName) == "" {
+		return ErrSSODisplayNameRequired
+	}
+	return nil
+}
+
+// Validate validates the UpdateSAMLConnectionRequest.
+func (r *UpdateSAMLConnectionRequest) Validate() error {
+	if r.ConnectionID == "" {
+		return ErrSSOConnectionIDRequired
+	}
+	if r.MetadataURL != "" {
+		if r.IdPEntityID != "" || r.IdPSSOURL != "" || r.X509Certificate != "" {
+			return ErrSSOMetadataConflict
+		}
+		return validateHTTPSURL(r.MetadataURL)
+	}
+	if r.IdPEntityID == "" || r.IdPSSOURL == "" || r.X509Certificate == "" {
+		return ErrSSOMetadataRequired
+	}
+	return validateHTTPSURL(r.IdPSSOURL)
+}
+
+// Validate validates the UpdateOIDCConnectionRequest.
+func (r *UpdateOIDCConnectionRequest) Validate() error {
+	if r.ConnectionID == "" {
+		return ErrSSOConnectionIDRequired
+	}
+	for _, raw := range []string{r.Issuer, r.AuthorizationURL, r.TokenURL, r.UserinfoURL, r.JWKSURL} {
+		if raw == "" {
+			continue
+		}
+		if err := validateHTTPSURL(raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateHTTPSURL(raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return ErrSSOInvalidURL
+	}
+	return nil
+}
+
+// AuthSSORepository defines auth provider SSO connection operations.
+// organizationID is the auth provider's organization ID.
+type AuthSSORepository interface {
+	GetSSOSettings(ctx context.Context, organizationID string) (*SSOSettings, error)
+	CreateConnection(ctx context.Context, organizationID string, req *CreateSSOConnectionRequest) (*SSOConnection, error)
+	UpdateSAMLConnection(ctx context.Context, organizationID string, req *UpdateSAMLConnectionRequest) (*SSOConnection, error)
+	UpdateOIDCConnection(ctx context.Context, organizationID string, req *UpdateOIDCConnectionRequest) (*SSOConnection, error)
+	DeleteConnection(ctx context.Context, organizationID, connectionID string) error
+	SetDefaultConnection(ctx context.Context, organizationID, connectionID string) error
+	SetSSORequired(ctx context.Context, organizationID string, required bool) error
+}
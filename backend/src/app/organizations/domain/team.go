@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Team roles. Leads manage the team's membership and details; members only
+// gain visibility of the team's data.
+const (
+	TeamRoleLead   = "lead"
+	TeamRoleMember = "member"
+
+	maxTeamNameLength = 100
+)
+
+// Team groups members of an organization. Documents and resources assigned to
+// a team are visible only to its members and to organization managers.
+type Team struct {
+	ID             int32     `json:"id"`
+	OrganizationID int32     `json:"organization_id"`
+	Name           string    `json:"name"`
+	Description    *string   `json:"description,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// TeamMember is an account's membership in a team.
+type TeamMember struct {
+	TeamID    int32     `json:"team_id"`
+	AccountID int32     `json:"account_id"`
+	Email     string    `json:"email,omitempty"`
+	FullName  string    `json:"full_name,omitempty"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsLead reports whether the member manages the team.
+func (m *TeamMember) IsLead() bool {
+	return m.Role == TeamRoleLead
+}
+
+// CreateTeamRequest creates a team in the caller's organization.
+type CreateTeamRequest struct {
+	Name        string  `json:"name" binding:"required"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Validate checks the request and trims the name in place.
+func (r *CreateTeamRequest) Validate() error {
+	name, err := normalizeTeamName(r.Name)
+	if err != nil {
+		return err
+	}
+	r.Name = name
+	return nil
+}
+
+// UpdateTeamRequest renames a team or changes its description.
+type UpdateTeamRequest struct {
+	Name        string  `json:"name" binding:"required"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Validate checks the request and trims the name in place.
+func (r *UpdateTeamRequest) Validate() error {
+	name, err := normalizeTeamName(r.Name)
+	if err != nil {
+		return err
+	}
+	r.Name = name
+	return nil
+}
+
+// SetTeamMemberRequest adds an account to a team or changes its team role.
+type SetTeamMemberRequest struct {
+	// Role is lead or member. Defaults to member.
+	Role string `json:"role,omitempty"`
+}
+
+// Validate checks the request and defaults the role in place.
+func (r *SetTeamMemberRequest) Validate() error {
+	r.Role = strings.ToLower(strings.TrimSpace(r.Role))
+	if r.Role == "" {
+		r.Role = TeamRoleMember
+	}
+	if r.Role != TeamRoleLead && r.Role != TeamRoleMember {
+		return fmt.Errorf("%w: %q", ErrInvalidTeamRole, r.Role)
+	}
+	return nil
+}
+
+func normalizeTeamName(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if name == "" {
+		return "", ErrTeamNameRequired
+	}
+	if len(name) > maxTeamNameLength {
+		return "", fmt.Errorf("%w: name cannot exceed %d characters", ErrInvalidTeam, maxTeamNameLength)
+	}
+	return name, nil
+}
+
+// TeamRepository stores teams and their memberships.
+type TeamRepository interface {
+	Create(ctx context.Context, orgID int32, req *CreateTeamRequest) (*Team, error)
+	Get(ctx context.Context, orgID, teamID int32) (*Team, error)
+	List(ctx context.Context, orgID int32) ([]*Team, error)
+	// ListByAccount returns the teams the account belongs to.
+	ListByAccount(ctx context.Context, orgID, accountID int32) ([]*Team, error)
+	Update(ctx context.Context, orgID, teamID int32, req *UpdateTeamRequest) (*Team, error)
+	// Delete removes the team and its memberships. Returns ErrTeamInUse while
+	// documents or resources are still assigned to it.
+	Delete(ctx context.Context, orgID, teamID int32) error
+
+	// SetMember adds the account to the team or updates its role.
+	SetMember(ctx context.Context, teamID, accountID int32, role string) (*TeamMember, error)
+	GetMember(ctx context.Context, teamID, accountID int32) (*TeamMember, error)
+	ListMembers(ctx context.Context, teamID int32) ([]*TeamMember, error)
+	RemoveMember(ctx context.Context, teamID, accountID int32) error
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// AuthWebhookAction is the change an auth provider webhook reports.
+type AuthWebhookAction string
+
+const (
+	AuthWebhookActionCreate AuthWebhookAction = "create"
+	AuthWebhookActionUpdate AuthWebhookAction = "update"
+	AuthWebhookActionDelete AuthWebhookAction = "delete"
+)
+
+// AuthWebhookObjectType is the kind of object an auth provider webhook is about.
+type AuthWebhookObjectType string
+
+const (
+	AuthWebhookObjectMember       AuthWebhookObjectType = "member"
+	AuthWebhookObjectOrganization AuthWebhookObjectType = "organization"
+)
+
+// AuthWebhookEvent is a provider-agnostic view of an inbound auth provider webhook.
+// Exactly one of Member or Organization is set, depending on ObjectType.
+type AuthWebhookEvent struct {
+	EventID      string                `json:"event_id"`
+	Action       AuthWebhookAction     `json:"action"`
+	ObjectType   AuthWebhookObjectType `json:"object_type"`
+	Source       string                `json:"source"`
+	Timestamp    time.Time             `json:"timestamp"`
+	Member       *AuthMember           `json:"member,omitempty"`
+	Organization *AuthOrganization     `json:"organization,omitempty"`
+}
+
+// AuthWebhookDecoder turns a raw, already verified webhook body into an AuthWebhookEvent.
+type AuthWebhookDecoder interface {
+	Decode(ctx context.Context, payload []byte) (*AuthWebhookEvent, error)
+}
@@ -3,6 +3,7 @@ module github.com/moasq/backend/app/organizations
 go 1.25
 
 require (
+	github.com/google/uuid v1.6.0
 	github.com/moasq/backend/pkg/db v0.0.0-00010101000000-000000000000
 	github.com/stytchauth/stytch-go/v16 v16.40.0
 	go.uber.org/dig v1.19.0
@@ -11,7 +12,6 @@ require (
 require (
 	github.com/fsnotify/fsnotify v1.7.0 // indirect
 	github.com/golang-migrate/migrate/v4 v4.17.1 // indirect
-	github.com/google/uuid v1.6.0 // indirect
 	github.com/hashicorp/hcl v1.0.0 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
@@ -46,3 +46,7 @@ replace github.com/moasq/backend/pkg/db => ../../pkg/db
 replace github.com/moasq/backend/pkg/eventbus => ../../pkg/eventbus
 
 replace github.com/moasq/backend/pkg/logger => ../../pkg/logger
+
+replace github.com/moasq/backend/pkg/metrics => ../../pkg/metrics
+
+replace github.com/moasq/backend/pkg/lifecycle => ../../pkg/lifecycle
@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/moasq/backend/app/organizations/domain"
+)
+
+// dnsLookupTimeout bounds a single verification lookup.
+const dnsLookupTimeout = 5 * time.Second
+
+// TXTResolver looks up DNS TXT records. *net.Resolver implements it.
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+type dnsDomainVerifier struct {
+	resolver TXTResolver
+}
+
+// NewDNSDomainVerifier verifies domains by looking up the TXT record at
+// _saas-verification.<domain>. A nil resolver uses net.DefaultResolver.
+func NewDNSDomainVerifier(resolver TXTResolver) domain.DomainVerifier {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &dnsDomainVerifier{resolver: resolver}
+}
+
+func (v *dnsDomainVerifier) Verify(ctx context.Context, name, token string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, dnsLookupTimeout)
+	defer cancel()
+
+	record := domain.NewDomainVerificationRecord(name, token)
+	values, err := v.resolver.LookupTXT(ctx, record.Name)
+	if err != nil {
+		// A missing record is a failed verification, not an error
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to look up %s: %w", record.Name, err)
+	}
+
+	for _, value := range values {
+		if strings.TrimSpace(value) == record.Value {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/db/postgres"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+type organizationDomainRepository struct {
+	orgStore adapters.OrganizationStore
+}
+
+func NewOrganizationDomainRepository(orgStore adapters.OrganizationStore) domain.OrganizationDomainRepository {
+	return &organizationDomainRepository{
+		orgStore: orgStore,
+	}
+}
+
+func (r *organizationDomainRepository) Create(ctx context.Context, orgID int32, name, verificationToken string) (*domain.OrganizationDomain, error) {
+	result, err := r.orgStore.CreateOrganizationDomain(ctx, sqlc.CreateOrganizationDomainParams{
+		OrganizationID:    orgID,
+		Domain:            name,
+		VerificationToken: verificationToken,
+		JitEnabled:        false,
+		DefaultRole:       domain.DefaultJITRole,
+	})
+	if err != nil {
+		if sqlc.ErrorCode(err) == sqlc.UniqueViolation {
+			return nil, domain.ErrDomainAlreadyClaimed
+		}
+		return nil, fmt.Errorf("failed to create organization domain: %w", err)
+	}
+
+	return r.mapToDomain(&result), nil
+}
+
+func (r *organizationDomainRepository) Get(ctx context.Context, orgID, domainID int32) (*domain.OrganizationDomain, error) {
+	result, err := r.orgStore.GetOrganizationDomain(ctx, sqlc.GetOrganizationDomainParams{
+		ID:             domainID,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		return nil, r.mapError("get", err)
+	}
+
+	return r.mapToDomain(&result), nil
+}
+
+func (r *organizationDomainRepository) GetVerified(ctx context.Context, name string) (*domain.OrganizationDomain, error) {
+	result, err := r.orgStore.GetVerifiedOrganizationDomain(ctx, name)
+	if err != nil {
+		return nil, r.mapError("get verified", err)
+	}
+
+	return r.mapToDomain(&result), nil
+}
+
+func (r *organizationDomainRepository) List(ctx context.Context, orgID int32) ([]*domain.OrganizationDomain, error) {
+	results, err := r.orgStore.ListOrganizationDomains(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization domains: %w", err)
+	}
+
+	domains := make([]*domain.OrganizationDomain, len(results))
+	for i := range results {
+		domains[i] = r.mapToDomain(&results[i])
+	}
+	return domains, nil
+}
+
+func (r *organizationDomainRepository) MarkVerified(ctx context.Context, orgID, domainID int32) (*domain.OrganizationDomain, error) {
+	result, err := r.orgStore.MarkOrganizationDomainVerified(ctx, sqlc.MarkOrganizationDomainVerifiedParams{
+		ID:             domainID,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		// Another organization verified the same domain first
+		if sqlc.ErrorCode(err) == sqlc.UniqueViolation {
+			return nil, domain.ErrDomainOwnedElsewhere
+		}
+		return nil, r.mapError("verify", err)
+	}
+
+	return r.mapToDomain(&result), nil
+}
+
+func (r *organizationDomainRepository) UpdateJIT(ctx context.Context, orgID, domainID int32, enabled bool, defaultRole string) (*domain.OrganizationDomain, error) {
+	result, err := r.orgStore.UpdateOrganizationDomainJIT(ctx, sqlc.UpdateOrganizationDomainJITParams{
+		ID:             domainID,
+		OrganizationID: orgID,
+		JitEnabled:     enabled,
+		DefaultRole:    defaultRole,
+	})
+	if err != nil {
+		return nil, r.mapError("update", err)
+	}
+
+	return r.mapToDomain(&result), nil
+}
+
+func (r *organizationDomainRepository) Delete(ctx context.Context, orgID, domainID int32) error {
+	if err := r.orgStore.DeleteOrganizationDomain(ctx, sqlc.DeleteOrganizationDomainParams{
+		ID:             domainID,
+		OrganizationID: orgID,
+	}); err != nil {
+		return fmt.Errorf("failed to delete organization domain: %w", err)
+	}
+	return nil
+}
+
+func (r *organizationDomainRepository) mapError(op string, err error) error {
+	if errors.Is(err, sqlc.ErrRecordNotFound) {
+		return domain.ErrDomainNotFound
+	}
+	return fmt.Errorf("failed to %s organization domain: %w", op, err)
+}
+
+func (r *organizationDomainRepository) mapToDomain(row *sqlc.OrganizationsOrganizationDomain) *domain.OrganizationDomain {
+	verifiedAt := postgres.TimeStampPtr(row.VerifiedAt)
+	return &domain.OrganizationDomain{
+		ID:                 row.ID,
+		OrganizationID:     row.OrganizationID,
+		Domain:             row.Domain,
+		Verified:           verifiedAt != nil,
+		VerifiedAt:         verifiedAt,
+		JITEnabled:         row.JitEnabled,
+		DefaultRole:        row.DefaultRole,
+		VerificationRecord: domain.NewDomainVerificationRecord(row.Domain, row.VerificationToken),
+		CreatedAt:          row.CreatedAt.Time,
+		UpdatedAt:          row.UpdatedAt.Time,
+		VerificationToken:  row.VerificationToken,
+	}
+}
@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/db/postgres"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+type organizationSettingsRepository struct {
+	orgStore adapters.OrganizationStore
+}
+
+func NewOrganizationSettingsRepository(orgStore adapters.OrganizationStore) domain.OrganizationSettingsRepository {
+	return &organizationSettingsRepository{
+		orgStore: orgStore,
+	}
+}
+
+func (r *organizationSettingsRepository) Get(ctx context.Context, orgID int32) (*domain.OrganizationSettings, error) {
+	result, err := r.orgStore.GetOrganizationSettings(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return &domain.OrganizationSettings{
+				OrganizationID: orgID,
+				Values:         map[string]any{},
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to get organization settings: %w", err)
+	}
+
+	return r.mapToDomainSettings(&result)
+}
+
+func (r *organizationSettingsRepository) Save(ctx context.Context, orgID int32, values map[string]any) (*domain.OrganizationSettings, error) {
+	if values == nil {
+		values = map[string]any{}
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode organization settings: %w", err)
+	}
+
+	result, err := r.orgStore.UpsertOrganizationSettings(ctx, sqlc.UpsertOrganizationSettingsParams{
+		OrganizationID: orgID,
+		Settings:       data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save organization settings: %w", err)
+	}
+
+	return r.mapToDomainSettings(&result)
+}
+
+func (r *organizationSettingsRepository) mapToDomainSettings(row *sqlc.OrganizationsOrganizationSetting) (*domain.OrganizationSettings, error) {
+	values := map[string]any{}
+	if len(row.Settings) > 0 {
+		if err := json.Unmarshal(row.Settings, &values); err != nil {
+			return nil, fmt.Errorf("failed to decode organization settings: %w", err)
+		}
+	}
+
+	return &domain.OrganizationSettings{
+		OrganizationID: row.OrganizationID,
+		Values:         values,
+		UpdatedAt:      postgres.TimeStampPtr(row.UpdatedAt),
+	}, nil
+}
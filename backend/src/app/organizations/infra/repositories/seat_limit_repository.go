@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/db/adapters"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+type seatLimitRepository struct {
+	subscriptionStore adapters.SubscriptionStore
+}
+
+// NewSeatLimitRepository reads seat limits from the billing quota tracked for
+// each organization's subscription.
+func NewSeatLimitRepository(subscriptionStore adapters.SubscriptionStore) domain.SeatLimitRepository {
+	return &seatLimitRepository{
+		subscriptionStore: subscriptionStore,
+	}
+}
+
+func (r *seatLimitRepository) GetMaxSeats(ctx context.Context, orgID int32) (int32, error) {
+	quota, err := r.subscriptionStore.GetQuotaByOrgID(ctx, orgID)
+	if err != nil {
+		// No quota row means no subscription limit
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get seat limit: %w", err)
+	}
+	if !quota.MaxSeats.Valid {
+		return 0, nil
+	}
+	return quota.MaxSeats.Int32, nil
+}
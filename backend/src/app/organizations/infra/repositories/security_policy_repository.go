@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/db/postgres"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+type securityPolicyRepository struct {
+	orgStore adapters.OrganizationStore
+}
+
+func NewSecurityPolicyRepository(orgStore adapters.OrganizationStore) domain.SecurityPolicyRepository {
+	return &securityPolicyRepository{
+		orgStore: orgStore,
+	}
+}
+
+func (r *securityPolicyRepository) Get(ctx context.Context, orgID int32) (*domain.SecurityPolicy, error) {
+	result, err := r.orgStore.GetOrganizationSecurityPolicy(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return &domain.SecurityPolicy{
+				OrganizationID:     orgID,
+				AllowedAuthMethods: []string{},
+				IPAllowlist:        []string{},
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to get security policy: %w", err)
+	}
+
+	return r.mapToDomainPolicy(&result), nil
+}
+
+func (r *securityPolicyRepository) Save(ctx context.Context, orgID int32, req *domain.UpdateSecurityPolicyRequest) (*domain.SecurityPolicy, error) {
+	params := sqlc.UpsertOrganizationSecurityPolicyParams{
+		OrganizationID:     orgID,
+		RequireMfa:         req.RequireMFA,
+		AllowedAuthMethods: req.AllowedAuthMethods,
+		IpAllowlist:        req.IPAllowlist,
+	}
+	if params.AllowedAuthMethods == nil {
+		params.AllowedAuthMethods = []string{}
+	}
+	if params.IpAllowlist == nil {
+		params.IpAllowlist = []string{}
+	}
+
+	result, err := r.orgStore.UpsertOrganizationSecurityPolicy(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save security policy: %w", err)
+	}
+
+	return r.mapToDomainPolicy(&result), nil
+}
+
+func (r *securityPolicyRepository) mapToDomainPolicy(row *sqlc.OrganizationsOrganizationSecurityPolicy) *domain.SecurityPolicy {
+	policy := &domain.SecurityPolicy{
+		OrganizationID:     row.OrganizationID,
+		RequireMFA:         row.RequireMfa,
+		AllowedAuthMethods: row.AllowedAuthMethods,
+		IPAllowlist:        row.IpAllowlist,
+		UpdatedAt:          postgres.TimeStampPtr(row.UpdatedAt),
+	}
+	if policy.AllowedAuthMethods == nil {
+		policy.AllowedAuthMethods = []string{}
+	}
+	if policy.IPAllowlist == nil {
+		policy.IPAllowlist = []string{}
+	}
+	return policy
+}
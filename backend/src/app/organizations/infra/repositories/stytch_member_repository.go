@@ -170,6 +170,36 @@ func (r *stytchMemberRepository) ListMembers(ctx context.Context, organizationID
 	return results, nil
 }
 
+func (r *stytchMemberRepository) ListMembersPage(ctx context.Context, organizationID, cursor string, limit int) (*domain.AuthMemberPage, error) {
+	if organizationID == "" {
+		return nil, domain.ErrAuthOrganizationIDRequired
+	}
+
+	params := &members.SearchParams{
+		OrganizationIds: []string{organizationID},
+		Cursor:          cursor,
+	}
+	if limit > 0 {
+		params.Limit = uint32(limit)
+	}
+
+	resp, err := r.client.API().Organizations.Members.Search(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("stytch search members: %w", stytchcfg.MapError(err))
+	}
+
+	page := &domain.AuthMemberPage{
+		Members:    make([]*domain.AuthMember, 0, len(resp.Members)),
+		NextCursor: resp.ResultsMetadata.NextCursor,
+		Total:      int(resp.ResultsMetadata.Total),
+	}
+	for _, m := range resp.Members {
+		page.Members = append(page.Members, mapToAuthMember(m))
+	}
+
+	return page, nil
+}
+
 func (r *stytchMemberRepository) RemoveMembers(ctx context.Context, req *domain.RemoveAuthMembersRequest) error {
 	if err := req.Validate(); err != nil {
 		return fmt.Errorf("invalid remove members request: %w", err)
@@ -192,6 +222,10 @@ func (r *stytchMemberRepository) AssignRoles(ctx context.Context, req *domain.As
 	if err := req.Validate(); err != nil {
 		return fmt.Errorf("invalid assign roles request: %w", err)
 	}
+	// Also called for JIT-provisioned members, where the client may be unset (development mode)
+	if r.client == nil || r.client.API() == nil {
+		return fmt.Errorf("stytch client not configured: %w", stytchcfg.ErrInvalidConfig)
+	}
 
 	updateParams := &members.UpdateParams{
 		OrganizationID: req.OrganizationID,
@@ -130,6 +130,43 @@ func (r *stytchOrganizationRepository) DeleteOrganization(ctx context.Context, o
 	return nil
 }
 
+// Stytch email JIT provisioning modes.
+const (
+	stytchEmailJITRestricted = "RESTRICTED"
+	stytchEmailJITNotAllowed = "NOT_ALLOWED"
+)
+
+func (r *stytchOrganizationRepository) SetEmailJITDomains(ctx context.Context, organizationID string, domains []string) error {
+	if r.client == nil || r.client.API() == nil {
+		return fmt.Errorf("stytch client not configured: %w", stytchcfg.ErrInvalidConfig)
+	}
+	if organizationID == "" {
+		return domain.ErrAuthOrganizationIDRequired
+	}
+
+	// email_allowed_domains cannot be cleared (empty lists are omitted), so
+	// turning JIT off leaves the list in place with provisioning NOT_ALLOWED.
+	params := &organizations.UpdateParams{
+		OrganizationID:       organizationID,
+		EmailJITProvisioning: stytchEmailJITNotAllowed,
+	}
+	if len(domains) > 0 {
+		params.EmailJITProvisioning = stytchEmailJITRestricted
+		params.EmailAllowedDomains = domains
+	}
+
+	if _, err := r.client.API().Organizations.Update(ctx, params); err != nil {
+		return fmt.Errorf("stytch update organization email jit domains: %w", stytchcfg.MapError(err))
+	}
+
+	r.logger.Info("organization email jit domains updated", loggerDomain.Fields{
+		"auth_org_id": organizationID,
+		"domains":     domains,
+	})
+
+	return nil
+}
+
 func (r *stytchOrganizationRepository) CheckEmailExists(ctx context.Context, email string) (bool, error) {
 	if email == "" {
 		return false, fmt.Errorf("email cannot be empty")
@@ -0,0 +1,319 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/moasq/backend/app/organizations/domain"
+	loggerDomain "github.com/moasq/backend/pkg/logger"
+	stytchcfg "github.com/moasq/backend/pkg/stytch"
+	"github.com/stytchauth/stytch-go/v16/stytch/b2b/organizations"
+	"github.com/stytchauth/stytch-go/v16/stytch/b2b/sso"
+	"github.com/stytchauth/stytch-go/v16/stytch/b2b/sso/oidc"
+	"github.com/stytchauth/stytch-go/v16/stytch/b2b/sso/saml"
+)
+
+// Stytch organization auth method settings used for "SSO required".
+const (
+	stytchAuthMethodsAllAllowed = "ALL_ALLOWED"
+	stytchAuthMethodsRestricted = "RESTRICTED"
+	stytchAuthMethodSSO         = "sso"
+)
+
+type stytchSSORepository struct {
+	client *stytchcfg.Client
+	logger loggerDomain.Logger
+}
+
+// NewStytchSSORepository creates a Stytch-backed SSO connection repository.
+func NewStytchSSORepository(client *stytchcfg.Client, logger loggerDomain.Logger) domain.AuthSSORepository {
+	return &stytchSSORepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *stytchSSORepository) GetSSOSettings(ctx context.Context, organizationID string) (*domain.SSOSettings, error) {
+	if err := r.ensureClient(); err != nil {
+		return nil, err
+	}
+	if organizationID == "" {
+		return nil, domain.ErrAuthOrganizationIDRequired
+	}
+
+	orgResp, err := r.client.API().Organizations.Get(ctx, &organizations.GetParams{
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("stytch get organization: %w", stytchcfg.MapError(err))
+	}
+
+	connResp, err := r.client.API().SSO.GetConnections(ctx, &sso.GetConnectionsParams{
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("stytch get sso connections: %w", stytchcfg.MapError(err))
+	}
+
+	org := orgResp.Organization
+	settings := &domain.SSOSettings{
+		Connections:         make([]*domain.SSOConnection, 0, len(connResp.SAMLConnections)+len(connResp.OIDCConnections)),
+		DefaultConnectionID: org.SSODefaultConnectionID,
+		SSORequired:         isSSORequired(org.AuthMethods, org.AllowedAuthMethods),
+	}
+	for i := range connResp.SAMLConnections {
+		settings.Connections = append(settings.Connections, mapSAMLConnection(&connResp.SAMLConnections[i]))
+	}
+	for i := range connResp.OIDCConnections {
+		settings.Connections = append(settings.Connections, mapOIDCConnection(&connResp.OIDCConnections[i]))
+	}
+	for _, conn := range settings.Connections {
+		conn.IsDefault = conn.ConnectionID == settings.DefaultConnectionID
+	}
+
+	return settings, nil
+}
+
+func (r *stytchSSORepository) CreateConnection(ctx context.Context, organizationID string, req *domain.CreateSSOConnectionRequest) (*domain.SSOConnection, error) {
+	if err := r.ensureClient(); err != nil {
+		return nil, err
+	}
+	if organizationID == "" {
+		return nil, domain.ErrAuthOrganizationIDRequired
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch req.Type {
+	case domain.SSOConnectionTypeSAML:
+		params := &saml.CreateConnectionParams{
+			OrganizationID: organizationID,
+			DisplayName:    req.DisplayName,
+		}
+		if req.IdentityProvider != "" {
+			idp := saml.CreateConnectionRequestIdentityProvider(req.IdentityProvider)
+			params.IdentityProvider = &idp
+		}
+		resp, err := r.client.API().SSO.SAML.CreateConnection(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("stytch create saml connection: %w", stytchcfg.MapError(err))
+		}
+		return mapSAMLConnection(resp.Connection), nil
+	default:
+		params := &oidc.CreateConnectionParams{
+			OrganizationID: organizationID,
+			DisplayName:    req.DisplayName,
+		}
+		if req.IdentityProvider != "" {
+			idp := oidc.CreateConnectionRequestIdentityProvider(req.IdentityProvider)
+			params.IdentityProvider = &idp
+		}
+		resp, err := r.client.API().SSO.OIDC.CreateConnection(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("stytch create oidc connection: %w", stytchcfg.MapError(err))
+		}
+		return mapOIDCConnection(resp.Connection), nil
+	}
+}
+
+func (r *stytchSSORepository) UpdateSAMLConnection(ctx context.Context, organizationID string, req *domain.UpdateSAMLConnectionRequest) (*domain.SSOConnection, error) {
+	if err := r.ensureClient(); err != nil {
+		return nil, err
+	}
+	if organizationID == "" {
+		return nil, domain.ErrAuthOrganizationIDRequired
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var conn *sso.SAMLConnection
+	if req.MetadataURL != "" {
+		resp, err := r.client.API().SSO.SAML.UpdateByURL(ctx, &saml.UpdateByURLParams{
+			OrganizationID: organizationID,
+			ConnectionID:   req.ConnectionID,
+			MetadataURL:    req.MetadataURL,
+		})
+		if err != nil {
+			return nil, mapSSOError("stytch update saml connection by url", err)
+		}
+		conn = resp.Connection
+	}
+
+	// Metadata documents carry no attribute mapping, so it is always set separately.
+	if req.MetadataURL == "" || req.AttributeMapping != nil {
+		resp, err := r.client.API().SSO.SAML.UpdateConnection(ctx, &saml.UpdateConnectionParams{
+			OrganizationID:   organizationID,
+			ConnectionID:     req.ConnectionID,
+			IDPEntityID:      req.IdPEntityID,
+			IDPSSOURL:        req.IdPSSOURL,
+			X509Certificate:  req.X509Certificate,
+			AttributeMapping: req.AttributeMapping,
+		})
+		if err != nil {
+			return nil, mapSSOError("stytch update saml connection", err)
+		}
+		conn = resp.Connection
+	}
+
+	return mapSAMLConnection(conn), nil
+}
+
+func (r *stytchSSORepository) UpdateOIDCConnection(ctx context.Context, organizationID string, req *domain.UpdateOIDCConnectionRequest) (*domain.SSOConnection, error) {
+	if err := r.ensureClient(); err != nil {
+		return nil, err
+	}
+	if organizationID == "" {
+		return nil, domain.ErrAuthOrganizationIDRequired
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.API().SSO.OIDC.UpdateConnection(ctx, &oidc.UpdateConnectionParams{
+		OrganizationID:   organizationID,
+		ConnectionID:     req.ConnectionID,
+		Issuer:           req.Issuer,
+		ClientID:         req.ClientID,
+		ClientSecret:     req.ClientSecret,
+		AuthorizationURL: req.AuthorizationURL,
+		TokenURL:         req.TokenURL,
+		UserinfoURL:      req.UserinfoURL,
+		JWKSURL:          req.JWKSURL,
+	})
+	if err != nil {
+		return nil, mapSSOError("stytch update oidc connection", err)
+	}
+
+	return mapOIDCConnection(resp.Connection), nil
+}
+
+func (r *stytchSSORepository) DeleteConnection(ctx context.Context, organizationID, connectionID string) error {
+	if err := r.ensureClient(); err != nil {
+		return err
+	}
+	if organizationID == "" {
+		return domain.ErrAuthOrganizationIDRequired
+	}
+	if connectionID == "" {
+		return domain.ErrSSOConnectionIDRequired
+	}
+
+	if _, err := r.client.API().SSO.DeleteConnection(ctx, &sso.DeleteConnectionParams{
+		OrganizationID: organizationID,
+		ConnectionID:   connectionID,
+	}); err != nil {
+		return mapSSOError("stytch delete sso connection", err)
+	}
+
+	return nil
+}
+
+func (r *stytchSSORepository) SetDefaultConnection(ctx context.Context, organizationID, connectionID string) error {
+	if err := r.ensureClient(); err != nil {
+		return err
+	}
+	if organizationID == "" {
+		return domain.ErrAuthOrganizationIDRequired
+	}
+	if connectionID == "" {
+		return domain.ErrSSOConnectionIDRequired
+	}
+
+	if _, err := r.client.API().Organizations.Update(ctx, &organizations.UpdateParams{
+		OrganizationID:         organizationID,
+		SSODefaultConnectionID: connectionID,
+	}); err != nil {
+		return fmt.Errorf("stytch set default sso connection: %w", stytchcfg.MapError(err))
+	}
+
+	return nil
+}
+
+func (r *stytchSSORepository) SetSSORequired(ctx context.Context, organizationID string, required bool) error {
+	if err := r.ensureClient(); err != nil {
+		return err
+	}
+	if organizationID == "" {
+		return domain.ErrAuthOrganizationIDRequired
+	}
+
+	params := &organizations.UpdateParams{
+		OrganizationID: organizationID,
+		AuthMethods:    stytchAuthMethodsAllAllowed,
+	}
+	if required {
+		params.AuthMethods = stytchAuthMethodsRestricted
+		params.AllowedAuthMethods = []string{stytchAuthMethodSSO}
+	}
+
+	if _, err := r.client.API().Organizations.Update(ctx, params); err != nil {
+		return fmt.Errorf("stytch update organization auth methods: %w", stytchcfg.MapError(err))
+	}
+
+	r.logger.Info("organization sso requirement updated", loggerDomain.Fields{
+		"auth_org_id":  organizationID,
+		"sso_required": required,
+	})
+
+	return nil
+}
+
+func (r *stytchSSORepository) ensureClient() error {
+	if r.client == nil || r.client.API() == nil {
+		return fmt.Errorf("stytch client not configured: %w", stytchcfg.ErrInvalidConfig)
+	}
+	return nil
+}
+
+// mapSSOError maps Stytch "not found" responses for a connection to ErrSSOConnectionNotFound.
+func mapSSOError(op string, err error) error {
+	mapped := stytchcfg.MapError(err)
+	if errors.Is(mapped, stytchcfg.ErrNotFound) {
+		return fmt.Errorf("%s: %w", op, domain.ErrSSOConnectionNotFound)
+	}
+	return fmt.Errorf("%s: %w", op, mapped)
+}
+
+func isSSORequired(authMethods string, allowed []string) bool {
+	return authMethods == stytchAuthMethodsRestricted && len(allowed) == 1 && allowed[0] == stytchAuthMethodSSO
+}
+
+func mapSAMLConnection(src *sso.SAMLConnection) *domain.SSOConnection {
+	if src == nil {
+		return nil
+	}
+	return &domain.SSOConnection{
+		ConnectionID:     src.ConnectionID,
+		Type:             domain.SSOConnectionTypeSAML,
+		DisplayName:      src.DisplayName,
+		Status:           src.Status,
+		IdentityProvider: src.IdentityProvider,
+		ACSURL:           src.AcsURL,
+		AudienceURI:      src.AudienceURI,
+		IdPEntityID:      src.IDPEntityID,
+		IdPSSOURL:        src.IDPSSOURL,
+	}
+}
+
+func mapOIDCConnection(src *sso.OIDCConnection) *domain.SSOConnection {
+	if src == nil {
+		return nil
+	}
+	return &domain.SSOConnection{
+		ConnectionID:     src.ConnectionID,
+		Type:             domain.SSOConnectionTypeOIDC,
+		DisplayName:      src.DisplayName,
+		Status:           src.Status,
+		IdentityProvider: src.IdentityProvider,
+		RedirectURL:      src.RedirectURL,
+		Issuer:           src.Issuer,
+		ClientID:         src.ClientID,
+		AuthorizationURL: src.AuthorizationURL,
+		TokenURL:         src.TokenURL,
+		UserinfoURL:      src.UserinfoURL,
+		JWKSURL:          src.JWKSURL,
+	}
+}
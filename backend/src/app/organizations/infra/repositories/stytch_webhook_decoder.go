@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/stytchauth/stytch-go/v16/stytch/b2b/organizations"
+)
+
+// stytchWebhookEnvelope is the common shape of Stytch event webhooks. The changed
+// object is delivered under a key matching object_type.
+type stytchWebhookEnvelope struct {
+	EventID      string                      `json:"event_id"`
+	Action       string                      `json:"action"`
+	ObjectType   string                      `json:"object_type"`
+	Source       string                      `json:"source"`
+	ID           string                      `json:"id"`
+	Timestamp    *time.Time                  `json:"timestamp"`
+	Member       *organizations.Member       `json:"member"`
+	Organization *organizations.Organization `json:"organization"`
+}
+
+type stytchWebhookDecoder struct{}
+
+// NewStytchWebhookDecoder creates a decoder for Stytch B2B event webhooks.
+func NewStytchWebhookDecoder() domain.AuthWebhookDecoder {
+	return &stytchWebhookDecoder{}
+}
+
+func (d *stytchWebhookDecoder) Decode(ctx context.Context, payload []byte) (*domain.AuthWebhookEvent, error) {
+	var envelope stytchWebhookEnvelope
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrAuthWebhookPayloadInvalid, err)
+	}
+
+	if envelope.EventID == "" {
+		return nil, fmt.Errorf("%w: missing event_id", domain.ErrAuthWebhookPayloadInvalid)
+	}
+
+	event := &domain.AuthWebhookEvent{
+		EventID:    envelope.EventID,
+		Action:     domain.AuthWebhookAction(strings.ToLower(envelope.Action)),
+		ObjectType: domain.AuthWebhookObjectType(strings.ToLower(envelope.ObjectType)),
+		Source:     envelope.Source,
+	}
+	if envelope.Timestamp != nil {
+		event.Timestamp = envelope.Timestamp.UTC()
+	}
+
+	switch event.ObjectType {
+	case domain.AuthWebhookObjectMember:
+		if envelope.Member == nil {
+			return nil, fmt.Errorf("%w: missing member object", domain.ErrAuthWebhookPayloadInvalid)
+		}
+		event.Member = mapToAuthMember(*envelope.Member)
+		if event.Member.MemberID == "" {
+			event.Member.MemberID = envelope.ID
+		}
+	case domain.AuthWebhookObjectOrganization:
+		if envelope.Organization == nil {
+			return nil, fmt.Errorf("%w: missing organization object", domain.ErrAuthWebhookPayloadInvalid)
+		}
+		event.Organization = mapToAuthOrganization(*envelope.Organization)
+		if event.Organization.OrganizationID == "" {
+			event.Organization.OrganizationID = envelope.ID
+		}
+	}
+
+	return event, nil
+}
@@ -0,0 +1,199 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/db/postgres"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+type teamRepository struct {
+	orgStore adapters.OrganizationStore
+}
+
+func NewTeamRepository(orgStore adapters.OrganizationStore) domain.TeamRepository {
+	return &teamRepository{
+		orgStore: orgStore,
+	}
+}
+
+func (r *teamRepository) Create(ctx context.Context, orgID int32, req *domain.CreateTeamRequest) (*domain.Team, error) {
+	result, err := r.orgStore.CreateTeam(ctx, sqlc.CreateTeamParams{
+		OrganizationID: orgID,
+		Name:           req.Name,
+		Description:    postgres.PgText(req.Description),
+	})
+	if err != nil {
+		if sqlc.ErrorCode(err) == sqlc.UniqueViolation {
+			return nil, domain.ErrTeamNameTaken
+		}
+		return nil, fmt.Errorf("failed to create team: %w", err)
+	}
+
+	return r.mapToDomain(&result), nil
+}
+
+func (r *teamRepository) Get(ctx context.Context, orgID, teamID int32) (*domain.Team, error) {
+	result, err := r.orgStore.GetTeam(ctx, sqlc.GetTeamParams{
+		ID:             teamID,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return nil, domain.ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	return r.mapToDomain(&result), nil
+}
+
+func (r *teamRepository) List(ctx context.Context, orgID int32) ([]*domain.Team, error) {
+	results, err := r.orgStore.ListTeams(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	return r.mapAllToDomain(results), nil
+}
+
+func (r *teamRepository) ListByAccount(ctx context.Context, orgID, accountID int32) ([]*domain.Team, error) {
+	results, err := r.orgStore.ListTeamsByAccount(ctx, sqlc.ListTeamsByAccountParams{
+		OrganizationID: orgID,
+		AccountID:      accountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account teams: %w", err)
+	}
+
+	return r.mapAllToDomain(results), nil
+}
+
+func (r *teamRepository) Update(ctx context.Context, orgID, teamID int32, req *domain.UpdateTeamRequest) (*domain.Team, error) {
+	result, err := r.orgStore.UpdateTeam(ctx, sqlc.UpdateTeamParams{
+		ID:             teamID,
+		OrganizationID: orgID,
+		Name:           req.Name,
+		Description:    postgres.PgText(req.Description),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sqlc.ErrRecordNotFound):
+			return nil, domain.ErrTeamNotFound
+		case sqlc.ErrorCode(err) == sqlc.UniqueViolation:
+			return nil, domain.ErrTeamNameTaken
+		}
+		return nil, fmt.Errorf("failed to update team: %w", err)
+	}
+
+	return r.mapToDomain(&result), nil
+}
+
+func (r *teamRepository) Delete(ctx context.Context, orgID, teamID int32) error {
+	if err := r.orgStore.DeleteTeam(ctx, sqlc.DeleteTeamParams{
+		ID:             teamID,
+		OrganizationID: orgID,
+	}); err != nil {
+		// Documents and resources reference the team with ON DELETE RESTRICT
+		if sqlc.ErrorCode(err) == sqlc.ForeignKeyViolation {
+			return domain.ErrTeamInUse
+		}
+		return fmt.Errorf("failed to delete team: %w", err)
+	}
+	return nil
+}
+
+func (r *teamRepository) SetMember(ctx context.Context, teamID, accountID int32, role string) (*domain.TeamMember, error) {
+	result, err := r.orgStore.UpsertTeamMember(ctx, sqlc.UpsertTeamMemberParams{
+		TeamID:    teamID,
+		AccountID: accountID,
+		Role:      role,
+	})
+	if err != nil {
+		if sqlc.ErrorCode(err) == sqlc.ForeignKeyViolation {
+			return nil, domain.ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to set team member: %w", err)
+	}
+
+	return r.mapMemberToDomain(&result), nil
+}
+
+func (r *teamRepository) GetMember(ctx context.Context, teamID, accountID int32) (*domain.TeamMember, error) {
+	result, err := r.orgStore.GetTeamMember(ctx, sqlc.GetTeamMemberParams{
+		TeamID:    teamID,
+		AccountID: accountID,
+	})
+	if err != nil {
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return nil, domain.ErrTeamMemberNotFound
+		}
+		return nil, fmt.Errorf("failed to get team member: %w", err)
+	}
+
+	return r.mapMemberToDomain(&result), nil
+}
+
+func (r *teamRepository) ListMembers(ctx context.Context, teamID int32) ([]*domain.TeamMember, error) {
+	results, err := r.orgStore.ListTeamMembers(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team members: %w", err)
+	}
+
+	members := make([]*domain.TeamMember, len(results))
+	for i, row := range results {
+		members[i] = &domain.TeamMember{
+			TeamID:    row.TeamID,
+			AccountID: row.AccountID,
+			Email:     row.Email,
+			FullName:  row.FullName,
+			Role:      row.Role,
+			CreatedAt: row.CreatedAt.Time,
+			UpdatedAt: row.UpdatedAt.Time,
+		}
+	}
+	return members, nil
+}
+
+func (r *teamRepository) RemoveMember(ctx context.Context, teamID, accountID int32) error {
+	if err := r.orgStore.DeleteTeamMember(ctx, sqlc.DeleteTeamMemberParams{
+		TeamID:    teamID,
+		AccountID: accountID,
+	}); err != nil {
+		return fmt.Errorf("failed to remove team member: %w", err)
+	}
+	return nil
+}
+
+func (r *teamRepository) mapAllToDomain(rows []sqlc.OrganizationsTeam) []*domain.Team {
+	teams := make([]*domain.Team, len(rows))
+	for i := range rows {
+		teams[i] = r.mapToDomain(&rows[i])
+	}
+	return teams
+}
+
+func (r *teamRepository) mapToDomain(row *sqlc.OrganizationsTeam) *domain.Team {
+	return &domain.Team{
+		ID:             row.ID,
+		OrganizationID: row.OrganizationID,
+		Name:           row.Name,
+		Description:    postgres.StringPtr(row.Description),
+		CreatedAt:      row.CreatedAt.Time,
+		UpdatedAt:      row.UpdatedAt.Time,
+	}
+}
+
+func (r *teamRepository) mapMemberToDomain(row *sqlc.OrganizationsTeamMember) *domain.TeamMember {
+	return &domain.TeamMember{
+		TeamID:    row.TeamID,
+		AccountID: row.AccountID,
+		Role:      row.Role,
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}
+}
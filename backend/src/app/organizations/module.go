@@ -1,13 +1,21 @@
 package organizations
 
 import (
+	"context"
+
 	"go.uber.org/dig"
 
+	audit "github.com/moasq/backend/app/audit/domain"
 	"github.com/moasq/backend/app/organizations/app/services"
 	"github.com/moasq/backend/app/organizations/domain"
+	"github.com/moasq/backend/app/organizations/domain/events"
 	"github.com/moasq/backend/app/organizations/infra/repositories"
+	"github.com/moasq/backend/pkg/auth"
 	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/eventbus"
+	"github.com/moasq/backend/pkg/lifecycle"
 	loggerDomain "github.com/moasq/backend/pkg/logger/domain"
+	"github.com/moasq/backend/pkg/redis"
 	stytchcfg "github.com/moasq/backend/pkg/stytch"
 )
 
@@ -42,6 +50,61 @@ func (m *Module) RegisterDependencies() error {
 		return err
 	}
 
+	if err := m.container.Provide(func(
+		orgStore adapters.OrganizationStore,
+	) domain.OrganizationSettingsRepository {
+		return repositories.NewOrganizationSettingsRepository(orgStore)
+	}); err != nil {
+		return err
+	}
+
+	if err := m.container.Provide(func(
+		orgStore adapters.OrganizationStore,
+	) domain.SecurityPolicyRepository {
+		return repositories.NewSecurityPolicyRepository(orgStore)
+	}); err != nil {
+		return err
+	}
+
+	if err := m.container.Provide(func(
+		orgStore adapters.OrganizationStore,
+	) domain.OrganizationDomainRepository {
+		return repositories.NewOrganizationDomainRepository(orgStore)
+	}); err != nil {
+		return err
+	}
+
+	if err := m.container.Provide(func(
+		orgStore adapters.OrganizationStore,
+	) domain.TeamRepository {
+		return repositories.NewTeamRepository(orgStore)
+	}); err != nil {
+		return err
+	}
+
+	// Seat limits come from the billing quota of the organization's subscription
+	if err := m.container.Provide(func(
+		subscriptionStore adapters.SubscriptionStore,
+	) domain.SeatLimitRepository {
+		return repositories.NewSeatLimitRepository(subscriptionStore)
+	}); err != nil {
+		return err
+	}
+
+	// Domain ownership is proven with a DNS TXT record
+	if err := m.container.Provide(func() domain.DomainVerifier {
+		return repositories.NewDNSDomainVerifier(nil)
+	}); err != nil {
+		return err
+	}
+
+	// Organization settings as seen by authorization policies
+	if err := m.container.Provide(func(repo domain.OrganizationSettingsRepository) auth.OrgSettingsProvider {
+		return &policySettingsProvider{repo: repo}
+	}); err != nil {
+		return err
+	}
+
 	// Register auth provider repositories (Stytch implementation)
 	if err := m.container.Provide(func(
 		client *stytchcfg.Client,
@@ -72,12 +135,68 @@ func (m *Module) RegisterDependencies() error {
 		return err
 	}
 
+	if err := m.container.Provide(func(
+		client *stytchcfg.Client,
+		logger loggerDomain.Logger,
+	) domain.AuthSessionRepository {
+		return repositories.NewStytchSessionRepository(client, logger)
+	}); err != nil {
+		return err
+	}
+
+	if err := m.container.Provide(func(
+		client *stytchcfg.Client,
+		logger loggerDomain.Logger,
+	) domain.AuthSSORepository {
+		return repositories.NewStytchSSORepository(client, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Token denylist shared with the auth middleware
+	if err := m.container.Provide(func(store auth.RevocationStore) domain.SessionRevocationStore {
+		return store
+	}); err != nil {
+		return err
+	}
+
 	// Register organization service
 	if err := m.container.Provide(func(
 		orgRepo domain.OrganizationRepository,
 		accountRepo domain.AccountRepository,
+		eventBus eventbus.EventBus,
+		logger loggerDomain.Logger,
 	) services.OrganizationService {
-		return services.NewOrganizationService(orgRepo, accountRepo)
+		return services.NewOrganizationService(orgRepo, accountRepo, eventBus, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register settings service (per-organization settings)
+	if err := m.container.Provide(func(
+		settingsRepo domain.OrganizationSettingsRepository,
+		auditRecorder audit.Recorder,
+		logger loggerDomain.Logger,
+	) services.SettingsService {
+		return services.NewSettingsService(settingsRepo, auditRecorder, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register security policy service (MFA, allowed auth methods, IP allowlist)
+	if err := m.container.Provide(func(
+		repo domain.SecurityPolicyRepository,
+		auditRecorder audit.Recorder,
+		logger loggerDomain.Logger,
+	) services.SecurityPolicyService {
+		return services.NewSecurityPolicyService(repo, auditRecorder, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Security policies enforced by the auth middleware
+	if err := m.container.Provide(func(service services.SecurityPolicyService) auth.SecurityPolicyProvider {
+		return service
 	}); err != nil {
 		return err
 	}
@@ -89,6 +208,8 @@ func (m *Module) RegisterDependencies() error {
 		authRoleRepo domain.AuthRoleRepository,
 		localOrgRepo domain.OrganizationRepository,
 		localAccountRepo domain.AccountRepository,
+		revocations domain.SessionRevocationStore,
+		auditRecorder audit.Recorder,
 		logger loggerDomain.Logger,
 	) services.MemberService {
 		return services.NewMemberService(
@@ -97,6 +218,146 @@ func (m *Module) RegisterDependencies() error {
 			authRoleRepo,
 			localOrgRepo,
 			localAccountRepo,
+			revocations,
+			auditRecorder,
+			logger,
+		)
+	}); err != nil {
+		return err
+	}
+
+	// Register session service (list/revoke sessions, token denylist)
+	if err := m.container.Provide(func(
+		authSessionRepo domain.AuthSessionRepository,
+		revocations domain.SessionRevocationStore,
+		localOrgRepo domain.OrganizationRepository,
+		localAccountRepo domain.AccountRepository,
+		logger loggerDomain.Logger,
+	) services.SessionService {
+		return services.NewSessionService(
+			authSessionRepo,
+			revocations,
+			localOrgRepo,
+			localAccountRepo,
+			logger,
+		)
+	}); err != nil {
+		return err
+	}
+
+	// Register SSO service (SAML/OIDC connection management)
+	if err := m.container.Provide(func(
+		authSSORepo domain.AuthSSORepository,
+		localOrgRepo domain.OrganizationRepository,
+		auditRecorder audit.Recorder,
+		logger loggerDomain.Logger,
+	) services.SSOService {
+		return services.NewSSOService(authSSORepo, localOrgRepo, auditRecorder, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register domain service (email domain verification, JIT membership)
+	if err := m.container.Provide(func(
+		domainRepo domain.OrganizationDomainRepository,
+		verifier domain.DomainVerifier,
+		seatLimits domain.SeatLimitRepository,
+		authOrgRepo domain.AuthOrganizationRepository,
+		authMemberRepo domain.AuthMemberRepository,
+		localOrgRepo domain.OrganizationRepository,
+		localAccountRepo domain.AccountRepository,
+		auditRecorder audit.Recorder,
+		logger loggerDomain.Logger,
+	) services.DomainService {
+		return services.NewDomainService(
+			domainRepo,
+			verifier,
+			seatLimits,
+			authOrgRepo,
+			authMemberRepo,
+			localOrgRepo,
+			localAccountRepo,
+			auditRecorder,
+			logger,
+		)
+	}); err != nil {
+		return err
+	}
+
+	// Just-in-time membership for the auth middleware
+	if err := m.container.Provide(func(service services.DomainService) auth.AccountProvisioner {
+		return service
+	}); err != nil {
+		return err
+	}
+
+	// Register team service (teams and team-scoped visibility)
+	if err := m.container.Provide(func(
+		teamRepo domain.TeamRepository,
+		accountRepo domain.AccountRepository,
+		auditRecorder audit.Recorder,
+		logger loggerDomain.Logger,
+	) services.TeamService {
+		return services.NewTeamService(teamRepo, accountRepo, auditRecorder, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Team ownership checks for modules that store team-owned data
+	if err := m.container.Provide(func(service services.TeamService) auth.TeamAccess {
+		return service
+	}); err != nil {
+		return err
+	}
+
+	// Register reconciliation service (auth provider -> local account drift)
+	if err := m.container.Provide(func(
+		authMemberRepo domain.AuthMemberRepository,
+		localOrgRepo domain.OrganizationRepository,
+		localAccountRepo domain.AccountRepository,
+		logger loggerDomain.Logger,
+	) services.ReconciliationService {
+		return services.NewReconciliationService(
+			authMemberRepo,
+			localOrgRepo,
+			localAccountRepo,
+			logger,
+		)
+	}); err != nil {
+		return err
+	}
+
+	if err := m.container.Provide(func(
+		service services.ReconciliationService,
+		cfg *stytchcfg.Config,
+		logger loggerDomain.Logger,
+	) *services.ReconciliationScheduler {
+		return services.NewReconciliationScheduler(service, cfg.ReconcileInterval, cfg.ReconcileDryRun, logger)
+	}); err != nil {
+		return err
+	}
+
+	// Register auth provider webhook handling (Stytch implementation)
+	if err := m.container.Provide(func() domain.AuthWebhookDecoder {
+		return repositories.NewStytchWebhookDecoder()
+	}); err != nil {
+		return err
+	}
+
+	if err := m.container.Provide(func(
+		decoder domain.AuthWebhookDecoder,
+		localOrgRepo domain.OrganizationRepository,
+		localAccountRepo domain.AccountRepository,
+		eventBus eventbus.EventBus,
+		cache redis.Client,
+		logger loggerDomain.Logger,
+	) services.WebhookService {
+		return services.NewWebhookService(
+			decoder,
+			localOrgRepo,
+			localAccountRepo,
+			eventBus,
+			cache,
 			logger,
 		)
 	}); err != nil {
@@ -105,3 +366,76 @@ func (m *Module) RegisterDependencies() error {
 
 	return nil
 }
+
+// RegisterEventHandlers subscribes module services to organization events.
+func (m *Module) RegisterEventHandlers() error {
+	return m.container.Invoke(func(
+		bus eventbus.EventBus,
+		sessionService services.SessionService,
+		auditRecorder audit.Recorder,
+	) error {
+		// Organization and account changes are recorded in the audit log
+		for _, eventName := range []string{
+			events.OrganizationCreatedEventType,
+			events.OrganizationUpdatedEventType,
+			events.AccountCreatedEventType,
+			events.AccountUpdatedEventType,
+			events.AccountDeletedEventType,
+			events.AccountLoginEventType,
+		} {
+			if err := bus.Subscribe(eventName, auditRecorder.HandleEvent); err != nil {
+				return err
+			}
+		}
+
+		// Role changes, suspensions, and deletions invalidate outstanding tokens
+		for _, eventName := range []string{
+			events.AccountUpdatedEventType,
+			events.AccountDeletedEventType,
+		} {
+			if err := bus.Subscribe(eventName, sessionService.HandleAccountEvent); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// StartBackgroundJobs registers the scheduled member reconciliation with the
+// lifecycle manager, which starts it with the server and stops it on shutdown.
+// Skipped when the Stytch client is not configured (development mode).
+func (m *Module) StartBackgroundJobs() error {
+	return m.container.Invoke(func(
+		client *stytchcfg.Client,
+		scheduler *services.ReconciliationScheduler,
+		manager *lifecycle.Manager,
+	) error {
+		if client == nil {
+			return nil
+		}
+		return manager.Append(lifecycle.Hook{
+			Name: "organizations.reconciliation",
+			OnStart: func(context.Context) error {
+				scheduler.Start()
+				return nil
+			},
+			OnStop: func(context.Context) error {
+				scheduler.Stop()
+				return nil
+			},
+		})
+	})
+}
+
+// policySettingsProvider adapts domain.OrganizationSettingsRepository to auth.OrgSettingsProvider
+type policySettingsProvider struct {
+	repo domain.OrganizationSettingsRepository
+}
+
+func (p *policySettingsProvider) GetOrganizationSettings(ctx context.Context, orgID int32) (auth.OrgSettings, error) {
+	settings, err := p.repo.Get(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return auth.OrgSettings(settings.Values), nil
+}
@@ -24,6 +24,179 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/accounts/{id}/sessions": {
+            "get": {
+                "description": "Lists the active sessions of an account in the current organization. Requires org:manage.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "List account sessions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Account ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.AuthSession"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid account ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to list sessions",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Revokes every session and outstanding token of an account in the current organization. Requires org:manage.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Revoke all account sessions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Account ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sessions revoked"
+                    },
+                    "400": {
+                        "description": "Invalid account ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to revoke sessions",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{id}/sessions/{session_id}": {
+            "delete": {
+                "description": "Revokes a single session of an account in the current organization. Requires org:manage.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Revoke account session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Account ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Session revoked"
+                    },
+                    "400": {
+                        "description": "Invalid account ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Account or session not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to revoke session",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
         "/api/subscriptions/status": {
             "get": {
                 "description": "Retrieve the current subscription billing status and invoice quota information for the organization",
@@ -113,7 +286,7 @@ const docTemplate = `{
         },
         "/auth/check-email": {
             "get": {
-                "description": "Checks if an email exists in any organization. Returns 200 OK (empty response) if exists, 404 Not Found if doesn't exist. This is a public endpoint used during login flow.",
+                "description": "Checks if an email exists in any organization and which organization verified its domain. Returns 200 OK if the email exists or its domain belongs to an organization (domain_organization.jit_enabled tells whether signing in joins it automatically), 404 Not Found otherwise. This is a public endpoint used during login flow.",
                 "consumes": [
                     "application/json"
                 ],
@@ -135,7 +308,10 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Email exists"
+                        "description": "Email exists or its domain is claimed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_app_services.CheckEmailResponse"
+                        }
                     },
                     "400": {
                         "description": "Invalid email format",
@@ -387,135 +563,293 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/signup": {
-            "post": {
-                "description": "Creates a new organization in Stytch with an initial admin member. The admin receives a magic link invite email to complete passwordless onboarding. Organization slug is auto-generated from the organization name.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/auth/sessions": {
+            "get": {
+                "description": "Lists the current user's active sessions. The session used for this request is flagged with current=true.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "auth"
                 ],
-                "summary": "Bootstrap organization",
+                "summary": "List my sessions",
                 "parameters": [
                     {
-                        "description": "Organization bootstrap request (passwordless - no password required)",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_app_services.BootstrapOrganizationRequest"
-                        }
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_app_services.BootstrapOrganizationResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.AuthSession"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Invalid request payload",
+                    "401": {
+                        "description": "Authentication required",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "500": {
-                        "description": "Failed to bootstrap organization",
+                        "description": "Failed to list sessions",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     }
                 }
-            }
-        },
-        "/example_cognitive/chat": {
-            "post": {
-                "description": "Sends a message to the AI and gets a response, optionally using RAG",
-                "consumes": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Revokes every session of the current user, including the one used for this request.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cognitive"
+                    "auth"
                 ],
-                "summary": "Chat with AI",
+                "summary": "Revoke all my sessions",
                 "parameters": [
                     {
-                        "description": "Chat request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_example_cognitive.ChatRequest"
-                        }
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_app_example_cognitive_domain.ChatResponse"
-                        }
+                    "204": {
+                        "description": "Sessions revoked"
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Authentication required",
                         "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Failed to revoke sessions",
                         "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     }
                 }
             }
         },
-        "/example_cognitive/sessions": {
-            "get": {
-                "description": "Lists chat sessions for the current user with pagination",
+        "/auth/sessions/{session_id}": {
+            "delete": {
+                "description": "Revokes a single session of the current user. Tokens issued for that session are rejected immediately.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cognitive"
+                    "auth"
                 ],
-                "summary": "List chat sessions",
+                "summary": "Revoke one of my sessions",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "Session revoked"
+                    },
+                    "401": {
+                        "description": "Authentication required",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "500": {
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to revoke session",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/signup": {
+            "post": {
+                "description": "Creates a new organization in Stytch with an initial admin member. The admin receives a magic link invite email to complete passwordless onboarding. Organization slug is auto-generated from the organization name.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Bootstrap organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries return the original response instead of creating another organization",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Organization bootstrap request (passwordless - no password required)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_app_services.BootstrapOrganizationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_app_services.BootstrapOrganizationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "A request with this Idempotency-Key is still in progress",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency-Key was already used for a different request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to bootstrap organization",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/example_cognitive/chat": {
+            "post": {
+                "description": "Sends a message to the AI and gets a response, optionally using RAG",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cognitive"
+                ],
+                "summary": "Chat with AI",
+                "parameters": [
+                    {
+                        "description": "Chat request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_example_cognitive.ChatRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_example_cognitive_domain.ChatResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/example_cognitive/sessions": {
+            "get": {
+                "description": "Lists chat sessions for the current user with pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cognitive"
+                ],
+                "summary": "List chat sessions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
@@ -616,40 +950,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/example_documents/upload": {
-            "post": {
-                "description": "Uploads a PDF document, extracts text, and creates embeddings",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/example_documents/jobs/{job_id}": {
+            "get": {
+                "description": "Returns the status, attempt count, and last error of a document processing job",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Documents"
                 ],
-                "summary": "Upload PDF document",
+                "summary": "Get document job",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "PDF file to upload",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Document title",
-                        "name": "title",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "job_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.Document"
+                            "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.DocumentJob"
                         }
                     },
                     "400": {
@@ -658,6 +982,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -667,25 +997,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/example_documents/{id}": {
-            "delete": {
-                "description": "Deletes a document and its associated file",
+        "/example_documents/types": {
+            "get": {
+                "description": "Lists the types documents are classified into: the built-in types (invoice, receipt, contract, report, other) followed by the organization's custom types",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Documents"
                 ],
-                "summary": "Delete document",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Document ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "List document types",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_app_services.DocumentTypesResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -700,11 +1027,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/rbac/check-permission": {
+            },
             "post": {
-                "description": "Verifies whether a role has been granted a specific permission. Useful for conditional UI rendering.",
+                "description": "Adds a custom document type. The description is shown to the classifier, so it should say what sets these documents apart. An optional extraction schema (a JSON schema object whose properties are the fields) makes documents of the type have their fields extracted. Requires org:manage",
                 "consumes": [
                     "application/json"
                 ],
@@ -712,602 +1037,4249 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC"
+                    "Documents"
                 ],
-                "summary": "Check if a role has a specific permission",
+                "summary": "Create document type",
                 "parameters": [
                     {
-                        "description": "Role and permission to check",
-                        "name": "body",
+                        "description": "Document type",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.PermissionCheckRequest"
+                            "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.CreateDocumentTypeRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Permission check result",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.PermissionCheckResponse"
+                            "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.DocumentType"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "A type with this name already exists",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
                         }
                     }
                 }
             }
         },
-        "/rbac/metadata": {
-            "get": {
-                "description": "Returns summary information about the RBAC system including total roles, permissions, and categories.",
+        "/example_documents/types/{type_id}": {
+            "put": {
+                "description": "Replaces the description and extraction schema of a custom document type; omitting the schema removes it. Names cannot change because classified documents refer to them. Requires org:manage",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC"
+                    "Documents"
                 ],
-                "summary": "Get RBAC system metadata",
-                "responses": {
-                    "200": {
-                        "description": "RBAC system metadata",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.RBACMetadata"
-                        }
+                "summary": "Update document type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Document type ID",
+                        "name": "type_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Document type",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.UpdateDocumentTypeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.DocumentType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a custom document type. Documents already classified as this type keep their classification. Requires org:manage",
+                "tags": [
+                    "Documents"
+                ],
+                "summary": "Delete document type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Document type ID",
+                        "name": "type_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
                     }
                 }
             }
         },
-        "/rbac/permissions": {
-            "get": {
-                "description": "Returns all available permissions in the system. Each permission includes resource, action, display name, and description for frontend rendering.",
+        "/example_documents/upload": {
+            "post": {
+                "description": "Uploads a document, extracts text, and creates embeddings. Accepts PDF, PNG, JPEG, WebP and TIFF, which are read with OCR, and DOCX, XLSX, CSV and TXT, which are read directly with tables kept as markdown. The type follows the file extension and must match the file content",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC"
+                    "Documents"
+                ],
+                "summary": "Upload document",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "File to upload (.pdf, .png, .jpg, .jpeg, .webp, .tif, .tiff, .docx, .xlsx, .csv, .txt)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Document title",
+                        "name": "title",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team that owns the document; omit to share it with the whole organization",
+                        "name": "team_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries return the original response instead of uploading again",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
                 ],
-                "summary": "Get all permissions",
                 "responses": {
-                    "200": {
-                        "description": "All permissions",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.PermissionsResponse"
+                            "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.Document"
                         }
                     },
-                    "500": {
-                        "description": "Internal error",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "A request with this Idempotency-Key is still in progress",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency-Key was already used for a different request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
                         }
                     }
                 }
             }
         },
-        "/rbac/permissions/by-category": {
-            "get": {
-                "description": "Returns all permissions organized by their category for better UI organization.",
-                "produces": [
-                    "application/json"
-                ],
+        "/example_documents/{id}": {
+            "delete": {
+                "description": "Deletes a document and its associated file. When the organization enables edit_own_resources_only, only the uploader or an org manager may delete it",
                 "tags": [
-                    "RBAC"
+                    "Documents"
+                ],
+                "summary": "Delete document",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Document ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get permissions grouped by category",
                 "responses": {
-                    "200": {
-                        "description": "Permissions by category",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.PermissionsByCategoryResponse"
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
                         }
                     },
-                    "500": {
-                        "description": "Internal error",
+                    "403": {
+                        "description": "Denied by the resource policy, with the reason",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
                         }
                     }
                 }
             }
         },
-        "/rbac/roles": {
+        "/example_documents/{id}/fields": {
             "get": {
-                "description": "Returns all available roles in the system with their associated permissions. This is the single source of truth for frontend role/permission discovery.",
+                "description": "Lists the structured fields extracted from a document, such as the vendor, dates, totals and line items of an invoice. Fields follow the extraction schema of the document's type; each has the extractor's confidence and the page it was read from. The outcome of the extraction is in the document's metadata.field_extraction",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC"
+                    "Documents"
+                ],
+                "summary": "List document fields",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Document ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all roles with permissions",
                 "responses": {
                     "200": {
-                        "description": "Roles with permissions",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.RolesResponse"
+                            "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_app_services.DocumentFieldsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Internal error",
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
                         }
                     }
                 }
             }
         },
-        "/rbac/roles/{role_id}": {
+        "/example_documents/{id}/jobs": {
             "get": {
-                "description": "Returns comprehensive information about a role including permissions, statistics, and restrictions.",
+                "description": "Lists the most recent processing jobs of a document, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC"
+                    "Documents"
                 ],
-                "summary": "Get detailed information about a specific role",
+                "summary": "List document jobs",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Role ID (member, approver, admin)",
-                        "name": "role_id",
+                        "type": "integer",
+                        "description": "Document ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Role details with statistics",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.RolePermissionsResponse"
+                            "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_app_services.DocumentJobsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid role ID",
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
                         }
                     },
                     "404": {
-                        "description": "Role not found",
+                        "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
                         }
-                    }
-                }
-            }
-        }
-    },
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/example_documents/{id}/pages/{n}": {
+            "get": {
+                "description": "Retrieves the extracted text, layout blocks and tables of one page of a processed document. Bounding boxes are fractions (0-1) of the page size with the origin at the top-left corner.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Documents"
+                ],
+                "summary": "Get document page",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Document ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (1-based)",
+                        "name": "n",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_app_services.DocumentPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/example_documents/{id}/reprocess": {
+            "post": {
+                "description": "Queues a document for text extraction again, e.g. after a failure. Processing runs in the background; poll the returned job for progress. When the organization enables edit_own_resources_only, only the uploader or an org manager may reprocess it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Documents"
+                ],
+                "summary": "Reprocess document",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Document ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.DocumentJob"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Denied by the resource policy, with the reason",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "The document is already queued or processing",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/ocr/jobs": {
+            "post": {
+                "description": "Starts extracting the text of a file in the background and returns the queued job. Poll the job, or pass a callback URL to receive it once it succeeds, fails or is canceled. Callback hosts must be listed in OCR_JOB_CALLBACK_ALLOWED_HOSTS. The callback is signed with the callback_secret returned here, which is not shown again: X-OCR-Signature is \"sha256=\" and the hex HMAC-SHA256 of \"{X-OCR-Timestamp}.{body}\". The type follows the file extension and must match the file content. Each job consumes one unit of the processing quota",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "OCR"
+                ],
+                "summary": "Submit OCR job",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "File to extract (.pdf, .png, .jpg, .jpeg, .webp, .tif, .tiff, .docx, .xlsx, .csv, .txt)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "URL that receives a POST with the finished job",
+                        "name": "callback_url",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries return the original response instead of submitting again",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_ocr_domain.Job"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "402": {
+                        "description": "The processing quota is used up",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "A request with this Idempotency-Key is still in progress",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "413": {
+                        "description": "The file exceeds the size limit of its type",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency-Key was already used for a different request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "429": {
+                        "description": "Too many OCR jobs are queued or running",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "503": {
+                        "description": "The instance is shutting down",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/ocr/jobs/{job_id}": {
+            "get": {
+                "description": "Returns the status and page progress of an OCR job of the organization, with the extracted text once it has succeeded",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "OCR"
+                ],
+                "summary": "Get OCR job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_ocr_domain.Job"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stops a queued or running OCR job of the organization. Canceling a finished job does nothing",
+                "tags": [
+                    "OCR"
+                ],
+                "summary": "Cancel OCR job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_common_errors.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/audit-log": {
+            "get": {
+                "description": "Lists audit events newest first. Use next_cursor as cursor to get the next page. With format=csv or format=jsonl every matching event is streamed as a file download and cursor/limit are ignored.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Get organization audit log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action, or prefix ending in * (e.g. member.*)",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Actor account ID",
+                        "name": "actor_account_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target type",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target ID",
+                        "name": "target_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Occurred at or after (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Occurred before (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Return events before this sequence",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "json (default), csv, or jsonl",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_audit_domain.EventPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get audit log",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/audit-log/verify": {
+            "get": {
+                "description": "Recomputes every event hash in order and reports the first event whose hash or link to the previous event does not match.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Verify organization audit log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_audit_domain.ChainVerification"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to verify audit log",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/domains": {
+            "get": {
+                "description": "Returns the email domains the organization has claimed, with their verification status, DNS verification record, and JIT provisioning settings.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "List organization domains",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.OrganizationDomain"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to list domains",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Claims an email domain. Publish the returned verification_record as a DNS TXT record, then call the verify endpoint. Public email providers cannot be claimed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Claim organization domain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Domain",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.ClaimDomainRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.OrganizationDomain"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid domain",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Domain already claimed or verified by another organization",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to claim domain",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/domains/{id}": {
+            "put": {
+                "description": "Turns just-in-time provisioning on or off for a verified domain. Users from the domain who authenticate are added with default_role (member or approver) while the organization has free seats.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Update domain JIT provisioning",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Domain ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "JIT settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.UpdateDomainJITRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.OrganizationDomain"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Domain not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Domain is not verified",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update domain",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes the domain claim and stops JIT provisioning for it. Existing members are not affected.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Remove organization domain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Domain ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Domain removed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid domain ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Domain not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to remove domain",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/domains/{id}/verify": {
+            "post": {
+                "description": "Looks up the domain's DNS TXT verification record and marks the domain verified when it carries the organization's token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Verify organization domain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Domain ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.OrganizationDomain"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid domain ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Domain not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Domain verified by another organization",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Verification record not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to verify domain",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/reconcile": {
+            "post": {
+                "description": "Compares members in the auth provider with local accounts and reports drift (missing, orphaned, role mismatch). Runs as a dry run by default; pass dry_run=false to apply the fixes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Reconcile organization members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only report drift without applying changes (default true)",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.ReconciliationReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid dry_run value or missing organization context",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to reconcile members",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/security-policy": {
+            "get": {
+                "description": "Returns the MFA requirement, allowed auth methods, and IP allowlist enforced on every request to the organization. Empty lists impose no restriction.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Get organization security policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.SecurityPolicy"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get security policy",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the security policy. Requests that violate it are rejected with 403 and a reason of ip_not_allowed, auth_method_not_allowed, or mfa_required. A policy that would block the caller's own session is rejected with 409.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Update organization security policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Security policy",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.UpdateSecurityPolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.SecurityPolicy"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid security policy",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Policy would block the current session",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update security policy",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/settings": {
+            "get": {
+                "description": "Returns the current organization's settings, including the flags read by authorization policies (allow_self_approval, edit_own_resources_only).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Get organization settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.OrganizationSettings"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get settings",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Merges the given keys into the organization's settings. A null value removes the key. Policy flags must be booleans.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Update organization settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Settings to change",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.OrganizationSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid settings",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update settings",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/sso": {
+            "get": {
+                "description": "Returns the organization's SAML and OIDC connections, the default connection, and whether members must log in with SSO.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Get SSO settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.SSOSettings"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Organization is not linked to the auth provider",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get SSO settings",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/sso/connections": {
+            "post": {
+                "description": "Creates a pending SAML or OIDC connection. Give the returned acs_url/audience_uri (SAML) or redirect_url (OIDC) to the IdP, then configure the connection.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Create SSO connection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Connection",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.CreateSSOConnectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.SSOConnection"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Organization is not linked to the auth provider",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create SSO connection",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/sso/connections/{connection_id}": {
+            "delete": {
+                "description": "Deletes an SSO connection. The default connection cannot be deleted while SSO is required.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Delete SSO connection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Connection ID",
+                        "name": "connection_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Connection deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Connection not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Connection is required for SSO login",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete SSO connection",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/sso/connections/{connection_id}/oidc": {
+            "put": {
+                "description": "Configures an OIDC connection. Endpoints that are omitted are discovered from the issuer.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Configure OIDC connection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Connection ID",
+                        "name": "connection_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "IdP configuration",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.UpdateOIDCConnectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.SSOConnection"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or connection is not OIDC",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Connection not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update SSO connection",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/sso/connections/{connection_id}/saml": {
+            "put": {
+                "description": "Configures a SAML connection from the IdP metadata URL, or from the IdP entity ID, SSO URL, and signing certificate.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Configure SAML connection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Connection ID",
+                        "name": "connection_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "IdP configuration",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.UpdateSAMLConnectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.SSOConnection"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or connection is not SAML",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Connection not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update SSO connection",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/sso/default-connection": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Set default SSO connection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Connection",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.SetDefaultSSOConnectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Default connection set",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Connection not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to set default connection",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/sso/required": {
+            "put": {
+                "description": "When required, members can only log in through SSO. Requiring SSO needs an active default connection.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Require SSO login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Requirement",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.SetSSORequiredRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "SSO requirement updated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "No active default connection",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update SSO requirement",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/teams": {
+            "get": {
+                "description": "Returns every team in the organization for organization managers, and the caller's own teams for everyone else.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "List teams",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.Team"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to list teams",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a team. Documents and resources assigned to a team are visible only to its members and organization managers.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Create team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Team",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.CreateTeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.Team"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Team name already taken",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create team",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/teams/{id}": {
+            "get": {
+                "description": "Returns a team. Members see their own teams; organization managers see every team.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Get team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.Team"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Not a member of the team",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get team",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates a team's name and description. Requires org:manage or the team's lead role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Update team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Team",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.UpdateTeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.Team"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Not a lead of the team",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Team name already taken",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update team",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a team and its memberships. Fails while documents or resources are still assigned to the team.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Delete team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Team deleted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Team still owns documents or resources",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete team",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/teams/{id}/members": {
+            "get": {
+                "description": "Returns the members of a team with their team roles.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "List team members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.TeamMember"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Not a member of the team",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to list team members",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/teams/{id}/members/{account_id}": {
+            "put": {
+                "description": "Adds an organization account to the team, or updates its role (lead or member). Requires org:manage or the team's lead role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Set team member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Account ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Team role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.SetTeamMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.TeamMember"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Not a lead of the team",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Team or account not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to set team member",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes an account from the team. Requires org:manage or the team's lead role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Remove team member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer JWT token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Team ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Account ID",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Team member removed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Not a lead of the team",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Team or team member not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to remove team member",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/rbac/check": {
+            "post": {
+                "description": "Evaluates up to 100 permission checks in one call. Each check may target a resource instance (resource_type + resource_id) and may require the caller to be its owner or approval assignee (relation). Results are returned in request order with a reason when denied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "Check a batch of permissions for the caller",
+                "parameters": [
+                    {
+                        "description": "Permission checks",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.BatchPermissionCheckRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Check results",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.BatchPermissionCheckResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/rbac/check-permission": {
+            "post": {
+                "description": "Verifies whether a role has been granted a specific permission. Useful for conditional UI rendering.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "Check if a role has a specific permission",
+                "parameters": [
+                    {
+                        "description": "Role and permission to check",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.PermissionCheckRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Permission check result",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.PermissionCheckResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/rbac/me": {
+            "get": {
+                "description": "Returns the roles and fully resolved permissions of the authenticated user, with wildcards and role-derived permissions expanded. Use this to drive UI visibility with a single call.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "Get the caller's effective permissions",
+                "responses": {
+                    "200": {
+                        "description": "Effective roles and permissions",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.EffectivePermissionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/rbac/metadata": {
+            "get": {
+                "description": "Returns summary information about the RBAC system including total roles, permissions, and categories.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "Get RBAC system metadata",
+                "responses": {
+                    "200": {
+                        "description": "RBAC system metadata",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.RBACMetadata"
+                        }
+                    }
+                }
+            }
+        },
+        "/rbac/permissions": {
+            "get": {
+                "description": "Returns all available permissions in the system. Each permission includes resource, action, display name, and description for frontend rendering.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "Get all permissions",
+                "responses": {
+                    "200": {
+                        "description": "All permissions",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.PermissionsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/rbac/permissions/by-category": {
+            "get": {
+                "description": "Returns all permissions organized by their category for better UI organization.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "Get permissions grouped by category",
+                "responses": {
+                    "200": {
+                        "description": "Permissions by category",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.PermissionsByCategoryResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/rbac/roles": {
+            "get": {
+                "description": "Returns all available roles in the system with their associated permissions. This is the single source of truth for frontend role/permission discovery.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "Get all roles with permissions",
+                "responses": {
+                    "200": {
+                        "description": "Roles with permissions",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.RolesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/rbac/roles/{role_id}": {
+            "get": {
+                "description": "Returns comprehensive information about a role including permissions, statistics, and restrictions.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC"
+                ],
+                "summary": "Get detailed information about a specific role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Role ID (member, approver, admin)",
+                        "name": "role_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role details with statistics",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.RolePermissionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid role ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Role not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/stytch": {
+            "post": {
+                "description": "Verifies the Svix signature of a Stytch event webhook and syncs local accounts and organizations. Handles member created/updated/deleted and organization updated events; redelivered events are acknowledged without side effects.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Stytch webhook receiver",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook message ID",
+                        "name": "svix-id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook timestamp (unix seconds)",
+                        "name": "svix-timestamp",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook signature",
+                        "name": "svix-signature",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_app_services.WebhookResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook payload",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid webhook signature",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to process webhook",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "503": {
+                        "description": "Webhook secret not configured",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        }
+    },
     "definitions": {
         "api_example_cognitive.ChatRequest": {
             "type": "object",
             "required": [
-                "message"
+                "message"
+            ],
+            "properties": {
+                "context_history": {
+                    "type": "integer"
+                },
+                "max_documents": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "session_id": {
+                    "type": "integer"
+                },
+                "use_rag": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "api_subscriptions.VerifyPaymentRequest": {
+            "type": "object",
+            "required": [
+                "session_id"
+            ],
+            "properties": {
+                "session_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_audit_domain.ChainVerification": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "type": "integer"
+                },
+                "first_broken_sequence": {
+                    "type": "integer"
+                },
+                "organization_id": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_audit_domain.Change": {
+            "type": "object",
+            "properties": {
+                "after": {},
+                "before": {}
+            }
+        },
+        "github_com_moasq_backend_app_audit_domain.Event": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actor_account_id": {
+                    "type": "integer"
+                },
+                "actor_email": {
+                    "type": "string"
+                },
+                "actor_type": {
+                    "type": "string"
+                },
+                "changes": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_moasq_backend_app_audit_domain.Change"
+                    }
+                },
+                "hash": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ip_address": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "occurred_at": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "integer"
+                },
+                "prev_hash": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "sequence": {
+                    "type": "integer"
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_audit_domain.EventPage": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_app_audit_domain.Event"
+                    }
+                },
+                "next_cursor": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_billing_domain.BillingStatus": {
+            "type": "object",
+            "properties": {
+                "canProcessInvoices": {
+                    "type": "boolean"
+                },
+                "checkedAt": {
+                    "type": "string"
+                },
+                "externalID": {
+                    "type": "string"
+                },
+                "hasActiveSubscription": {
+                    "type": "boolean"
+                },
+                "invoiceCount": {
+                    "description": "Remaining invoices",
+                    "type": "integer"
+                },
+                "organizationID": {
+                    "type": "integer"
+                },
+                "planName": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_cognitive_domain.ChatMessage": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "referenced_docs": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "role": {
+                    "$ref": "#/definitions/github_com_moasq_backend_app_example_cognitive_domain.ChatRole"
+                },
+                "session_id": {
+                    "type": "integer"
+                },
+                "tokens_used": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_cognitive_domain.ChatResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "$ref": "#/definitions/github_com_moasq_backend_app_example_cognitive_domain.ChatMessage"
+                },
+                "referenced_docs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_app_example_cognitive_domain.SimilarDocument"
+                    }
+                },
+                "session_id": {
+                    "type": "integer"
+                },
+                "tokens_used": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_cognitive_domain.ChatRole": {
+            "type": "string",
+            "enum": [
+                "user",
+                "assistant",
+                "system"
+            ],
+            "x-enum-varnames": [
+                "ChatRoleUser",
+                "ChatRoleAssistant",
+                "ChatRoleSystem"
+            ]
+        },
+        "github_com_moasq_backend_app_example_cognitive_domain.SimilarDocument": {
+            "type": "object",
+            "properties": {
+                "chunk_index": {
+                    "type": "integer"
+                },
+                "content_hash": {
+                    "type": "string"
+                },
+                "content_preview": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "document_id": {
+                    "type": "integer"
+                },
+                "embedding": {
+                    "description": "1536 dimensions for OpenAI",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "organization_id": {
+                    "type": "integer"
+                },
+                "similarity_score": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_app_services.DocumentFieldsResponse": {
+            "type": "object",
+            "properties": {
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.DocumentField"
+                    }
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_app_services.DocumentJobsResponse": {
+            "type": "object",
+            "properties": {
+                "jobs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.DocumentJob"
+                    }
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_app_services.DocumentPageResponse": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.DocumentPage"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_app_services.DocumentTypesResponse": {
+            "type": "object",
+            "properties": {
+                "types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.DocumentType"
+                    }
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_app_services.ListDocumentsResponse": {
+            "type": "object",
+            "properties": {
+                "documents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.Document"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_domain.BoundingBox": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "number"
+                },
+                "width": {
+                    "type": "number"
+                },
+                "x": {
+                    "type": "number"
+                },
+                "y": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_domain.CreateDocumentTypeRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "extraction_schema": {
+                    "$ref": "#/definitions/github_com_moasq_backend_pkg_llm_domain.Schema"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_domain.Document": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by_account_id": {
+                    "description": "Uploader; nil for documents uploaded before it was recorded",
+                    "type": "integer"
+                },
+                "extracted_text": {
+                    "type": "string"
+                },
+                "file_asset_id": {
+                    "type": "integer"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "file_size": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "organization_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.DocumentStatus"
+                },
+                "team_id": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_domain.DocumentField": {
+            "type": "object",
+            "properties": {
+                "confidence": {
+                    "description": "0.0 to 1.0",
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "page_number": {
+                    "description": "Page the value was read from, when known",
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "value": {}
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_domain.DocumentJob": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "document_id": {
+                    "type": "integer"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "max_attempts": {
+                    "type": "integer"
+                },
+                "organization_id": {
+                    "type": "integer"
+                },
+                "run_after": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.JobStatus"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_domain.DocumentPage": {
+            "type": "object",
+            "properties": {
+                "blocks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.PageBlock"
+                    }
+                },
+                "document_id": {
+                    "type": "integer"
+                },
+                "extractor": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "number"
+                },
+                "page_number": {
+                    "type": "integer"
+                },
+                "tables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.PageTable"
+                    }
+                },
+                "text": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_domain.DocumentStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "processing",
+                "processed",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "DocumentStatusPending",
+                "DocumentStatusProcessing",
+                "DocumentStatusProcessed",
+                "DocumentStatusFailed"
+            ]
+        },
+        "github_com_moasq_backend_app_example_documents_domain.DocumentType": {
+            "type": "object",
+            "properties": {
+                "built_in": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "extraction_schema": {
+                    "$ref": "#/definitions/github_com_moasq_backend_pkg_llm_domain.Schema"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_domain.JobStatus": {
+            "type": "string",
+            "enum": [
+                "queued",
+                "running",
+                "succeeded",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "JobStatusQueued",
+                "JobStatusRunning",
+                "JobStatusSucceeded",
+                "JobStatusFailed"
+            ]
+        },
+        "github_com_moasq_backend_app_example_documents_domain.PageBlock": {
+            "type": "object",
+            "properties": {
+                "bbox": {
+                    "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.BoundingBox"
+                },
+                "confidence": {
+                    "type": "number"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_domain.PageTable": {
+            "type": "object",
+            "properties": {
+                "bbox": {
+                    "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.BoundingBox"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "github_com_moasq_backend_app_example_documents_domain.UpdateDocumentTypeRequest": {
+            "type": "object",
+            "required": [
+                "description"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "extraction_schema": {
+                    "$ref": "#/definitions/github_com_moasq_backend_pkg_llm_domain.Schema"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_app_services.AddMemberResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "invite_sent": {
+                    "type": "boolean"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "org_id": {
+                    "type": "string"
+                },
+                "role_slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_app_services.BootstrapOrganizationRequest": {
+            "type": "object",
+            "required": [
+                "org_display_name",
+                "owner_email",
+                "owner_name"
+            ],
+            "properties": {
+                "org_display_name": {
+                    "description": "Organization details",
+                    "type": "string"
+                },
+                "owner_email": {
+                    "description": "Owner member details",
+                    "type": "string"
+                },
+                "owner_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_app_services.BootstrapOrganizationResponse": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "invite_sent": {
+                    "type": "boolean"
+                },
+                "magic_link_sent": {
+                    "type": "boolean"
+                },
+                "org_slug": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "owner_email": {
+                    "type": "string"
+                },
+                "owner_member_id": {
+                    "type": "string"
+                },
+                "owner_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_app_services.CheckEmailResponse": {
+            "type": "object",
+            "properties": {
+                "domain_organization": {
+                    "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.DomainOwner"
+                },
+                "exists": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_app_services.ListMembersResponse": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_app_organizations_app_services.MemberInfo"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_app_services.MemberInfo": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "email_verified": {
+                    "type": "boolean"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_app_services.ProfileOrganization": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_app_services.ProfileResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "description": "Internal account details",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "email_verified": {
+                    "type": "boolean"
+                },
+                "member_id": {
+                    "description": "Auth provider member details",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "organization": {
+                    "description": "Organization details",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_app_services.ProfileOrganization"
+                        }
+                    ]
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_app_services.WebhookResult": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "changed": {
+                    "type": "boolean"
+                },
+                "duplicate": {
+                    "type": "boolean"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "object_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.AuthSession": {
+            "type": "object",
+            "properties": {
+                "auth_methods": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "current": {
+                    "type": "boolean"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "last_accessed_at": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "session_id": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.ClaimDomainRequest": {
+            "type": "object",
+            "required": [
+                "domain"
             ],
             "properties": {
-                "context_history": {
+                "domain": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.CreateSSOConnectionRequest": {
+            "type": "object",
+            "required": [
+                "display_name",
+                "type"
+            ],
+            "properties": {
+                "display_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "identity_provider": {
+                    "type": "string"
+                },
+                "type": {
+                    "enum": [
+                        "saml",
+                        "oidc"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.SSOConnectionType"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.CreateTeamRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.DomainOwner": {
+            "type": "object",
+            "properties": {
+                "domain": {
+                    "type": "string"
+                },
+                "jit_enabled": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.DomainVerificationRecord": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.DriftType": {
+            "type": "string",
+            "enum": [
+                "missing_local",
+                "orphaned_local",
+                "role_mismatch",
+                "member_id_mismatch",
+                "email_verified_mismatch"
+            ],
+            "x-enum-varnames": [
+                "DriftMissingLocal",
+                "DriftOrphanedLocal",
+                "DriftRoleMismatch",
+                "DriftMemberIDMismatch",
+                "DriftEmailVerifiedMismatch"
+            ]
+        },
+        "github_com_moasq_backend_app_organizations_domain.MemberDrift": {
+            "type": "object",
+            "properties": {
+                "account_id": {
                     "type": "integer"
                 },
-                "max_documents": {
+                "applied": {
+                    "type": "boolean"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "local_value": {
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "remote_value": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.DriftType"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.OrganizationDomain": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "default_role": {
+                    "type": "string"
+                },
+                "domain": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "jit_enabled": {
+                    "type": "boolean"
+                },
+                "organization_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "verification_record": {
+                    "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.DomainVerificationRecord"
+                },
+                "verified": {
+                    "type": "boolean"
+                },
+                "verified_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.OrganizationSettings": {
+            "type": "object",
+            "properties": {
+                "organization_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "values": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.ReconciliationReport": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "integer"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "drifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.MemberDrift"
+                    }
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "local_accounts": {
+                    "type": "integer"
+                },
+                "organization_id": {
                     "type": "integer"
                 },
-                "message": {
+                "orphans_skipped": {
+                    "description": "OrphansSkipped is set when the member list may be incomplete, so local\naccounts were not checked for orphans rather than deactivated wrongly.",
+                    "type": "boolean"
+                },
+                "provider_org_id": {
                     "type": "string"
                 },
-                "session_id": {
+                "remote_members": {
                     "type": "integer"
                 },
-                "use_rag": {
-                    "type": "boolean"
-                }
-            }
-        },
-        "api_subscriptions.VerifyPaymentRequest": {
-            "type": "object",
-            "required": [
-                "session_id"
-            ],
-            "properties": {
-                "session_id": {
+                "started_at": {
                     "type": "string"
                 }
             }
         },
-        "github_com_moasq_backend_app_billing_domain.BillingStatus": {
+        "github_com_moasq_backend_app_organizations_domain.SSOConnection": {
             "type": "object",
             "properties": {
-                "canProcessInvoices": {
-                    "type": "boolean"
+                "acs_url": {
+                    "description": "SAML: service provider values to give the IdP, and the configured IdP values",
+                    "type": "string"
                 },
-                "checkedAt": {
+                "audience_uri": {
                     "type": "string"
                 },
-                "externalID": {
+                "authorization_url": {
                     "type": "string"
                 },
-                "hasActiveSubscription": {
-                    "type": "boolean"
+                "client_id": {
+                    "type": "string"
                 },
-                "invoiceCount": {
-                    "description": "Remaining invoices",
-                    "type": "integer",
-                    "format": "int32"
+                "connection_id": {
+                    "type": "string"
                 },
-                "organizationID": {
-                    "type": "integer",
-                    "format": "int32"
+                "display_name": {
+                    "type": "string"
                 },
-                "reason": {
+                "identity_provider": {
                     "type": "string"
-                }
-            }
-        },
-        "github_com_moasq_backend_app_example_cognitive_domain.ChatMessage": {
-            "type": "object",
-            "properties": {
-                "content": {
+                },
+                "idp_entity_id": {
                     "type": "string"
                 },
-                "created_at": {
+                "idp_sso_url": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "is_default": {
+                    "type": "boolean"
                 },
-                "referenced_docs": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "issuer": {
+                    "type": "string"
                 },
-                "role": {
-                    "$ref": "#/definitions/github_com_moasq_backend_app_example_cognitive_domain.ChatRole"
+                "jwks_url": {
+                    "type": "string"
                 },
-                "session_id": {
-                    "type": "integer"
+                "redirect_url": {
+                    "description": "OIDC: redirect URL to give the IdP, and the configured IdP values",
+                    "type": "string"
                 },
-                "tokens_used": {
-                    "type": "integer"
-                }
-            }
-        },
-        "github_com_moasq_backend_app_example_cognitive_domain.ChatResponse": {
-            "type": "object",
-            "properties": {
-                "message": {
-                    "$ref": "#/definitions/github_com_moasq_backend_app_example_cognitive_domain.ChatMessage"
+                "status": {
+                    "type": "string"
                 },
-                "referenced_docs": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_moasq_backend_app_example_cognitive_domain.SimilarDocument"
-                    }
+                "token_url": {
+                    "type": "string"
                 },
-                "session_id": {
-                    "type": "integer"
+                "type": {
+                    "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.SSOConnectionType"
                 },
-                "tokens_used": {
-                    "type": "integer"
+                "userinfo_url": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_moasq_backend_app_example_cognitive_domain.ChatRole": {
+        "github_com_moasq_backend_app_organizations_domain.SSOConnectionType": {
             "type": "string",
             "enum": [
-                "user",
-                "assistant",
-                "system"
+                "saml",
+                "oidc"
             ],
             "x-enum-varnames": [
-                "ChatRoleUser",
-                "ChatRoleAssistant",
-                "ChatRoleSystem"
+                "SSOConnectionTypeSAML",
+                "SSOConnectionTypeOIDC"
             ]
         },
-        "github_com_moasq_backend_app_example_cognitive_domain.SimilarDocument": {
+        "github_com_moasq_backend_app_organizations_domain.SSOSettings": {
             "type": "object",
             "properties": {
-                "chunk_index": {
-                    "type": "integer"
-                },
-                "content_hash": {
-                    "type": "string"
-                },
-                "content_preview": {
-                    "type": "string"
+                "connections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_app_organizations_domain.SSOConnection"
+                    }
                 },
-                "created_at": {
+                "default_connection_id": {
                     "type": "string"
                 },
-                "document_id": {
-                    "type": "integer"
-                },
-                "embedding": {
-                    "description": "1536 dimensions for OpenAI",
+                "sso_required": {
+                    "description": "SSORequired restricts member login to SSO.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.SecurityPolicy": {
+            "type": "object",
+            "properties": {
+                "allowed_auth_methods": {
                     "type": "array",
                     "items": {
-                        "type": "number"
+                        "type": "string"
                     }
                 },
-                "id": {
-                    "type": "integer"
+                "ip_allowlist": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "organization_id": {
                     "type": "integer"
                 },
-                "similarity_score": {
-                    "type": "number"
+                "require_mfa": {
+                    "type": "boolean"
                 },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "github_com_moasq_backend_app_example_documents_app_services.ListDocumentsResponse": {
+        "github_com_moasq_backend_app_organizations_domain.SetDefaultSSOConnectionRequest": {
             "type": "object",
+            "required": [
+                "connection_id"
+            ],
             "properties": {
-                "documents": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.Document"
-                    }
-                },
-                "limit": {
-                    "type": "integer"
-                },
-                "offset": {
-                    "type": "integer"
-                },
-                "total": {
-                    "type": "integer"
+                "connection_id": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_moasq_backend_app_example_documents_domain.Document": {
+        "github_com_moasq_backend_app_organizations_domain.SetSSORequiredRequest": {
             "type": "object",
+            "required": [
+                "required"
+            ],
             "properties": {
-                "content_type": {
+                "required": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.SetTeamMemberRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "description": "Role is lead or member. Defaults to member.",
                     "type": "string"
-                },
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.Team": {
+            "type": "object",
+            "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "extracted_text": {
-                    "type": "string"
-                },
-                "file_asset_id": {
-                    "type": "integer"
-                },
-                "file_name": {
+                "description": {
                     "type": "string"
                 },
-                "file_size": {
-                    "type": "integer"
-                },
                 "id": {
                     "type": "integer"
                 },
-                "metadata": {
-                    "type": "object",
-                    "additionalProperties": true
+                "name": {
+                    "type": "string"
                 },
                 "organization_id": {
                     "type": "integer"
                 },
-                "status": {
-                    "$ref": "#/definitions/github_com_moasq_backend_app_example_documents_domain.DocumentStatus"
-                },
-                "title": {
-                    "type": "string"
-                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "github_com_moasq_backend_app_example_documents_domain.DocumentStatus": {
-            "type": "string",
-            "enum": [
-                "pending",
-                "processing",
-                "processed",
-                "failed"
-            ],
-            "x-enum-varnames": [
-                "DocumentStatusPending",
-                "DocumentStatusProcessing",
-                "DocumentStatusProcessed",
-                "DocumentStatusFailed"
-            ]
-        },
-        "github_com_moasq_backend_app_organizations_app_services.AddMemberResponse": {
+        "github_com_moasq_backend_app_organizations_domain.TeamMember": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
+                "account_id": {
+                    "type": "integer"
                 },
-                "invite_sent": {
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string"
                 },
-                "member_id": {
+                "email": {
                     "type": "string"
                 },
-                "name": {
+                "full_name": {
                     "type": "string"
                 },
-                "org_id": {
+                "role": {
                     "type": "string"
                 },
-                "role_slug": {
+                "team_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "github_com_moasq_backend_app_organizations_app_services.BootstrapOrganizationRequest": {
+        "github_com_moasq_backend_app_organizations_domain.UpdateDomainJITRequest": {
             "type": "object",
             "required": [
-                "org_display_name",
-                "owner_email",
-                "owner_name"
+                "jit_enabled"
             ],
             "properties": {
-                "org_display_name": {
-                    "description": "Organization details",
-                    "type": "string"
-                },
-                "owner_email": {
-                    "description": "Owner member details",
+                "default_role": {
+                    "description": "DefaultRole is given to provisioned members (member or approver). Defaults to member.",
                     "type": "string"
                 },
-                "owner_name": {
-                    "type": "string"
+                "jit_enabled": {
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_moasq_backend_app_organizations_app_services.BootstrapOrganizationResponse": {
+        "github_com_moasq_backend_app_organizations_domain.UpdateOIDCConnectionRequest": {
             "type": "object",
+            "required": [
+                "client_id",
+                "client_secret",
+                "issuer"
+            ],
             "properties": {
-                "display_name": {
+                "authorization_url": {
                     "type": "string"
                 },
-                "invite_sent": {
-                    "type": "boolean"
+                "client_id": {
+                    "type": "string"
                 },
-                "magic_link_sent": {
-                    "type": "boolean"
+                "client_secret": {
+                    "type": "string"
                 },
-                "org_slug": {
+                "issuer": {
                     "type": "string"
                 },
-                "organization_id": {
+                "jwks_url": {
                     "type": "string"
                 },
-                "owner_email": {
+                "token_url": {
                     "type": "string"
                 },
-                "owner_member_id": {
+                "userinfo_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_app_organizations_domain.UpdateSAMLConnectionRequest": {
+            "type": "object",
+            "properties": {
+                "attribute_mapping": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "idp_entity_id": {
                     "type": "string"
                 },
-                "owner_name": {
+                "idp_sso_url": {
+                    "type": "string"
+                },
+                "metadata_url": {
+                    "type": "string"
+                },
+                "x509_certificate": {
                     "type": "string"
                 }
             }
         },
-        "github_com_moasq_backend_app_organizations_app_services.ListMembersResponse": {
+        "github_com_moasq_backend_app_organizations_domain.UpdateSecurityPolicyRequest": {
             "type": "object",
             "properties": {
-                "members": {
+                "allowed_auth_methods": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ip_allowlist": {
+                    "description": "IPAllowlist holds CIDR blocks (e.g. 203.0.113.0/24) or single IPs.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_moasq_backend_app_organizations_app_services.MemberInfo"
+                        "type": "string"
                     }
                 },
-                "total": {
-                    "type": "integer"
+                "require_mfa": {
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_moasq_backend_app_organizations_app_services.MemberInfo": {
+        "github_com_moasq_backend_app_organizations_domain.UpdateTeamRequest": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "email": {
-                    "type": "string"
-                },
-                "email_verified": {
-                    "type": "boolean"
-                },
-                "member_id": {
+                "description": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
-                },
-                "roles": {
+                }
+            }
+        },
+        "github_com_moasq_backend_pkg_auth.BatchPermissionCheckRequest": {
+            "type": "object",
+            "required": [
+                "checks"
+            ],
+            "properties": {
+                "checks": {
                     "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.PermissionCheckRequest"
                     }
-                },
-                "status": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
                 }
             }
         },
-        "github_com_moasq_backend_app_organizations_app_services.ProfileOrganization": {
+        "github_com_moasq_backend_pkg_auth.BatchPermissionCheckResponse": {
             "type": "object",
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "organization_id": {
-                    "type": "string"
-                },
-                "slug": {
-                    "type": "string"
-                },
-                "status": {
-                    "type": "string"
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_pkg_auth.PermissionCheckResponse"
+                    }
                 }
             }
         },
-        "github_com_moasq_backend_app_organizations_app_services.ProfileResponse": {
+        "github_com_moasq_backend_pkg_auth.EffectivePermissionsResponse": {
             "type": "object",
             "properties": {
                 "account_id": {
-                    "description": "Internal account details",
                     "type": "integer"
                 },
-                "created_at": {
-                    "type": "string"
-                },
                 "email": {
                     "type": "string"
                 },
-                "email_verified": {
-                    "type": "boolean"
-                },
-                "member_id": {
-                    "description": "Auth provider member details",
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "organization": {
-                    "description": "Organization details",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_moasq_backend_app_organizations_app_services.ProfileOrganization"
-                        }
-                    ]
+                "organization_id": {
+                    "type": "integer"
                 },
                 "permissions": {
                     "type": "array",
@@ -1321,10 +5293,7 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
-                "status": {
-                    "type": "string"
-                },
-                "updated_at": {
+                "user_id": {
                     "type": "string"
                 }
             }
@@ -1332,13 +5301,25 @@ const docTemplate = `{
         "github_com_moasq_backend_pkg_auth.PermissionCheckRequest": {
             "type": "object",
             "required": [
-                "permission_id",
-                "role_id"
+                "permission_id"
             ],
             "properties": {
                 "permission_id": {
                     "type": "string"
                 },
+                "relation": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "assignee"
+                    ]
+                },
+                "resource_id": {
+                    "type": "string"
+                },
+                "resource_type": {
+                    "type": "string"
+                },
                 "role_id": {
                     "type": "string"
                 }
@@ -1353,6 +5334,21 @@ const docTemplate = `{
                 "permission_id": {
                     "type": "string"
                 },
+                "reason": {
+                    "type": "string"
+                },
+                "relations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resource_id": {
+                    "type": "string"
+                },
+                "resource_type": {
+                    "type": "string"
+                },
                 "role_id": {
                     "type": "string"
                 }
@@ -1515,6 +5511,256 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "github_com_moasq_backend_pkg_llm_domain.Schema": {
+            "type": "object",
+            "properties": {
+                "additionalProperties": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "enum": {
+                    "type": "array",
+                    "items": {}
+                },
+                "format": {
+                    "type": "string"
+                },
+                "items": {
+                    "$ref": "#/definitions/github_com_moasq_backend_pkg_llm_domain.Schema"
+                },
+                "maxItems": {
+                    "type": "integer"
+                },
+                "maxLength": {
+                    "type": "integer"
+                },
+                "maximum": {
+                    "type": "number"
+                },
+                "minItems": {
+                    "type": "integer"
+                },
+                "minLength": {
+                    "type": "integer"
+                },
+                "minimum": {
+                    "type": "number"
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_moasq_backend_pkg_llm_domain.Schema"
+                    }
+                },
+                "required": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_pkg_ocr_domain.Block": {
+            "type": "object",
+            "properties": {
+                "bbox": {
+                    "description": "Position on the page",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_ocr_domain.BoundingBox"
+                        }
+                    ]
+                },
+                "confidence": {
+                    "description": "Recognition confidence (0.0 to 1.0), when reported",
+                    "type": "number"
+                },
+                "text": {
+                    "description": "Text of the region, empty for images",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "One of the BlockType constants",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_pkg_ocr_domain.BoundingBox": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "number"
+                },
+                "width": {
+                    "type": "number"
+                },
+                "x": {
+                    "type": "number"
+                },
+                "y": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_moasq_backend_pkg_ocr_domain.Job": {
+            "type": "object",
+            "properties": {
+                "callback_secret": {
+                    "type": "string"
+                },
+                "callback_url": {
+                    "type": "string"
+                },
+                "completed_pages": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "mime_type": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/github_com_moasq_backend_pkg_ocr_domain.OCRResponse"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_moasq_backend_pkg_ocr_domain.JobStatus"
+                },
+                "total_pages": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_pkg_ocr_domain.JobStatus": {
+            "type": "string",
+            "enum": [
+                "queued",
+                "running",
+                "succeeded",
+                "failed",
+                "canceled"
+            ],
+            "x-enum-varnames": [
+                "JobStatusQueued",
+                "JobStatusRunning",
+                "JobStatusSucceeded",
+                "JobStatusFailed",
+                "JobStatusCanceled"
+            ]
+        },
+        "github_com_moasq_backend_pkg_ocr_domain.OCRResponse": {
+            "type": "object",
+            "properties": {
+                "confidence": {
+                    "description": "OCR confidence score (0.0 to 1.0)",
+                    "type": "number"
+                },
+                "extractor": {
+                    "description": "Extractor that produced the text, e.g. \"pdf_text\" or \"mistral\"",
+                    "type": "string"
+                },
+                "page_texts": {
+                    "description": "Per-page text and layout, in page order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_pkg_ocr_domain.PageText"
+                    }
+                },
+                "pages": {
+                    "description": "Number of pages processed",
+                    "type": "integer"
+                },
+                "text": {
+                    "description": "Extracted text",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_moasq_backend_pkg_ocr_domain.PageText": {
+            "type": "object",
+            "properties": {
+                "blocks": {
+                    "description": "Positioned regions of the page",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_pkg_ocr_domain.Block"
+                    }
+                },
+                "extractor": {
+                    "description": "Extractor that produced the page text",
+                    "type": "string"
+                },
+                "height": {
+                    "description": "Page height in the same unit",
+                    "type": "number"
+                },
+                "number": {
+                    "description": "1-based page number",
+                    "type": "integer"
+                },
+                "tables": {
+                    "description": "Tables found on the page",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_moasq_backend_pkg_ocr_domain.Table"
+                    }
+                },
+                "text": {
+                    "description": "Extracted text of the page",
+                    "type": "string"
+                },
+                "width": {
+                    "description": "Page width in the extractor's unit (points or pixels), 0 when unknown",
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_moasq_backend_pkg_ocr_domain.Table": {
+            "type": "object",
+            "properties": {
+                "bbox": {
+                    "description": "Position on the page, when known",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_moasq_backend_pkg_ocr_domain.BoundingBox"
+                        }
+                    ]
+                },
+                "rows": {
+                    "description": "Cell text, header row first",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -2,10 +2,12 @@ package cmd
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/dig"
 
 	api "github.com/moasq/backend/api/cmd"
+	audit "github.com/moasq/backend/app/audit/cmd"
 	cognitive "github.com/moasq/backend/app/example_cognitive/cmd"
 	documents "github.com/moasq/backend/app/example_documents/cmd"
 	organizations "github.com/moasq/backend/app/organizations/cmd"
@@ -17,14 +19,20 @@ import (
 	db "github.com/moasq/backend/pkg/db/cmd"
 	eventbus "github.com/moasq/backend/pkg/eventbus/cmd"
 	file_manager "github.com/moasq/backend/pkg/file_manager/cmd"
+	health "github.com/moasq/backend/pkg/health/cmd"
+	lifecycle "github.com/moasq/backend/pkg/lifecycle/cmd"
 	llm "github.com/moasq/backend/pkg/llm/cmd"
+	metrics "github.com/moasq/backend/pkg/metrics/cmd"
 	logger "github.com/moasq/backend/pkg/logger/cmd"
 	ocr "github.com/moasq/backend/pkg/ocr/cmd"
 	polar "github.com/moasq/backend/pkg/polar/cmd"
 	redisCmd "github.com/moasq/backend/pkg/redis/cmd"
 	stytchCmd "github.com/moasq/backend/pkg/stytch/cmd"
+	telemetry "github.com/moasq/backend/pkg/telemetry/cmd"
 	paywall "github.com/moasq/backend/pkg/paywall"
 	server "github.com/moasq/backend/server/cmd"
+	"github.com/moasq/backend/server/idempotency"
+	"github.com/moasq/backend/server/ratelimit"
 )
 
 // orgLookupAdapter adapts orgDomain.OrganizationRepository to auth.OrganizationLookup
@@ -42,12 +50,36 @@ type accLookupAdapter struct {
 }
 
 func (a *accLookupAdapter) GetByEmail(ctx context.Context, orgID int32, email string) (auth.AccountEntity, error) {
-	return a.repo.GetByEmail(ctx, orgID, email)
+	account, err := a.repo.GetByEmail(ctx, orgID, email)
+	if errors.Is(err, orgDomain.ErrAccountNotFound) {
+		return nil, auth.ErrAccountNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return account, nil
 }
 
 func InitMods(container *dig.Container) {
 
 	// pkg
+	// Lifecycle first so every module can register start/stop hooks
+	if err := lifecycle.Init(container); err != nil {
+		panic(err)
+	}
+
+	// Telemetry next so the tracer provider is installed before anything is traced
+	if err := telemetry.Init(container); err != nil {
+		panic(err)
+	}
+	// Metrics label policy must be set before anything records a metric
+	if err := metrics.Init(container); err != nil {
+		panic(err)
+	}
+	// Health registry must be provided before modules that register checks
+	if err := health.Init(container); err != nil {
+		panic(err)
+	}
 	server.Init(container)
 	logger.Init(container)
 	db.Init(container)
@@ -85,6 +117,11 @@ func InitMods(container *dig.Container) {
 	docs.Init(container)
 
 	// app
+	// Audit log must be initialized first (other modules record through domain.Recorder)
+	if err := audit.Init(container); err != nil {
+		panic(err)
+	}
+
 	if err := organizations.Init(container); err != nil {
 		panic(err)
 	}
@@ -124,6 +161,22 @@ func InitMods(container *dig.Container) {
 		panic(err)
 	}
 
+	// Distributed rate limiting (Redis buckets per organization, scaled by plan)
+	if err := ratelimit.SetupMiddleware(container); err != nil {
+		panic(err)
+	}
+	if err := ratelimit.RegisterNamedMiddlewares(container); err != nil {
+		panic(err)
+	}
+
+	// Idempotency-Key replay for mutating endpoints (Redis)
+	if err := idempotency.SetupMiddleware(container); err != nil {
+		panic(err)
+	}
+	if err := idempotency.RegisterNamedMiddlewares(container); err != nil {
+		panic(err)
+	}
+
 	// OCR service (Mistral API for document text extraction)
 	// Must be initialized before documents module (documents depends on OCR)
 	if err := ocr.Init(container); err != nil {
@@ -1,31 +1,75 @@
 package cmd
 
 import (
+	"context"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/joho/godotenv"
 	"go.uber.org/dig"
 
+	"github.com/moasq/backend/pkg/lifecycle"
 	server "github.com/moasq/backend/server/domain"
 )
 
+// Process exit codes
+const (
+	exitOK      = 0
+	exitFailure = 1 // startup or serve failure
+	exitUnclean = 2 // shutdown abandoned work or a stop hook failed
+)
+
 func Execute() {
+	os.Exit(run())
+}
+
+func run() (code int) {
 	if err := godotenv.Load("app.env"); err != nil {
 		log.Printf("Warning: Error loading app.env file: %v", err)
 	}
 
 	container := dig.New()
 
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Startup failed: %v", r)
+			code = exitFailure
+		}
+	}()
 	InitMods(container)
 
 	var srv server.Server
-
-	if err := container.Invoke(func(s server.Server) {
+	var manager *lifecycle.Manager
+	if err := container.Invoke(func(s server.Server, m *lifecycle.Manager) {
 		srv = s
+		manager = m
 	}); err != nil {
-		panic(err)
+		log.Printf("Startup failed: %v", err)
+		return exitFailure
 	}
 
-	srv.Start()
+	if err := manager.Start(); err != nil {
+		log.Printf("Startup failed: %v", err)
+		return exitFailure
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
+	// The HTTP server stops first so no new work arrives while hooks drain
+	code = exitOK
+	if err := srv.Run(ctx); err != nil {
+		log.Printf("Server failed: %v", err)
+		code = exitFailure
+	}
+
+	if err := manager.Stop(); err != nil {
+		log.Printf("Unclean shutdown: %v", err)
+		if code == exitOK {
+			code = exitUnclean
+		}
+	}
+	return code
 }
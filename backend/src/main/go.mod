@@ -20,6 +20,10 @@ require (
 	github.com/moasq/backend/pkg/polar v0.0.0
 	github.com/moasq/backend/pkg/redis v0.0.0
 	github.com/moasq/backend/pkg/stytch v0.0.0
+	github.com/moasq/backend/pkg/telemetry v0.0.0
+	github.com/moasq/backend/pkg/metrics v0.0.0
+	github.com/moasq/backend/pkg/health v0.0.0
+	github.com/moasq/backend/pkg/lifecycle v0.0.0
 	github.com/moasq/backend/server v0.0.0
 	go.uber.org/dig v1.19.0
 )
@@ -160,3 +164,11 @@ replace github.com/moasq/backend/pkg/stytch => ../pkg/stytch
 replace github.com/moasq/backend/pkg/redis => ../pkg/redis
 
 replace github.com/moasq/backend/pkg/polar => ../pkg/polar
+
+replace github.com/moasq/backend/pkg/telemetry => ../pkg/telemetry
+
+replace github.com/moasq/backend/pkg/metrics => ../pkg/metrics
+
+replace github.com/moasq/backend/pkg/health => ../pkg/health
+
+replace github.com/moasq/backend/pkg/lifecycle => ../pkg/lifecycle
@@ -0,0 +1,293 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+)
+
+// Relations a caller can hold with a resource instance.
+const (
+	// RelationOwner means the caller created the resource.
+	RelationOwner = "owner"
+	// RelationAssignee means the resource's approval is assigned to the caller.
+	RelationAssignee = "assignee"
+)
+
+// Reasons reported by AccessChecker when a check is denied.
+const (
+	ReasonMissingPermission    = "missing_permission"
+	ReasonUnknownRole          = "unknown_role"
+	ReasonUnknownResourceType  = "unknown_resource_type"
+	ReasonResourceNotFound     = "resource_not_found"
+	ReasonResourceLookupFailed = "resource_lookup_failed"
+	ReasonResourceIDRequired   = "resource_id_required"
+	ReasonNotOwner             = "not_owner"
+	ReasonNotAssignee          = "not_assignee"
+)
+
+// ResourceAttributes describes a loaded resource instance for authorization.
+//
+// Loaders fill in the attributes their resource type has; ownership and
+// approval assignment are optional.
+type ResourceAttributes struct {
+	Type                 string `json:"type"`
+	ID                   string `json:"id"`
+	OrganizationID       int32  `json:"organization_id"`
+	CreatedByAccountID   *int32 `json:"created_by_account_id,omitempty"`
+	ApprovalAssignedToID *int32 `json:"approval_assigned_to_id,omitempty"`
+}
+
+// IsOwnedBy reports whether accountID created the resource.
+func (a *ResourceAttributes) IsOwnedBy(accountID int32) bool {
+	return a.CreatedByAccountID != nil && *a.CreatedByAccountID == accountID
+}
+
+// IsAssignedTo reports whether the resource's approval is assigned to accountID.
+func (a *ResourceAttributes) IsAssignedTo(accountID int32) bool {
+	return a.ApprovalAssignedToID != nil && *a.ApprovalAssignedToID == accountID
+}
+
+// RelationsFor returns the relations accountID holds with the resource.
+func (a *ResourceAttributes) RelationsFor(accountID int32) []string {
+	var relations []string
+	if a.IsOwnedBy(accountID) {
+		relations = append(relations, RelationOwner)
+	}
+	if a.IsAssignedTo(accountID) {
+		relations = append(relations, RelationAssignee)
+	}
+	return relations
+}
+
+// ResourceLoader loads the authorization attributes of a resource instance.
+//
+// Implementations must scope the lookup to orgID and return
+// ErrResourceNotFound when the resource does not exist in that organization.
+type ResourceLoader interface {
+	LoadResource(ctx context.Context, orgID int32, resourceID string) (*ResourceAttributes, error)
+}
+
+// ResourceLoaderFunc adapts a function to the ResourceLoader interface.
+type ResourceLoaderFunc func(ctx context.Context, orgID int32, resourceID string) (*ResourceAttributes, error)
+
+// LoadResource calls f.
+func (f ResourceLoaderFunc) LoadResource(ctx context.Context, orgID int32, resourceID string) (*ResourceAttributes, error) {
+	return f(ctx, orgID, resourceID)
+}
+
+// ResourceRegistry maps resource types to their loaders.
+//
+// App modules register a loader for each resource type that supports
+// instance-level checks:
+//
+//	registry.Register("invoice", auth.ResourceLoaderFunc(invoiceService.LoadAuthAttributes))
+type ResourceRegistry struct {
+	mu      sync.RWMutex
+	loaders map[string]ResourceLoader
+}
+
+// NewResourceRegistry creates an empty registry.
+func NewResourceRegistry() *ResourceRegistry {
+	return &ResourceRegistry{loaders: make(map[string]ResourceLoader)}
+}
+
+// Register sets the loader for resourceType, replacing any previous one.
+func (r *ResourceRegistry) Register(resourceType string, loader ResourceLoader) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.loaders[resourceType] = loader
+}
+
+// Types returns the registered resource types in sorted order.
+func (r *ResourceRegistry) Types() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]string, 0, len(r.loaders))
+	for t := range r.loaders {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Load resolves the attributes of a resource instance within orgID.
+func (r *ResourceRegistry) Load(ctx context.Context, resourceType string, orgID int32, resourceID string) (*ResourceAttributes, error) {
+	r.mu.RLock()
+	loader, ok := r.loaders[resourceType]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownResourceType
+	}
+
+	attrs, err := loader.LoadResource(ctx, orgID, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if attrs == nil || attrs.OrganizationID != orgID {
+		return nil, ErrResourceNotFound
+	}
+	if attrs.Type == "" {
+		attrs.Type = resourceType
+	}
+	if attrs.ID == "" {
+		attrs.ID = resourceID
+	}
+	return attrs, nil
+}
+
+// EffectivePermissions returns every catalog permission the identity holds,
+// with wildcards and role-derived permissions expanded, followed by any
+// explicit non-catalog permissions from the token.
+func EffectivePermissions(identity *Identity) []Permission {
+	if identity == nil {
+		return []Permission{}
+	}
+
+	result := make([]Permission, 0, len(AllPermissions))
+	seen := make(map[Permission]struct{}, len(AllPermissions))
+	for _, perm := range AllPermissions {
+		if hasPermission(identity, perm.Resource(), perm.Action()) {
+			result = append(result, perm)
+			seen[perm] = struct{}{}
+		}
+	}
+
+	for _, perm := range identity.Permissions {
+		if _, ok := seen[perm]; ok || perm.Resource() == "*" || perm.Action() == "*" {
+			continue
+		}
+		result = append(result, perm)
+		seen[perm] = struct{}{}
+	}
+
+	return result
+}
+
+// AccessChecker evaluates permission checks for the current caller,
+// including resource-instance checks such as ownership or approval
+// assignment.
+//
+// When a permission targets an instance of its own resource type
+// (e.g. "resource:approve" on resource 42), the PolicyEngine decides,
+// so deny reasons match what the API would enforce.
+type AccessChecker struct {
+	resources *ResourceRegistry
+	policies  *PolicyEngine
+}
+
+// NewAccessChecker creates a checker backed by the given resource registry and policy engine.
+func NewAccessChecker(resources *ResourceRegistry, policies *PolicyEngine) *AccessChecker {
+	return &AccessChecker{resources: resources, policies: policies}
+}
+
+// Check evaluates a single permission check for the caller.
+func (c *AccessChecker) Check(ctx context.Context, reqCtx *RequestContext, req PermissionCheckRequest) PermissionCheckResponse {
+	return c.CheckAll(ctx, reqCtx, []PermissionCheckRequest{req})[0]
+}
+
+// CheckAll evaluates checks in order. Each resource instance is loaded at
+// most once per call, so checking several actions on the same resource is cheap.
+func (c *AccessChecker) CheckAll(ctx context.Context, reqCtx *RequestContext, reqs []PermissionCheckRequest) []PermissionCheckResponse {
+	type loaded struct {
+		attrs *ResourceAttributes
+		err   error
+	}
+	cache := make(map[[2]string]loaded)
+
+	results := make([]PermissionCheckResponse, len(reqs))
+	for i, req := range reqs {
+		result := PermissionCheckResponse{
+			RoleID:       req.RoleID,
+			PermissionID: req.PermissionID,
+			ResourceType: req.ResourceType,
+			ResourceID:   req.ResourceID,
+		}
+
+		if reason := c.checkPermission(reqCtx, req); reason != "" {
+			result.Reason = reason
+			results[i] = result
+			continue
+		}
+
+		if req.ResourceType == "" {
+			result.HasPermission = true
+			results[i] = result
+			continue
+		}
+		if req.ResourceID == "" {
+			result.Reason = ReasonResourceIDRequired
+			results[i] = result
+			continue
+		}
+
+		key := [2]string{req.ResourceType, req.ResourceID}
+		entry, ok := cache[key]
+		if !ok {
+			entry.attrs, entry.err = c.resources.Load(ctx, req.ResourceType, reqCtx.OrganizationID, req.ResourceID)
+			cache[key] = entry
+		}
+		if entry.err != nil {
+			result.Reason = lookupReason(entry.err)
+			results[i] = result
+			continue
+		}
+
+		result.Relations = entry.attrs.RelationsFor(reqCtx.AccountID)
+		if perm := Permission(req.PermissionID); req.RoleID == "" && perm.Resource() == c.policies.PermissionResource(entry.attrs.Type) {
+			if decision := c.policies.Evaluate(ctx, reqCtx, perm.Action(), entry.attrs); !decision.Allowed {
+				result.Reason = decision.Reason
+				results[i] = result
+				continue
+			}
+		}
+		switch req.Relation {
+		case RelationOwner:
+			if !entry.attrs.IsOwnedBy(reqCtx.AccountID) {
+				result.Reason = ReasonNotOwner
+			}
+		case RelationAssignee:
+			if !entry.attrs.IsAssignedTo(reqCtx.AccountID) {
+				result.Reason = ReasonNotAssignee
+			}
+		}
+		result.HasPermission = result.Reason == ""
+		results[i] = result
+	}
+
+	return results
+}
+
+// checkPermission returns a deny reason, or "" when the permission is held.
+// With RoleID set the role is evaluated instead of the caller's identity.
+func (c *AccessChecker) checkPermission(reqCtx *RequestContext, req PermissionCheckRequest) string {
+	perm := Permission(req.PermissionID)
+
+	if req.RoleID != "" {
+		if GetRoleInfo(req.RoleID) == nil {
+			return ReasonUnknownRole
+		}
+		if !HasPermission(req.RoleID, perm) {
+			return ReasonMissingPermission
+		}
+		return ""
+	}
+
+	if reqCtx == nil || reqCtx.Identity == nil || !hasPermission(reqCtx.Identity, perm.Resource(), perm.Action()) {
+		return ReasonMissingPermission
+	}
+	return ""
+}
+
+func lookupReason(err error) string {
+	switch {
+	case errors.Is(err, ErrUnknownResourceType):
+		return ReasonUnknownResourceType
+	case errors.Is(err, ErrResourceNotFound):
+		return ReasonResourceNotFound
+	default:
+		return ReasonResourceLookupFailed
+	}
+}
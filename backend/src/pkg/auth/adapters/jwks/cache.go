@@ -0,0 +1,352 @@
+// Package jwks provides a Redis-backed JSON Web Key Set cache shared by
+// the JWT-based auth adapters (Stytch, generic OIDC).
+//
+// Public keys are cached individually by key ID (kid). When a token is
+// signed with a kid that is not cached, the JWKS endpoint is fetched
+// again and every key in it is cached, so a provider key rotation is
+// picked up on the first token signed with the new key. Key IDs that are
+// still missing after a refetch are remembered for a short window, so
+// replaying a token with a bogus key ID cannot hammer the endpoint.
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/moasq/backend/pkg/logger"
+	"github.com/moasq/backend/pkg/redis"
+)
+
+const (
+	// DefaultTTL is how long individual keys stay cached in Redis.
+	DefaultTTL = 24 * time.Hour
+	// DefaultMinRefreshInterval is how long a key ID missing from the JWKS is
+	// remembered before it may trigger another refetch.
+	DefaultMinRefreshInterval = 30 * time.Second
+)
+
+// JWKS represents a JSON Web Key Set document.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK represents a single JSON Web Key. RSA and EC keys are supported.
+type JWK struct {
+	Kid string `json:"kid"`           // Key ID
+	Kty string `json:"kty"`           // Key type (RSA, EC)
+	Alg string `json:"alg,omitempty"` // Algorithm (RS256, ES256, ...)
+	Use string `json:"use,omitempty"` // Public key use (sig)
+	N   string `json:"n,omitempty"`   // RSA modulus (base64url)
+	E   string `json:"e,omitempty"`   // RSA exponent (base64url)
+	Crv string `json:"crv,omitempty"` // EC curve (P-256, P-384, P-521)
+	X   string `json:"x,omitempty"`   // EC x coordinate (base64url)
+	Y   string `json:"y,omitempty"`   // EC y coordinate (base64url)
+}
+
+// Cache fetches a JWKS endpoint and caches its public keys in Redis.
+type Cache struct {
+	jwksURL            string
+	keyPattern         string
+	ttl                time.Duration
+	minRefreshInterval time.Duration
+	redis              redis.Client
+	logger             logger.Logger
+	httpClient         *http.Client
+
+	mu     sync.Mutex
+	misses map[string]time.Time
+}
+
+// maxRememberedMisses bounds the missing key ID memory.
+const maxRememberedMisses = 1024
+
+// Option customizes a Cache.
+type Option func(*Cache)
+
+// WithTTL overrides how long keys stay cached.
+func WithTTL(ttl time.Duration) Option {
+	return func(c *Cache) {
+		if ttl > 0 {
+			c.ttl = ttl
+		}
+	}
+}
+
+// WithMinRefreshInterval overrides how long missing key IDs are remembered.
+func WithMinRefreshInterval(interval time.Duration) Option {
+	return func(c *Cache) {
+		c.minRefreshInterval = interval
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used to fetch the JWKS.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Cache) {
+		if client != nil {
+			c.httpClient = client
+		}
+	}
+}
+
+// NewCache creates a cache for jwksURL. keyPattern is a fmt pattern with a single
+// %s for the key ID, e.g. "auth:oidc:jwks:key:%s", so providers never share keys.
+func NewCache(jwksURL, keyPattern string, redisClient redis.Client, log logger.Logger, opts ...Option) *Cache {
+	c := &Cache{
+		jwksURL:            jwksURL,
+		keyPattern:         keyPattern,
+		ttl:                DefaultTTL,
+		minRefreshInterval: DefaultMinRefreshInterval,
+		redis:              redisClient,
+		logger:             log,
+		misses:             make(map[string]time.Time),
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// GetPublicKey returns the public key for kid from Redis, refetching the JWKS on a miss.
+func (c *Cache) GetPublicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	cacheKey := fmt.Sprintf(c.keyPattern, kid)
+	cached, err := c.redis.Get(ctx, cacheKey)
+	if err == nil && cached != "" {
+		var jwk JWK
+		if err := json.Unmarshal([]byte(cached), &jwk); err == nil {
+			key, err := ToPublicKey(&jwk)
+			if err == nil {
+				c.logger.Debug("public key fetched from Redis cache", logger.Fields{
+					"kid": kid,
+				})
+				return key, nil
+			}
+			c.logger.Warn("failed to deserialize cached public key", logger.Fields{
+				"kid":   kid,
+				"error": err.Error(),
+			})
+		}
+	}
+
+	if c.recentlyMissed(kid) {
+		return nil, fmt.Errorf("key with ID %s not found in JWKS", kid)
+	}
+
+	jwks, err := c.refresh(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+
+	for i := range jwks.Keys {
+		if jwks.Keys[i].Kid == kid {
+			return ToPublicKey(&jwks.Keys[i])
+		}
+	}
+
+	c.rememberMiss(kid)
+
+	availableKids := make([]string, 0, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		availableKids = append(availableKids, jwk.Kid)
+	}
+	c.logger.Error("key not found in JWKS", logger.Fields{
+		"kid":            kid,
+		"available_kids": availableKids,
+	})
+
+	return nil, fmt.Errorf("key with ID %s not found in JWKS", kid)
+}
+
+// refresh fetches the JWKS and caches every key in it.
+func (c *Cache) refresh(ctx context.Context) (*JWKS, error) {
+	c.logger.Info("fetching JWKS", logger.Fields{
+		"jwks_url": c.jwksURL,
+	})
+
+	jwks, err := c.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range jwks.Keys {
+		c.store(ctx, &jwks.Keys[i])
+	}
+
+	return jwks, nil
+}
+
+// recentlyMissed reports whether kid was absent from a JWKS fetched within
+// the refresh interval.
+func (c *Cache) recentlyMissed(kid string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	missedAt, ok := c.misses[kid]
+	if !ok {
+		return false
+	}
+	if time.Since(missedAt) >= c.minRefreshInterval {
+		delete(c.misses, kid)
+		return false
+	}
+	return true
+}
+
+func (c *Cache) rememberMiss(kid string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.misses) >= maxRememberedMisses {
+		c.misses = make(map[string]time.Time)
+	}
+	c.misses[kid] = time.Now()
+}
+
+func (c *Cache) fetch(ctx context.Context) (*JWKS, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.jwksURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("JWKS HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS endpoint returned status %d", resp.StatusCode)
+	}
+
+	var jwks JWKS
+	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS JSON: %w", err)
+	}
+
+	c.logger.Debug("successfully fetched JWKS", logger.Fields{
+		"keys_count": len(jwks.Keys),
+	})
+
+	return &jwks, nil
+}
+
+// store caches a single key in Redis. Keys that cannot be parsed are skipped.
+func (c *Cache) store(ctx context.Context, jwk *JWK) {
+	if jwk.Kid == "" {
+		return
+	}
+	if _, err := ToPublicKey(jwk); err != nil {
+		c.logger.Debug("skipping unsupported JWK", logger.Fields{
+			"kid":   jwk.Kid,
+			"kty":   jwk.Kty,
+			"error": err.Error(),
+		})
+		return
+	}
+
+	data, err := json.Marshal(jwk)
+	if err != nil {
+		return
+	}
+
+	cacheKey := fmt.Sprintf(c.keyPattern, jwk.Kid)
+	if err := c.redis.Set(ctx, cacheKey, string(data), c.ttl); err != nil {
+		c.logger.Warn("failed to cache public key in Redis", logger.Fields{
+			"kid":   jwk.Kid,
+			"error": err.Error(),
+		})
+	}
+}
+
+// ToPublicKey converts a JWK to an *rsa.PublicKey or *ecdsa.PublicKey.
+func ToPublicKey(jwk *JWK) (crypto.PublicKey, error) {
+	kty := jwk.Kty
+	if kty == "" && jwk.N != "" {
+		// Entries cached before key types were recorded only held RSA components.
+		kty = "RSA"
+	}
+
+	switch kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode modulus: %w", err)
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode exponent: %w", err)
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported EC curve %q", jwk.Crv)
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode x coordinate: %w", err)
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode y coordinate: %w", err)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", jwk.Kty)
+	}
+}
+
+// FromPublicKey converts an RSA or EC public key to a JWK with the given key ID.
+func FromPublicKey(kid, alg string, key crypto.PublicKey) (JWK, error) {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kid: kid,
+			Kty: "RSA",
+			Alg: alg,
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		return JWK{
+			Kid: kid,
+			Kty: "EC",
+			Alg: alg,
+			Use: "sig",
+			Crv: k.Curve.Params().Name,
+			X:   base64.RawURLEncoding.EncodeToString(k.X.FillBytes(make([]byte, size))),
+			Y:   base64.RawURLEncoding.EncodeToString(k.Y.FillBytes(make([]byte, size))),
+		}, nil
+	default:
+		return JWK{}, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
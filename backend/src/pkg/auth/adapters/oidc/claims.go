@@ -0,0 +1,130 @@
+package oidc
+
+import (
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/moasq/backend/pkg/auth"
+)
+
+// claimMapper reads identity fields from token claims using the configured claim names.
+type claimMapper struct {
+	cfg    *Config
+	claims jwt.MapClaims
+}
+
+func (m claimMapper) subject() string {
+	sub, _ := m.claims.GetSubject()
+	return sub
+}
+
+// sessionID returns the OIDC session ID (sid), used for per-session revocation.
+func (m claimMapper) sessionID() string {
+	sid, _ := m.claims["sid"].(string)
+	return sid
+}
+
+func (m claimMapper) email() string {
+	email, _ := lookupClaim(m.claims, m.cfg.EmailClaim).(string)
+	return strings.TrimSpace(email)
+}
+
+func (m claimMapper) emailVerified() bool {
+	switch v := lookupClaim(m.claims, m.cfg.EmailVerifiedClaim).(type) {
+	case bool:
+		return v
+	case string:
+		// Some providers (e.g. Cognito) encode the flag as a string.
+		return strings.EqualFold(v, "true")
+	default:
+		return false
+	}
+}
+
+func (m claimMapper) organizationID() string {
+	switch v := lookupClaim(m.claims, m.cfg.OrgIDClaim).(type) {
+	case string:
+		return v
+	case []any:
+		// Organization membership lists resolve to the first entry.
+		if len(v) > 0 {
+			if s, ok := v[0].(string); ok {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+func (m claimMapper) roles() []string {
+	switch v := lookupClaim(m.claims, m.cfg.RolesClaim).(type) {
+	case string:
+		return strings.Fields(strings.ReplaceAll(v, ",", " "))
+	case []any:
+		roles := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				roles = append(roles, s)
+			}
+		}
+		return roles
+	default:
+		return nil
+	}
+}
+
+// authMethods maps the amr claim (RFC 8176) to auth.AuthMethod* values.
+// The second return value reports whether the IdP asserted multi-factor authentication.
+func (m claimMapper) authMethods() ([]string, bool) {
+	values, _ := m.claims["amr"].([]any)
+	methods := make([]string, 0, len(values))
+	mfa := false
+	for _, item := range values {
+		value, _ := item.(string)
+		var method string
+		switch value {
+		case "mfa":
+			mfa = true
+		case "pwd":
+			method = auth.AuthMethodPassword
+		case "otp":
+			method = auth.AuthMethodTOTP
+		case "sms":
+			method = auth.AuthMethodSMSOTP
+		case "fed":
+			method = auth.AuthMethodSSO
+		}
+		if method == "" {
+			continue
+		}
+		methods = append(methods, method)
+		if auth.IsSecondFactor(method) {
+			mfa = true
+		}
+	}
+	return methods, mfa
+}
+
+// lookupClaim returns the claim stored under name. An exact key match wins so
+// URL-style claim names containing dots work; otherwise name is treated as a
+// dotted path into nested objects.
+func lookupClaim(claims map[string]any, name string) any {
+	if name == "" {
+		return nil
+	}
+	if v, ok := claims[name]; ok {
+		return v
+	}
+
+	var current any = claims
+	for _, part := range strings.Split(name, ".") {
+		obj, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		if current, ok = obj[part]; !ok {
+			return nil
+		}
+	}
+	return current
+}
@@ -0,0 +1,59 @@
+// Command testissuer runs a local OIDC issuer for development.
+//
+// It prints the OIDC_* settings that point the backend at it plus a signed
+// token for the requested identity, then serves discovery and JWKS until
+// interrupted:
+//
+//	go run ./adapters/oidc/cmd/testissuer -email dev@example.com -org org-1 -roles admin
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/moasq/backend/pkg/auth/adapters/oidc"
+)
+
+func main() {
+	audience := flag.String("audience", "backend-local", "audience (aud) for issued tokens")
+	subject := flag.String("sub", "local-user", "subject (sub) for the issued token")
+	email := flag.String("email", "dev@example.com", "email for the issued token")
+	orgID := flag.String("org", "local-org", "organization ID for the issued token")
+	roles := flag.String("roles", "admin", "comma-separated roles for the issued token")
+	flag.Parse()
+
+	issuer, err := oidc.NewTestIssuer(*audience)
+	if err != nil {
+		log.Fatalf("failed to start test issuer: %v", err)
+	}
+	defer issuer.Close()
+
+	token, err := issuer.IssueToken(map[string]any{
+		"sub":            *subject,
+		"email":          *email,
+		"email_verified": true,
+		"org_id":         *orgID,
+		"roles":          strings.Split(*roles, ","),
+	})
+	if err != nil {
+		log.Fatalf("failed to issue token: %v", err)
+	}
+
+	fmt.Println("# Backend settings")
+	fmt.Println("AUTH_PROVIDER=oidc")
+	fmt.Printf("OIDC_ISSUER=%s\n", issuer.URL())
+	fmt.Printf("OIDC_AUDIENCE=%s\n", *audience)
+	fmt.Printf("OIDC_JWKS_URL=%s\n", issuer.JWKSURL())
+	fmt.Println()
+	fmt.Println("# Bearer token (valid for 1 hour)")
+	fmt.Println(token)
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+}
@@ -0,0 +1,176 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Config captures the runtime configuration for a generic OIDC provider.
+//
+// All configuration values can be set via environment variables with the
+// OIDC_ prefix (e.g., OIDC_ISSUER, OIDC_AUDIENCE).
+type Config struct {
+	// Issuer is the expected "iss" claim and discovery base URL (required)
+	Issuer string `mapstructure:"OIDC_ISSUER"`
+
+	// Audience is the expected "aud" claim (required)
+	Audience string `mapstructure:"OIDC_AUDIENCE"`
+
+	// JWKSURL is the JWKS endpoint URL (resolved via discovery if not set)
+	JWKSURL string `mapstructure:"OIDC_JWKS_URL"`
+
+	// OrgIDClaim is the claim holding the organization ID. Dotted paths
+	// address nested objects (e.g. "org.id").
+	OrgIDClaim string `mapstructure:"OIDC_ORG_ID_CLAIM"`
+
+	// RolesClaim is the claim holding the role list (e.g. "realm_access.roles")
+	RolesClaim string `mapstructure:"OIDC_ROLES_CLAIM"`
+
+	// EmailClaim is the claim holding the user's email
+	EmailClaim string `mapstructure:"OIDC_EMAIL_CLAIM"`
+
+	// EmailVerifiedClaim is the claim holding the email verification flag
+	EmailVerifiedClaim string `mapstructure:"OIDC_EMAIL_VERIFIED_CLAIM"`
+
+	// RequireEmailVerified rejects tokens whose email is not verified
+	RequireEmailVerified bool `mapstructure:"OIDC_REQUIRE_EMAIL_VERIFIED"`
+
+	// AllowedAlgorithms lists accepted JWT signing algorithms (comma separated)
+	AllowedAlgorithms string `mapstructure:"OIDC_ALLOWED_ALGORITHMS"`
+
+	// ClockSkew is the leeway applied to exp/nbf/iat checks
+	ClockSkew time.Duration `mapstructure:"OIDC_CLOCK_SKEW"`
+
+	// HTTPTimeout is the timeout for discovery and JWKS requests
+	HTTPTimeout time.Duration `mapstructure:"OIDC_HTTP_TIMEOUT"`
+}
+
+// LoadConfig loads the OIDC configuration from environment variables and app.env file.
+//
+// Configuration priority:
+//  1. Environment variables (highest)
+//  2. app.env file
+//  3. Default values (lowest)
+func LoadConfig() (*Config, error) {
+	v := viper.New()
+	v.SetConfigName("app")
+	v.SetConfigType("env")
+	v.AddConfigPath(".")
+	v.AutomaticEnv()
+
+	// Set defaults
+	v.SetDefault("OIDC_ORG_ID_CLAIM", "org_id")
+	v.SetDefault("OIDC_ROLES_CLAIM", "roles")
+	v.SetDefault("OIDC_EMAIL_CLAIM", "email")
+	v.SetDefault("OIDC_EMAIL_VERIFIED_CLAIM", "email_verified")
+	v.SetDefault("OIDC_REQUIRE_EMAIL_VERIFIED", true)
+	v.SetDefault("OIDC_ALLOWED_ALGORITHMS", "RS256")
+	v.SetDefault("OIDC_CLOCK_SKEW", "30s")
+	v.SetDefault("OIDC_HTTP_TIMEOUT", "10s")
+
+	// Try to read config file (ignore if not found)
+	if err := v.ReadInConfig(); err != nil {
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+			return nil, fmt.Errorf("failed to read config: %w", err)
+		}
+	}
+
+	// Bind keys so AutomaticEnv values without defaults are picked up by Unmarshal
+	for _, key := range []string{"OIDC_ISSUER", "OIDC_AUDIENCE", "OIDC_JWKS_URL"} {
+		_ = v.BindEnv(key)
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("unable to decode oidc config: %w", err)
+	}
+
+	cfg.Issuer = strings.TrimSpace(cfg.Issuer)
+	cfg.Audience = strings.TrimSpace(cfg.Audience)
+	cfg.JWKSURL = strings.TrimSpace(cfg.JWKSURL)
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if cfg.HTTPTimeout <= 0 {
+		cfg.HTTPTimeout = 10 * time.Second
+	}
+
+	return &cfg, nil
+}
+
+// Validate checks that the configuration has all required fields.
+func (c *Config) Validate() error {
+	if c.Issuer == "" {
+		return fmt.Errorf("oidc configuration invalid: OIDC_ISSUER is required")
+	}
+	if c.Audience == "" {
+		return fmt.Errorf("oidc configuration invalid: OIDC_AUDIENCE is required")
+	}
+	if len(c.Algorithms()) == 0 {
+		return fmt.Errorf("oidc configuration invalid: OIDC_ALLOWED_ALGORITHMS is empty")
+	}
+	return nil
+}
+
+// Algorithms returns the allowed signing algorithms as a slice.
+func (c *Config) Algorithms() []string {
+	var algs []string
+	for _, alg := range strings.Split(c.AllowedAlgorithms, ",") {
+		if alg = strings.TrimSpace(alg); alg != "" {
+			algs = append(algs, alg)
+		}
+	}
+	return algs
+}
+
+// discoveryDocument is the subset of the OpenID provider metadata we need.
+type discoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// ResolveJWKSURL returns the configured JWKS URL, or looks it up from the
+// issuer's /.well-known/openid-configuration document.
+func (c *Config) ResolveJWKSURL(ctx context.Context, httpClient *http.Client) (string, error) {
+	if c.JWKSURL != "" {
+		return c.JWKSURL, nil
+	}
+
+	discoveryURL := strings.TrimSuffix(c.Issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create discovery request: %w", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("discovery request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("discovery endpoint returned status %d", resp.StatusCode)
+	}
+
+	var doc discoveryDocument
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return "", fmt.Errorf("failed to decode discovery document: %w", err)
+	}
+	if doc.Issuer != "" && strings.TrimSuffix(doc.Issuer, "/") != strings.TrimSuffix(c.Issuer, "/") {
+		return "", fmt.Errorf("discovery issuer %q does not match configured issuer %q", doc.Issuer, c.Issuer)
+	}
+	if doc.JWKSURI == "" {
+		return "", fmt.Errorf("discovery document has no jwks_uri")
+	}
+
+	c.JWKSURL = doc.JWKSURI
+	return c.JWKSURL, nil
+}
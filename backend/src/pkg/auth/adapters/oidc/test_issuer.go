@@ -0,0 +1,152 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/moasq/backend/pkg/auth/adapters/jwks"
+)
+
+// TestIssuer is a minimal local OIDC issuer for development and tests.
+//
+// It serves a discovery document and a JWKS over an httptest server and
+// signs tokens with an in-memory RSA key. RotateKey swaps in a new signing
+// key while still publishing the previous one, mirroring how real IdPs roll
+// keys, so rotation handling can be exercised end to end.
+//
+//	issuer, err := oidc.NewTestIssuer("my-api")
+//	defer issuer.Close()
+//
+//	cfg := &oidc.Config{Issuer: issuer.URL(), Audience: "my-api", ...}
+//	token, err := issuer.IssueToken(map[string]any{
+//	    "sub": "user-1", "email": "a@example.com", "email_verified": true,
+//	    "org_id": "org-1", "roles": []string{"admin"},
+//	})
+type TestIssuer struct {
+	server   *httptest.Server
+	audience string
+
+	mu      sync.RWMutex
+	keyID   string
+	key     *rsa.PrivateKey
+	retired []jwks.JWK
+	seq     int
+}
+
+// NewTestIssuer starts a test issuer whose tokens carry the given audience.
+func NewTestIssuer(audience string) (*TestIssuer, error) {
+	issuer := &TestIssuer{audience: audience}
+	if err := issuer.RotateKey(); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", issuer.handleDiscovery)
+	mux.HandleFunc("/.well-known/jwks.json", issuer.handleJWKS)
+	issuer.server = httptest.NewServer(mux)
+
+	return issuer, nil
+}
+
+// URL returns the issuer URL (the expected "iss" claim).
+func (i *TestIssuer) URL() string {
+	return i.server.URL
+}
+
+// JWKSURL returns the URL of the issuer's key set.
+func (i *TestIssuer) JWKSURL() string {
+	return i.server.URL + "/.well-known/jwks.json"
+}
+
+// KeyID returns the ID of the current signing key.
+func (i *TestIssuer) KeyID() string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.keyID
+}
+
+// Close shuts down the underlying HTTP server.
+func (i *TestIssuer) Close() {
+	i.server.Close()
+}
+
+// RotateKey generates a new signing key. The previous key stays published
+// in the JWKS so tokens it signed remain verifiable.
+func (i *TestIssuer) RotateKey() error {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.key != nil {
+		previous, err := jwks.FromPublicKey(i.keyID, jwt.SigningMethodRS256.Alg(), &i.key.PublicKey)
+		if err != nil {
+			return err
+		}
+		i.retired = append(i.retired, previous)
+	}
+
+	i.seq++
+	i.keyID = fmt.Sprintf("test-key-%d", i.seq)
+	i.key = key
+	return nil
+}
+
+// IssueToken signs a token with the current key. Standard claims (iss, aud,
+// iat, exp) are filled in unless present in claims.
+func (i *TestIssuer) IssueToken(claims map[string]any) (string, error) {
+	now := time.Now()
+	mapClaims := jwt.MapClaims{
+		"iss": i.URL(),
+		"aud": i.audience,
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		mapClaims[k] = v
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, mapClaims)
+	token.Header["kid"] = i.keyID
+	return token.SignedString(i.key)
+}
+
+func (i *TestIssuer) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]any{
+		"issuer":                                i.URL(),
+		"jwks_uri":                              i.JWKSURL(),
+		"id_token_signing_alg_values_supported": []string{jwt.SigningMethodRS256.Alg()},
+	})
+}
+
+func (i *TestIssuer) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	current, err := jwks.FromPublicKey(i.keyID, jwt.SigningMethodRS256.Alg(), &i.key.PublicKey)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	keys := append([]jwks.JWK{current}, i.retired...)
+	writeJSON(w, jwks.JWKS{Keys: keys})
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
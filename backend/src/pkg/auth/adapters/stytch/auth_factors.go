@@ -0,0 +1,82 @@
+package stytch
+
+import (
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/stytchauth/stytch-go/v16/stytch/consumer/sessions"
+)
+
+// authMethodFromFactor maps a Stytch authentication factor to an auth.AuthMethod* value.
+// Factors with no equivalent (e.g. impersonated, imported) return "".
+func authMethodFromFactor(factorType, deliveryMethod string) string {
+	switch factorType {
+	case "password":
+		return auth.AuthMethodPassword
+	case "magic_link":
+		return auth.AuthMethodMagicLink
+	case "email_otp":
+		return auth.AuthMethodEmailOTP
+	case "otp":
+		// B2B email OTP is reported as type otp with delivery method email.
+		if deliveryMethod == "email" {
+			return auth.AuthMethodEmailOTP
+		}
+		return auth.AuthMethodSMSOTP
+	case "oauth":
+		return auth.AuthMethodOAuth
+	case "sso":
+		return auth.AuthMethodSSO
+	case "totp":
+		return auth.AuthMethodTOTP
+	case "recovery_codes":
+		return auth.AuthMethodRecoveryCode
+	default:
+		return ""
+	}
+}
+
+// authMethodsFromClaims reads the session's authentication_factors claim.
+func authMethodsFromClaims(sessionObj map[string]any) []string {
+	factors, _ := sessionObj["authentication_factors"].([]any)
+	methods := make([]string, 0, len(factors))
+	for _, factor := range factors {
+		factorMap, ok := factor.(map[string]any)
+		if !ok {
+			continue
+		}
+		factorType, _ := factorMap["type"].(string)
+		deliveryMethod, _ := factorMap["delivery_method"].(string)
+		methods = appendAuthMethod(methods, authMethodFromFactor(factorType, deliveryMethod))
+	}
+	return methods
+}
+
+// authMethodsFromFactors maps the factors of a session returned by the Stytch API.
+func authMethodsFromFactors(factors []sessions.AuthenticationFactor) []string {
+	methods := make([]string, 0, len(factors))
+	for _, factor := range factors {
+		methods = appendAuthMethod(methods, authMethodFromFactor(string(factor.Type), string(factor.DeliveryMethod)))
+	}
+	return methods
+}
+
+// hasSecondFactor reports whether the session completed a second factor.
+func hasSecondFactor(methods []string) bool {
+	for _, method := range methods {
+		if auth.IsSecondFactor(method) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendAuthMethod(methods []string, method string) []string {
+	if method == "" {
+		return methods
+	}
+	for _, m := range methods {
+		if m == method {
+			return methods
+		}
+	}
+	return append(methods, method)
+}
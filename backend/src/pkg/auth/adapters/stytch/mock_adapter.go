@@ -47,6 +47,7 @@ func (m *MockAuthAdapter) VerifyToken(ctx context.Context, token string) (*auth.
 		Permissions: []auth.Permission{
 			auth.NewPermission("*", "*"), // Wildcard permission for development
 		},
+		IssuedAt:  time.Now(),
 		ExpiresAt: time.Now().Add(24 * time.Hour),
 		Raw: map[string]any{
 			"mock":       true,
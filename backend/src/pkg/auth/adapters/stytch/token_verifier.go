@@ -49,11 +49,13 @@ func NewTokenVerifier(
 // internalClaims holds parsed JWT claims before conversion to auth.Identity.
 type internalClaims struct {
 	Subject        string
+	SessionID      string
 	Email          string
 	EmailVerified  bool
 	OrganizationID string
 	Roles          []string
 	Permissions    []auth.Permission
+	AuthMethods    []string
 	IssuedAt       time.Time
 	ExpiresAt      time.Time
 	NotBefore      time.Time
@@ -148,6 +150,10 @@ func (v *TokenVerifier) verifyLocally(ctx context.Context, token string) (*auth.
 		OrganizationID: claims.OrganizationID,
 		Roles:          v.convertRoles(claims.Roles),
 		Permissions:    permissions,
+		SessionID:      claims.SessionID,
+		AuthMethods:    claims.AuthMethods,
+		MFAVerified:    hasSecondFactor(claims.AuthMethods),
+		IssuedAt:       claims.IssuedAt,
 		ExpiresAt:      claims.ExpiresAt,
 		Raw:            claims.Raw,
 	}, nil
@@ -181,6 +187,7 @@ func (v *TokenVerifier) verifyViaAPI(ctx context.Context, token string) (*auth.I
 
 	// Derive permissions from roles
 	permissions := v.derivePermissions(ctx, session.Roles)
+	authMethods := authMethodsFromFactors(session.AuthenticationFactors)
 
 	// Build identity
 	identity := &auth.Identity{
@@ -190,6 +197,10 @@ func (v *TokenVerifier) verifyViaAPI(ctx context.Context, token string) (*auth.I
 		OrganizationID: session.OrganizationID,
 		Roles:          v.convertRoles(session.Roles),
 		Permissions:    permissions,
+		SessionID:      session.MemberSessionID,
+		AuthMethods:    authMethods,
+		MFAVerified:    hasSecondFactor(authMethods),
+		IssuedAt:       issuedAtFromJWT(v.jwtParser, token),
 		ExpiresAt:      timeValue(session.ExpiresAt),
 		Raw: map[string]any{
 			"member_session": session,
@@ -223,6 +234,10 @@ func (v *TokenVerifier) verifyWithoutSignature(ctx context.Context, token string
 		OrganizationID: claims.OrganizationID,
 		Roles:          v.convertRoles(claims.Roles),
 		Permissions:    permissions,
+		SessionID:      claims.SessionID,
+		AuthMethods:    claims.AuthMethods,
+		MFAVerified:    hasSecondFactor(claims.AuthMethods),
+		IssuedAt:       claims.IssuedAt,
 		ExpiresAt:      claims.ExpiresAt,
 		Raw:            claims.Raw,
 	}, nil
@@ -242,6 +257,12 @@ func (v *TokenVerifier) parseClaimsFromMap(claimsMap map[string]any) *internalCl
 	// Extract email from Stytch session authentication factors
 	// Format: https://stytch.com/session.authentication_factors[].email_factor.email_address
 	if sessionObj, ok := claimsMap["https://stytch.com/session"].(map[string]any); ok {
+		if sessionID, ok := sessionObj["id"].(string); ok {
+			claims.SessionID = sessionID
+		}
+
+		claims.AuthMethods = authMethodsFromClaims(sessionObj)
+
 		if factors, ok := sessionObj["authentication_factors"].([]any); ok {
 			for _, factor := range factors {
 				if factorMap, ok := factor.(map[string]any); ok {
@@ -483,3 +504,12 @@ func timeValue(ts *time.Time) time.Time {
 	}
 	return ts.UTC()
 }
+
+// issuedAtFromJWT reads the iat claim from a token already verified by the API.
+func issuedAtFromJWT(parser *JWTParser, token string) time.Time {
+	_, claimsMap, err := parser.ParseWithoutVerification(token)
+	if err != nil {
+		return time.Time{}
+	}
+	return parseNumericTime(claimsMap["iat"])
+}
@@ -109,6 +109,20 @@ type Identity struct {
 	// These are derived from roles by the auth provider or adapter.
 	Permissions []Permission `json:"permissions"`
 
+	// SessionID is the provider session the token belongs to, if any.
+	// For Stytch, this is the member_session_id. For OIDC, this is the sid claim.
+	SessionID string `json:"session_id,omitempty"`
+
+	// AuthMethods lists how the session was authenticated (AuthMethod* values),
+	// e.g. ["sso"] or ["password", "totp"]. Empty when the provider does not report it.
+	AuthMethods []string `json:"auth_methods,omitempty"`
+
+	// MFAVerified is true when the session completed a second factor.
+	MFAVerified bool `json:"mfa_verified"`
+
+	// IssuedAt is when the token was issued. Used to enforce member-wide revocation.
+	IssuedAt time.Time `json:"issued_at"`
+
 	// ExpiresAt is when the token/session expires.
 	ExpiresAt time.Time `json:"expires_at"`
 
@@ -164,6 +178,12 @@ type RequestContext struct {
 	// ProviderOrgID preserves the original provider organization ID for reference.
 	// Use this when making calls back to the auth provider.
 	ProviderOrgID string `json:"provider_org_id,omitempty"`
+
+	// ClientIP, UserAgent, and RequestID describe the HTTP request that
+	// carried the identity. Used to attribute audit events.
+	ClientIP  string `json:"client_ip,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
 }
 
 // OrganizationRepository defines the interface for looking up organizations.
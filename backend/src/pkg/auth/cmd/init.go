@@ -2,20 +2,33 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/auth/adapters/oidc"
 	"github.com/moasq/backend/pkg/auth/adapters/stytch"
 	"github.com/moasq/backend/pkg/logger"
 	"github.com/moasq/backend/pkg/redis"
+	"github.com/spf13/viper"
 	"go.uber.org/dig"
 )
 
+// Supported values for AUTH_PROVIDER.
+const (
+	ProviderStytch = "stytch"
+	ProviderOIDC   = "oidc"
+)
+
 //
 // This sets up:
 //   - stytch.Config
-//   - auth.AuthProvider (Stytch adapter)
+//   - auth.AuthProvider (Stytch or generic OIDC adapter, chosen by AUTH_PROVIDER)
+//   - auth.RevocationStore (Redis-backed session/member denylist)
+//   - *auth.ResourceRegistry (per-resource-type loaders for instance checks)
+//   - *auth.PolicyEngine (per-resource-type ABAC policies, needs auth.OrgSettingsProvider)
 //
 // Note: The auth middleware is NOT initialized here because it requires
 // organization/account resolvers from the organizations module.
@@ -47,6 +60,18 @@ func Init(container *dig.Container) error {
 		redisClient redis.Client,
 		log logger.Logger,
 	) (auth.AuthProvider, error) {
+		if loadSettings().provider == ProviderOIDC {
+			oidcCfg, err := oidc.LoadConfig()
+			if err != nil {
+				return nil, fmt.Errorf("failed to load oidc config: %w", err)
+			}
+			adapter, err := oidc.NewOIDCAuthAdapter(context.Background(), oidcCfg, redisClient, log)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create oidc adapter: %w", err)
+			}
+			return adapter, nil
+		}
+
 		// Check for placeholder credentials
 		if isPlaceholderCredentials(cfg) {
 			log.Warn("Stytch credentials are placeholders - using development mode", map[string]any{
@@ -65,6 +90,30 @@ func Init(container *dig.Container) error {
 		return fmt.Errorf("failed to provide auth provider: %w", err)
 	}
 
+	// Session revocation store (checked by RequireAuth)
+	if err := container.Provide(func(redisClient redis.Client) auth.RevocationStore {
+		return auth.NewRedisRevocationStore(redisClient, loadSettings().revocationTTL)
+	}); err != nil {
+		return fmt.Errorf("failed to provide revocation store: %w", err)
+	}
+
+	// Resource loaders for instance-level checks (registered by app modules)
+	if err := container.Provide(auth.NewResourceRegistry); err != nil {
+		return fmt.Errorf("failed to provide resource registry: %w", err)
+	}
+
+	// Attribute-based policies evaluated on top of RBAC
+	if err := container.Provide(func(
+		settings auth.OrgSettingsProvider,
+		resources *auth.ResourceRegistry,
+	) *auth.PolicyEngine {
+		engine := auth.NewPolicyEngine(settings, resources)
+		engine.Register(auth.ResourcePolicy)
+		return engine
+	}); err != nil {
+		return fmt.Errorf("failed to provide policy engine: %w", err)
+	}
+
 	return nil
 }
 
@@ -79,6 +128,8 @@ func Init(container *dig.Container) error {
 //   - auth.AuthProvider (from Init)
 //   - auth.OrganizationResolver
 //   - auth.AccountResolver
+//   - auth.SecurityPolicyProvider (from the organizations module)
+//   - auth.AccountProvisioner (from the organizations module)
 //   - serverDomain.Server (for registering named middlewares)
 //
 // # Usage
@@ -94,6 +145,29 @@ func InitMiddleware(container *dig.Container) error {
 	return nil
 }
 
+// settings holds provider-independent auth configuration.
+type settings struct {
+	provider      string
+	revocationTTL time.Duration
+}
+
+// loadSettings reads AUTH_PROVIDER (default Stytch) and AUTH_REVOCATION_TTL.
+func loadSettings() settings {
+	v := viper.New()
+	v.SetConfigName("app")
+	v.SetConfigType("env")
+	v.AddConfigPath(".")
+	v.AutomaticEnv()
+	v.SetDefault("AUTH_PROVIDER", ProviderStytch)
+	v.SetDefault("AUTH_REVOCATION_TTL", auth.DefaultRevocationTTL.String())
+	_ = v.ReadInConfig()
+
+	return settings{
+		provider:      strings.ToLower(strings.TrimSpace(v.GetString("AUTH_PROVIDER"))),
+		revocationTTL: v.GetDuration("AUTH_REVOCATION_TTL"),
+	}
+}
+
 // isPlaceholderCredentials checks if the Stytch credentials are placeholder values.
 func isPlaceholderCredentials(cfg *stytch.Config) bool {
 	return strings.Contains(cfg.ProjectID, "REPLACE") ||
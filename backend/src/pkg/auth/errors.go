@@ -19,6 +19,10 @@ var (
 	// HTTP status: 401 Unauthorized
 	ErrTokenExpired = errors.New("token expired")
 
+	// ErrSessionRevoked is returned when the token's session or member has been revoked.
+	// HTTP status: 401 Unauthorized
+	ErrSessionRevoked = errors.New("session revoked")
+
 	// ErrEmailNotVerified is returned when the user's email is not verified.
 	// HTTP status: 403 Forbidden
 	ErrEmailNotVerified = errors.New("email not verified")
@@ -37,6 +41,11 @@ var (
 	// HTTP status: 403 Forbidden
 	ErrAccountNotFound = errors.New("account not found")
 
+	// ErrSeatLimitReached is returned when an account cannot be provisioned
+	// because the organization has used all of its seats.
+	// HTTP status: 403 Forbidden
+	ErrSeatLimitReached = errors.New("organization seat limit reached")
+
 	// ErrMissingOrganization is returned when the token doesn't contain an organization ID.
 	// HTTP status: 403 Forbidden
 	ErrMissingOrganization = errors.New("no organization in token")
@@ -52,6 +61,17 @@ var (
 	// ErrIssuerMismatch is returned when the token issuer doesn't match.
 	// HTTP status: 401 Unauthorized
 	ErrIssuerMismatch = errors.New("token issuer mismatch")
+
+	// ErrUnknownResourceType is returned when no ResourceLoader is registered for a resource type.
+	ErrUnknownResourceType = errors.New("unknown resource type")
+
+	// ErrResourceNotFound is returned by a ResourceLoader when the resource does not
+	// exist in the caller's organization.
+	ErrResourceNotFound = errors.New("resource not found")
+
+	// ErrTeamNotFound is returned when a team does not exist in the caller's organization.
+	// HTTP status: 404 Not Found
+	ErrTeamNotFound = errors.New("team not found")
 )
 
 // IsAuthError returns true if the error is an authentication error (401).
@@ -59,6 +79,7 @@ func IsAuthError(err error) bool {
 	return errors.Is(err, ErrUnauthorized) ||
 		errors.Is(err, ErrInvalidToken) ||
 		errors.Is(err, ErrTokenExpired) ||
+		errors.Is(err, ErrSessionRevoked) ||
 		errors.Is(err, ErrAudienceMismatch) ||
 		errors.Is(err, ErrIssuerMismatch)
 }
@@ -69,6 +90,7 @@ func IsForbiddenError(err error) bool {
 		errors.Is(err, ErrEmailNotVerified) ||
 		errors.Is(err, ErrOrganizationNotFound) ||
 		errors.Is(err, ErrAccountNotFound) ||
+		errors.Is(err, ErrSeatLimitReached) ||
 		errors.Is(err, ErrMissingOrganization) ||
 		errors.Is(err, ErrMissingEmail)
 }
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -24,7 +25,8 @@ type OrganizationResolver interface {
 // Implement this interface by wrapping your account repository.
 type AccountResolver interface {
 	// ResolveByEmail looks up account by email within the given organization.
-	// Returns the database account ID (int32) or error if not found.
+	// Returns the database account ID (int32), or an error wrapping
+	// ErrAccountNotFound when there is no such account.
 	ResolveByEmail(ctx context.Context, orgID int32, email string) (int32, error)
 }
 
@@ -32,6 +34,21 @@ type AccountResolver interface {
 type MiddlewareConfig struct {
 	// ErrorHandler is called when an error occurs. If nil, default JSON responses are used.
 	ErrorHandler func(c *gin.Context, statusCode int, message string, err error)
+
+	// RevocationStore is checked after token verification. If nil, revocation is not enforced.
+	RevocationStore RevocationStore
+
+	// RevocationFailClosed rejects requests when the revocation store is unavailable.
+	// By default the check fails open, since the token signature is already verified.
+	RevocationFailClosed bool
+
+	// SecurityPolicies is checked by RequireOrganization once the organization is
+	// resolved (IP allowlist, allowed auth methods, MFA). If nil, no policy is enforced.
+	SecurityPolicies SecurityPolicyProvider
+
+	// AccountProvisioner is called by RequireOrganization when the identity has no
+	// account in the organization (just-in-time membership). If nil, such requests are rejected.
+	AccountProvisioner AccountProvisioner
 }
 
 // DefaultMiddlewareConfig returns the default middleware configuration.
@@ -50,6 +67,9 @@ func defaultErrorHandler(c *gin.Context, statusCode int, message string, err err
 	if err != nil && statusCode >= 500 {
 		response["detail"] = err.Error()
 	}
+	if reason := denyReason(err); reason != "" {
+		response["reason"] = reason
+	}
 	c.JSON(statusCode, response)
 }
 
@@ -90,7 +110,8 @@ func NewMiddleware(
 // This middleware:
 //  1. Extracts Bearer token from Authorization header
 //  2. Verifies token using the AuthProvider
-//  3. Sets Identity in Gin context (accessible via GetIdentity)
+//  3. Rejects revoked sessions/members (if a RevocationStore is configured)
+//  4. Sets Identity in Gin context (accessible via GetIdentity)
 //
 // Must be called before any middleware that requires authentication.
 //
@@ -123,6 +144,21 @@ func (m *Middleware) RequireAuth() gin.HandlerFunc {
 			return
 		}
 
+		// Check revocation
+		if m.config.RevocationStore != nil {
+			revoked, err := m.config.RevocationStore.IsRevoked(c.Request.Context(), identity)
+			if err != nil && m.config.RevocationFailClosed {
+				m.config.ErrorHandler(c, http.StatusServiceUnavailable, "unable to verify session status", err)
+				c.Abort()
+				return
+			}
+			if revoked {
+				m.config.ErrorHandler(c, http.StatusUnauthorized, errorMessage(ErrSessionRevoked), ErrSessionRevoked)
+				c.Abort()
+				return
+			}
+		}
+
 		// Set identity in context
 		SetIdentity(c, identity)
 
@@ -135,8 +171,12 @@ func (m *Middleware) RequireAuth() gin.HandlerFunc {
 // This middleware:
 //  1. Gets Identity from context (requires RequireAuth to run first)
 //  2. Looks up organization by provider org ID
-//  3. Looks up account by email within organization
-//  4. Sets RequestContext in Gin context (accessible via GetRequestContext)
+//  3. Enforces the organization's security policy (if a SecurityPolicyProvider is configured)
+//  4. Looks up account by email within organization, provisioning it just in
+//     time if it does not exist, an AccountProvisioner is configured and the
+//     identity is eligible (lookup failures are 503, not provisioned)
+//  5. Sets RequestContext in Gin context (accessible via GetRequestContext)
+//     and on the request's context.Context (accessible via RequestContextFromContext)
 //
 // Must be called after RequireAuth middleware.
 //
@@ -175,10 +215,42 @@ func (m *Middleware) RequireOrganization() gin.HandlerFunc {
 			return
 		}
 
-		// Resolve account
+		// Enforce organization security policy
+		if m.config.SecurityPolicies != nil {
+			policy, err := m.config.SecurityPolicies.GetSecurityPolicy(c.Request.Context(), orgID)
+			if err != nil {
+				m.config.ErrorHandler(c, http.StatusServiceUnavailable, "unable to load organization security policy", err)
+				c.Abort()
+				return
+			}
+			if reason := policy.Evaluate(identity, c.ClientIP()); reason != "" {
+				m.config.ErrorHandler(c, http.StatusForbidden, securityPolicyMessage(reason), &SecurityPolicyError{Reason: reason})
+				c.Abort()
+				return
+			}
+		}
+
+		// Resolve account, provisioning it just in time when the organization allows it.
+		// Only a confirmed miss provisions: a failed lookup (e.g. a database
+		// outage) must not create a duplicate account or take a seat.
 		accountID, err := m.accResolver.ResolveByEmail(c.Request.Context(), orgID, identity.Email)
+		if err != nil && !errors.Is(err, ErrAccountNotFound) {
+			m.config.ErrorHandler(c, http.StatusServiceUnavailable, "unable to resolve account", err)
+			c.Abort()
+			return
+		}
+		if err != nil && m.config.AccountProvisioner != nil {
+			accountID, err = m.config.AccountProvisioner.ProvisionAccount(c.Request.Context(), orgID, identity)
+		}
 		if err != nil {
-			m.config.ErrorHandler(c, http.StatusForbidden, "account not found", err)
+			status, message := http.StatusForbidden, "account not found"
+			switch {
+			case errors.Is(err, ErrSeatLimitReached):
+				message = "organization has no available seats"
+			case !errors.Is(err, ErrAccountNotFound):
+				status, message = http.StatusServiceUnavailable, "unable to provision account"
+			}
+			m.config.ErrorHandler(c, status, message, err)
 			c.Abort()
 			return
 		}
@@ -189,8 +261,12 @@ func (m *Middleware) RequireOrganization() gin.HandlerFunc {
 			OrganizationID: orgID,
 			AccountID:      accountID,
 			ProviderOrgID:  identity.OrganizationID,
+			ClientIP:       c.ClientIP(),
+			UserAgent:      c.Request.UserAgent(),
+			RequestID:      c.GetString("request_id"),
 		}
 		SetRequestContext(c, reqCtx)
+		c.Request = c.Request.WithContext(WithRequestContext(c.Request.Context(), reqCtx))
 
 		// Also set individual values for backward compatibility
 		c.Set("organization_id", orgID)
@@ -408,6 +484,8 @@ func errorMessage(err error) string {
 	switch err {
 	case ErrTokenExpired:
 		return "token expired"
+	case ErrSessionRevoked:
+		return "session revoked"
 	case ErrInvalidToken:
 		return "invalid token"
 	case ErrEmailNotVerified:
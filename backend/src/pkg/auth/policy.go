@@ -0,0 +1,417 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Well-known organization settings read by the built-in policies.
+const (
+	// SettingAllowSelfApproval lets the creator of a resource approve it.
+	SettingAllowSelfApproval = "allow_self_approval"
+	// SettingEditOwnResourcesOnly limits edit/delete to the resource owner
+	// unless the caller can manage the organization.
+	SettingEditOwnResourcesOnly = "edit_own_resources_only"
+)
+
+// Additional deny reasons reported by policies.
+const (
+	ReasonSelfApproval      = "self_approval_not_allowed"
+	ReasonPolicyUnavailable = "policy_unavailable"
+)
+
+// OrgSettings holds an organization's settings as seen by policies.
+type OrgSettings map[string]any
+
+// Bool returns the boolean setting for key, or def when unset or not a boolean.
+func (s OrgSettings) Bool(key string, def bool) bool {
+	if v, ok := s[key].(bool); ok {
+		return v
+	}
+	return def
+}
+
+// String returns the string setting for key, or def when unset or not a string.
+func (s OrgSettings) String(key, def string) string {
+	if v, ok := s[key].(string); ok {
+		return v
+	}
+	return def
+}
+
+// OrgSettingsProvider loads organization settings for policy evaluation.
+//
+// This interface decouples the policy engine from the organizations domain.
+type OrgSettingsProvider interface {
+	GetOrganizationSettings(ctx context.Context, orgID int32) (OrgSettings, error)
+}
+
+// PolicyInput is everything a rule can look at: the subject (caller),
+// the action, the loaded resource instance and the organization's settings.
+type PolicyInput struct {
+	Subject  *RequestContext
+	Action   string
+	Resource *ResourceAttributes
+	Settings OrgSettings
+}
+
+// IsOwner reports whether the subject created the resource.
+func (in PolicyInput) IsOwner() bool {
+	return in.Resource.IsOwnedBy(in.Subject.AccountID)
+}
+
+// IsAssignee reports whether the resource's approval is assigned to the subject.
+func (in PolicyInput) IsAssignee() bool {
+	return in.Resource.IsAssignedTo(in.Subject.AccountID)
+}
+
+// HasPermission reports whether the subject holds perm (wildcards and roles included).
+func (in PolicyInput) HasPermission(perm Permission) bool {
+	return hasPermission(in.Subject.Identity, perm.Resource(), perm.Action())
+}
+
+// PolicyRule is a single deny rule. Check returns a deny reason, or "" to pass.
+//
+// Rules only ever deny: a request is allowed when the subject holds the
+// "type:action" permission and no applicable rule denies it.
+type PolicyRule struct {
+	// Name identifies the rule in logs and docs.
+	Name string
+	// Actions the rule applies to. Empty means every action.
+	Actions []string
+	// Check evaluates the rule.
+	Check func(ctx context.Context, in PolicyInput) string
+}
+
+func (r PolicyRule) appliesTo(action string) bool {
+	if len(r.Actions) == 0 {
+		return true
+	}
+	for _, a := range r.Actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// Policy declares the instance-level rules for one resource type.
+type Policy struct {
+	ResourceType string
+	// PermissionResource is the RBAC resource whose permissions gate the
+	// type, for types without permissions of their own. Empty means
+	// ResourceType.
+	PermissionResource string
+	Rules              []PolicyRule
+}
+
+// RequireOwner denies the actions unless the subject created the resource.
+// Subjects holding bypass (e.g. PermOrgManage) are exempt; pass "" for no bypass.
+func RequireOwner(bypass Permission, actions ...string) PolicyRule {
+	return PolicyRule{
+		Name:    "require_owner",
+		Actions: actions,
+		Check: func(ctx context.Context, in PolicyInput) string {
+			if in.IsOwner() || (bypass != "" && in.HasPermission(bypass)) {
+				return ""
+			}
+			return ReasonNotOwner
+		},
+	}
+}
+
+// RequireAssignee denies the actions unless the resource's approval is
+// assigned to the subject. Subjects holding bypass are exempt.
+func RequireAssignee(bypass Permission, actions ...string) PolicyRule {
+	return PolicyRule{
+		Name:    "require_assignee",
+		Actions: actions,
+		Check: func(ctx context.Context, in PolicyInput) string {
+			if in.IsAssignee() || (bypass != "" && in.HasPermission(bypass)) {
+				return ""
+			}
+			return ReasonNotAssignee
+		},
+	}
+}
+
+// DenySelfApproval denies the actions to the resource's creator unless the
+// organization enables SettingAllowSelfApproval.
+func DenySelfApproval(actions ...string) PolicyRule {
+	return PolicyRule{
+		Name:    "deny_self_approval",
+		Actions: actions,
+		Check: func(ctx context.Context, in PolicyInput) string {
+			if in.IsOwner() && !in.Settings.Bool(SettingAllowSelfApproval, false) {
+				return ReasonSelfApproval
+			}
+			return ""
+		},
+	}
+}
+
+// WhenSetting applies rule only when the boolean organization setting key is true.
+func WhenSetting(key string, rule PolicyRule) PolicyRule {
+	check := rule.Check
+	rule.Name = rule.Name + "_when_" + key
+	rule.Check = func(ctx context.Context, in PolicyInput) string {
+		if !in.Settings.Bool(key, false) {
+			return ""
+		}
+		return check(ctx, in)
+	}
+	return rule
+}
+
+// ResourcePolicy is the policy for the generic "resource" type (see rbac.go).
+//
+//   - approve: only the approval assignee (or an org manager), never the creator
+//     unless the org allows self-approval
+//   - edit/delete: owner or org manager, when the org enables edit_own_resources_only
+var ResourcePolicy = Policy{
+	ResourceType: "resource",
+	Rules: []PolicyRule{
+		RequireAssignee(PermOrgManage, "approve"),
+		DenySelfApproval("approve"),
+		WhenSetting(SettingEditOwnResourcesOnly, RequireOwner(PermOrgManage, "edit", "delete")),
+	},
+}
+
+// PolicyDecision is the outcome of a policy evaluation.
+type PolicyDecision struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// PolicyError is returned by Authorize when a policy denies an action.
+// It wraps ErrForbidden, so IsForbiddenError and HTTPStatusCode report 403.
+type PolicyError struct {
+	ResourceType string
+	ResourceID   string
+	Action       string
+	Reason       string
+}
+
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("%s: %s %s/%s: %s", ErrForbidden, e.Action, e.ResourceType, e.ResourceID, e.Reason)
+}
+
+func (e *PolicyError) Unwrap() error {
+	return ErrForbidden
+}
+
+// PolicyDenyReason returns the deny reason carried by err, or "" if err is not a PolicyError.
+func PolicyDenyReason(err error) string {
+	var pe *PolicyError
+	if errors.As(err, &pe) {
+		return pe.Reason
+	}
+	return ""
+}
+
+// PolicyEngine evaluates per-resource-type policies on top of RBAC.
+//
+// Evaluation order:
+//  1. The subject must hold the "type:action" permission (role-based), with
+//     type replaced by the policy's PermissionResource when set
+//  2. Every rule of the type's policy that applies to the action must pass
+//
+// Resource types without a registered policy fall back to RBAC only.
+type PolicyEngine struct {
+	settings  OrgSettingsProvider
+	resources *ResourceRegistry
+
+	mu       sync.RWMutex
+	policies map[string]Policy
+}
+
+// NewPolicyEngine creates an engine. settings may be nil, in which case rules
+// see empty organization settings.
+func NewPolicyEngine(settings OrgSettingsProvider, resources *ResourceRegistry) *PolicyEngine {
+	return &PolicyEngine{
+		settings:  settings,
+		resources: resources,
+		policies:  make(map[string]Policy),
+	}
+}
+
+// Register declares the policy for policy.ResourceType, replacing any previous one.
+func (e *PolicyEngine) Register(policy Policy) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.policies[policy.ResourceType] = policy
+}
+
+// PermissionResource returns the RBAC resource whose permissions gate
+// resourceType: the PermissionResource of its policy, or the type itself.
+func (e *PolicyEngine) PermissionResource(resourceType string) string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if policy, ok := e.policies[resourceType]; ok && policy.PermissionResource != "" {
+		return policy.PermissionResource
+	}
+	return resourceType
+}
+
+// HasPolicy reports whether a policy is registered for resourceType.
+func (e *PolicyEngine) HasPolicy(resourceType string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	_, ok := e.policies[resourceType]
+	return ok
+}
+
+// Evaluate decides whether subject may perform action on resource.
+func (e *PolicyEngine) Evaluate(ctx context.Context, subject *RequestContext, action string, resource *ResourceAttributes) PolicyDecision {
+	if subject == nil || subject.Identity == nil || resource == nil {
+		return PolicyDecision{Reason: ReasonMissingPermission}
+	}
+	if resource.OrganizationID != subject.OrganizationID {
+		return PolicyDecision{Reason: ReasonResourceNotFound}
+	}
+	if !hasPermission(subject.Identity, e.PermissionResource(resource.Type), action) {
+		return PolicyDecision{Reason: ReasonMissingPermission}
+	}
+
+	e.mu.RLock()
+	policy, ok := e.policies[resource.Type]
+	e.mu.RUnlock()
+	if !ok {
+		return PolicyDecision{Allowed: true}
+	}
+
+	var settings OrgSettings
+	if e.settings != nil {
+		loaded, err := e.settings.GetOrganizationSettings(ctx, subject.OrganizationID)
+		if err != nil {
+			return PolicyDecision{Reason: ReasonPolicyUnavailable}
+		}
+		settings = loaded
+	}
+
+	in := PolicyInput{
+		Subject:  subject,
+		Action:   action,
+		Resource: resource,
+		Settings: settings,
+	}
+	for _, rule := range policy.Rules {
+		if !rule.appliesTo(action) {
+			continue
+		}
+		if reason := rule.Check(ctx, in); reason != "" {
+			return PolicyDecision{Reason: reason}
+		}
+	}
+
+	return PolicyDecision{Allowed: true}
+}
+
+// Authorize is the helper services call with an already loaded entity.
+// It returns nil when allowed, or a *PolicyError carrying the deny reason.
+//
+// Example:
+//
+//	attrs := &auth.ResourceAttributes{
+//	    Type:                 "invoice",
+//	    ID:                   strconv.Itoa(int(inv.ID)),
+//	    OrganizationID:       inv.OrganizationID,
+//	    CreatedByAccountID:   &inv.CreatedByAccountID,
+//	    ApprovalAssignedToID: inv.ApprovalAssignedToID,
+//	}
+//	if err := s.policies.Authorize(ctx, reqCtx, "approve", attrs); err != nil {
+//	    return err
+//	}
+func (e *PolicyEngine) Authorize(ctx context.Context, subject *RequestContext, action string, resource *ResourceAttributes) error {
+	decision := e.Evaluate(ctx, subject, action, resource)
+	if decision.Allowed {
+		return nil
+	}
+
+	pe := &PolicyError{Action: action, Reason: decision.Reason}
+	if resource != nil {
+		pe.ResourceType = resource.Type
+		pe.ResourceID = resource.ID
+	}
+	return pe
+}
+
+// AuthorizeContext is Authorize with the subject taken from ctx
+// (see WithRequestContext; RequireOrganization sets it on the request context).
+func (e *PolicyEngine) AuthorizeContext(ctx context.Context, action string, resource *ResourceAttributes) error {
+	subject := RequestContextFromContext(ctx)
+	if subject == nil {
+		return ErrUnauthorized
+	}
+	return e.Authorize(ctx, subject, action, resource)
+}
+
+// RequireResourcePolicy returns middleware that loads the resource identified
+// by the idParam path parameter and enforces the policy for action on it.
+//
+// The loaded attributes are available to the handler via GetResourceAttributes.
+// Must be called after RequireOrganization middleware.
+//
+// Usage:
+//
+//	router.POST("/invoices/:id/approve",
+//	    policies.RequireResourcePolicy("invoice", "approve", "id"),
+//	    handler.Approve)
+func (e *PolicyEngine) RequireResourcePolicy(resourceType, action, idParam string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reqCtx := GetRequestContext(c)
+		if reqCtx == nil {
+			defaultErrorHandler(c, http.StatusUnauthorized, "authentication required", nil)
+			c.Abort()
+			return
+		}
+
+		attrs, err := e.resources.Load(c.Request.Context(), resourceType, reqCtx.OrganizationID, c.Param(idParam))
+		if err != nil {
+			if errors.Is(err, ErrResourceNotFound) {
+				defaultErrorHandler(c, http.StatusNotFound, "resource not found", nil)
+			} else {
+				defaultErrorHandler(c, http.StatusInternalServerError, "failed to load resource", err)
+			}
+			c.Abort()
+			return
+		}
+
+		if err := e.Authorize(c.Request.Context(), reqCtx, action, attrs); err != nil {
+			RespondPolicyDenied(c, err)
+			c.Abort()
+			return
+		}
+
+		c.Set(string(resourceAttributesKey), attrs)
+		c.Next()
+	}
+}
+
+// resourceAttributesKey is the context key for attributes loaded by RequireResourcePolicy.
+const resourceAttributesKey contextKey = "auth_resource_attributes"
+
+// GetResourceAttributes returns the attributes loaded by RequireResourcePolicy, or nil.
+func GetResourceAttributes(c *gin.Context) *ResourceAttributes {
+	if val, exists := c.Get(string(resourceAttributesKey)); exists {
+		if attrs, ok := val.(*ResourceAttributes); ok {
+			return attrs
+		}
+	}
+	return nil
+}
+
+// RespondPolicyDenied writes a 403 response that includes the deny reason.
+// Handlers can use it for errors returned by PolicyEngine.Authorize.
+func RespondPolicyDenied(c *gin.Context, err error) {
+	c.JSON(http.StatusForbidden, gin.H{
+		"error":   ErrForbidden.Error(),
+		"reason":  PolicyDenyReason(err),
+		"success": false,
+	})
+}
@@ -24,6 +24,9 @@ type ServerMiddlewareRegistrar interface {
 //   - auth.AuthProvider
 //   - auth.OrganizationResolver
 //   - auth.AccountResolver
+//   - auth.RevocationStore
+//   - auth.SecurityPolicyProvider
+//   - auth.AccountProvisioner
 //
 // # Usage
 //
@@ -35,8 +38,15 @@ func SetupMiddleware(container *dig.Container) error {
 		provider AuthProvider,
 		orgResolver OrganizationResolver,
 		accResolver AccountResolver,
+		revocations RevocationStore,
+		securityPolicies SecurityPolicyProvider,
+		accountProvisioner AccountProvisioner,
 	) *Middleware {
-		return NewMiddleware(provider, orgResolver, accResolver, nil)
+		config := DefaultMiddlewareConfig()
+		config.RevocationStore = revocations
+		config.SecurityPolicies = securityPolicies
+		config.AccountProvisioner = accountProvisioner
+		return NewMiddleware(provider, orgResolver, accResolver, config)
 	}); err != nil {
 		return fmt.Errorf("failed to provide auth middleware: %w", err)
 	}
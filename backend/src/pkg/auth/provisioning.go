@@ -0,0 +1,20 @@
+package auth
+
+import "context"
+
+// ReasonSeatLimitReached is reported when just-in-time provisioning is refused
+// because the organization has no free seat.
+const ReasonSeatLimitReached = "seat_limit_reached"
+
+// AccountProvisioner creates accounts just in time for authenticated users who
+// have no account in their organization yet (e.g. users from a verified email domain).
+//
+// This interface decouples the auth middleware from the organizations domain.
+// RequireOrganization calls it only when the AccountResolver finds no account.
+type AccountProvisioner interface {
+	// ProvisionAccount returns the ID of the identity's account in orgID,
+	// creating it when the identity is eligible. It returns ErrAccountNotFound
+	// when the identity is not eligible and ErrSeatLimitReached when the
+	// organization has no free seat.
+	ProvisionAccount(ctx context.Context, orgID int32, identity *Identity) (int32, error)
+}
@@ -338,17 +338,49 @@ func NewRolePermissionsResponse(roleID string) *RolePermissionsResponse {
 	}
 }
 
-// PermissionCheckRequest is used to verify if a role has a permission
+// PermissionCheckRequest is used to verify if a role has a permission.
+//
+// In batch checks (POST /rbac/check) RoleID is optional: when empty the
+// caller's own roles and permissions are used. ResourceType/ResourceID
+// target a specific resource instance, and Relation additionally requires
+// the caller to be its owner or approval assignee.
 type PermissionCheckRequest struct {
-	RoleID       string `json:"role_id" binding:"required"`
+	RoleID       string `json:"role_id,omitempty"`
 	PermissionID string `json:"permission_id" binding:"required"`
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceID   string `json:"resource_id,omitempty"`
+	Relation     string `json:"relation,omitempty" binding:"omitempty,oneof=owner assignee"`
 }
 
 // PermissionCheckResponse indicates whether a role has a permission
 type PermissionCheckResponse struct {
-	RoleID        string `json:"role_id"`
-	PermissionID  string `json:"permission_id"`
-	HasPermission bool   `json:"has_permission"`
+	RoleID        string   `json:"role_id,omitempty"`
+	PermissionID  string   `json:"permission_id"`
+	ResourceType  string   `json:"resource_type,omitempty"`
+	ResourceID    string   `json:"resource_id,omitempty"`
+	HasPermission bool     `json:"has_permission"`
+	Relations     []string `json:"relations,omitempty"`
+	Reason        string   `json:"reason,omitempty"`
+}
+
+// BatchPermissionCheckRequest evaluates several permission checks in one call
+type BatchPermissionCheckRequest struct {
+	Checks []PermissionCheckRequest `json:"checks" binding:"required,min=1,max=100,dive"`
+}
+
+// BatchPermissionCheckResponse holds one result per requested check, in request order
+type BatchPermissionCheckResponse struct {
+	Results []PermissionCheckResponse `json:"results"`
+}
+
+// EffectivePermissionsResponse describes the caller's resolved roles and permissions
+type EffectivePermissionsResponse struct {
+	UserID         string   `json:"user_id"`
+	Email          string   `json:"email"`
+	OrganizationID int32    `json:"organization_id"`
+	AccountID      int32    `json:"account_id"`
+	Roles          []string `json:"roles"`
+	Permissions    []string `json:"permissions"`
 }
 
 // RBACMetadata provides summary information about the RBAC system
@@ -26,7 +26,9 @@ type OrganizationEntity interface {
 // It abstracts the specific repository implementation from the auth package.
 type AccountLookup interface {
 	// GetByEmail returns an account by email within an organization.
-	// The returned value must have an ID field (int32).
+	// The returned value must have an ID field (int32). A missing account
+	// must be reported as ErrAccountNotFound (or an error wrapping it), so
+	// lookup failures are not mistaken for it.
 	GetByEmail(ctx context.Context, orgID int32, email string) (AccountEntity, error)
 }
 
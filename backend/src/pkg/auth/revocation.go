@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/moasq/backend/pkg/redis"
+)
+
+const (
+	// Redis keys for revoked sessions and members
+	revokedSessionKeyPattern = "auth:revoked:session:%s" // Denylisted session ID
+	revokedMemberKeyPattern  = "auth:revoked:member:%s"  // Unix time; tokens issued at or before it are rejected
+
+	// DefaultRevocationTTL is how long revocation entries are kept. It must be at
+	// least the lifetime of the longest-lived access token the provider issues.
+	DefaultRevocationTTL = 24 * time.Hour
+)
+
+// RevocationStore tracks revoked sessions and members.
+//
+// Local JWT verification accepts a token until it expires, so logout, role
+// removal, or account suspension would otherwise only take effect at exp.
+// RequireAuth consults the store after verification to close that window.
+type RevocationStore interface {
+	// RevokeSession denylists a single session by its provider session ID.
+	RevokeSession(ctx context.Context, sessionID string) error
+
+	// RevokeMember rejects every token for the member issued before the given
+	// time, or in the same second since token issue times are whole seconds.
+	RevokeMember(ctx context.Context, memberID string, before time.Time) error
+
+	// IsRevoked reports whether the identity's session or member has been revoked.
+	IsRevoked(ctx context.Context, identity *Identity) (bool, error)
+}
+
+type redisRevocationStore struct {
+	redis redis.Client
+	ttl   time.Duration
+}
+
+// NewRedisRevocationStore creates a Redis-backed revocation store.
+// Entries expire after ttl (DefaultRevocationTTL if zero).
+func NewRedisRevocationStore(client redis.Client, ttl time.Duration) RevocationStore {
+	if ttl <= 0 {
+		ttl = DefaultRevocationTTL
+	}
+	return &redisRevocationStore{
+		redis: client,
+		ttl:   ttl,
+	}
+}
+
+func (s *redisRevocationStore) RevokeSession(ctx context.Context, sessionID string) error {
+	if sessionID == "" {
+		return fmt.Errorf("session ID is required")
+	}
+	key := fmt.Sprintf(revokedSessionKeyPattern, sessionID)
+	if err := s.redis.Set(ctx, key, "1", s.ttl); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return nil
+}
+
+func (s *redisRevocationStore) RevokeMember(ctx context.Context, memberID string, before time.Time) error {
+	if memberID == "" {
+		return fmt.Errorf("member ID is required")
+	}
+	key := fmt.Sprintf(revokedMemberKeyPattern, memberID)
+	if err := s.redis.Set(ctx, key, strconv.FormatInt(before.Unix(), 10), s.ttl); err != nil {
+		return fmt.Errorf("failed to revoke member tokens: %w", err)
+	}
+	return nil
+}
+
+func (s *redisRevocationStore) IsRevoked(ctx context.Context, identity *Identity) (bool, error) {
+	if identity.SessionID != "" {
+		exists, err := s.redis.Exists(ctx, fmt.Sprintf(revokedSessionKeyPattern, identity.SessionID))
+		if err != nil {
+			return false, fmt.Errorf("failed to check session revocation: %w", err)
+		}
+		if exists {
+			return true, nil
+		}
+	}
+
+	if identity.UserID == "" {
+		return false, nil
+	}
+
+	key := fmt.Sprintf(revokedMemberKeyPattern, identity.UserID)
+	exists, err := s.redis.Exists(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to check member revocation: %w", err)
+	}
+	if !exists {
+		return false, nil
+	}
+
+	value, err := s.redis.Get(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to read member revocation: %w", err)
+	}
+	revokedBefore, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid member revocation value %q: %w", value, err)
+	}
+
+	// Tokens without an issue time cannot prove they postdate the revocation.
+	if identity.IssuedAt.IsZero() {
+		return true, nil
+	}
+	// iat has whole-second precision, so a token from the second of the
+	// revocation may predate it and is rejected too.
+	return identity.IssuedAt.Unix() <= revokedBefore, nil
+}
@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+// Authentication methods reported in Identity.AuthMethods and accepted in
+// SecurityPolicy.AllowedAuthMethods.
+const (
+	AuthMethodPassword  = "password"
+	AuthMethodMagicLink = "magic_link"
+	AuthMethodEmailOTP  = "email_otp"
+	AuthMethodOAuth     = "oauth"
+	AuthMethodSSO       = "sso"
+
+	// Second factors. They satisfy RequireMFA but are not login methods on their own.
+	AuthMethodSMSOTP       = "sms_otp"
+	AuthMethodTOTP         = "totp"
+	AuthMethodRecoveryCode = "recovery_code"
+)
+
+// IsSecondFactor reports whether method is a second authentication factor.
+func IsSecondFactor(method string) bool {
+	switch method {
+	case AuthMethodSMSOTP, AuthMethodTOTP, AuthMethodRecoveryCode:
+		return true
+	default:
+		return false
+	}
+}
+
+// Reasons reported when an organization's security policy denies a request.
+const (
+	ReasonIPNotAllowed         = "ip_not_allowed"
+	ReasonMFARequired          = "mfa_required"
+	ReasonAuthMethodNotAllowed = "auth_method_not_allowed"
+)
+
+// SecurityPolicy is an organization's login and network restrictions.
+// Zero values impose no restriction.
+type SecurityPolicy struct {
+	// RequireMFA rejects sessions that did not complete a second factor.
+	RequireMFA bool
+	// AllowedAuthMethods restricts the login methods (password, magic_link,
+	// email_otp, oauth, sso) a session may have used. Empty allows every method.
+	AllowedAuthMethods []string
+	// IPAllowlist restricts client IPs to these networks. Empty allows every IP.
+	IPAllowlist []netip.Prefix
+}
+
+// Evaluate returns the reason the request is denied, or "" when it is allowed.
+// Checks run in order: IP allowlist, allowed auth methods, MFA.
+func (p *SecurityPolicy) Evaluate(identity *Identity, clientIP string) string {
+	if p == nil {
+		return ""
+	}
+
+	if len(p.IPAllowlist) > 0 && !ipAllowed(p.IPAllowlist, clientIP) {
+		return ReasonIPNotAllowed
+	}
+
+	if len(p.AllowedAuthMethods) > 0 && !authMethodAllowed(p.AllowedAuthMethods, identity.AuthMethods) {
+		return ReasonAuthMethodNotAllowed
+	}
+
+	if p.RequireMFA && !identity.MFAVerified {
+		return ReasonMFARequired
+	}
+
+	return ""
+}
+
+// ipAllowed reports whether clientIP falls within one of the networks.
+// Unparseable addresses are never allowed.
+func ipAllowed(allowlist []netip.Prefix, clientIP string) bool {
+	addr, err := netip.ParseAddr(clientIP)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range allowlist {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// authMethodAllowed reports whether the session used at least one allowed
+// login method. Second factors are ignored; a session that reports no login
+// method is not allowed.
+func authMethodAllowed(allowed, used []string) bool {
+	for _, method := range used {
+		if IsSecondFactor(method) {
+			continue
+		}
+		for _, a := range allowed {
+			if a == method {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SecurityPolicyProvider loads an organization's security policy.
+//
+// This interface decouples the auth middleware from the organizations domain.
+// Implementations return a nil policy when the organization has none.
+type SecurityPolicyProvider interface {
+	GetSecurityPolicy(ctx context.Context, orgID int32) (*SecurityPolicy, error)
+}
+
+// SecurityPolicyError is returned when an organization's security policy
+// rejects a request. It wraps ErrForbidden.
+type SecurityPolicyError struct {
+	Reason string
+}
+
+func (e *SecurityPolicyError) Error() string {
+	return fmt.Sprintf("%s: security policy: %s", ErrForbidden, e.Reason)
+}
+
+func (e *SecurityPolicyError) Unwrap() error {
+	return ErrForbidden
+}
+
+// securityPolicyMessage returns a user-facing message for a security policy deny reason.
+func securityPolicyMessage(reason string) string {
+	switch reason {
+	case ReasonIPNotAllowed:
+		return "access from this IP address is not allowed by your organization"
+	case ReasonMFARequired:
+		return "your organization requires multi-factor authentication"
+	case ReasonAuthMethodNotAllowed:
+		return "this login method is not allowed by your organization"
+	default:
+		return "request denied by organization security policy"
+	}
+}
+
+// denyReason returns the reason carried by a PolicyError or SecurityPolicyError,
+// or ReasonSeatLimitReached for ErrSeatLimitReached.
+func denyReason(err error) string {
+	var spe *SecurityPolicyError
+	if errors.As(err, &spe) {
+		return spe.Reason
+	}
+	if errors.Is(err, ErrSeatLimitReached) {
+		return ReasonSeatLimitReached
+	}
+	return PolicyDenyReason(err)
+}
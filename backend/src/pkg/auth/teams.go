@@ -0,0 +1,41 @@
+package auth
+
+import "context"
+
+// PermTeamVisibilityBypass lets a caller see data owned by every team in the
+// organization. Callers without it see data that has no team and data owned
+// by the teams they belong to.
+var PermTeamVisibilityBypass = PermOrgManage
+
+// TeamAccess checks team ownership for modules that store team-owned data
+// (documents, resources).
+//
+// The organizations module provides the implementation.
+type TeamAccess interface {
+	// AuthorizeTeam returns nil when the caller in ctx may assign data to teamID:
+	// the team exists in orgID and the caller belongs to it or holds
+	// PermTeamVisibilityBypass. Returns ErrTeamNotFound or ErrForbidden otherwise.
+	AuthorizeTeam(ctx context.Context, orgID, teamID int32) error
+}
+
+// TeamScope returns the account whose team memberships limit what the caller
+// in ctx can see, for use as the viewer of team-scoped queries.
+//
+// It returns nil when nothing is limited: the caller holds
+// PermTeamVisibilityBypass, or ctx carries no request (background jobs and
+// event handlers act for the whole organization).
+//
+// Example:
+//
+//	docs, err := repo.List(ctx, orgID, auth.TeamScope(ctx), limit, offset)
+func TeamScope(ctx context.Context) *int32 {
+	reqCtx := RequestContextFromContext(ctx)
+	if reqCtx == nil {
+		return nil
+	}
+	if reqCtx.Identity != nil && hasPermission(reqCtx.Identity, PermTeamVisibilityBypass.Resource(), PermTeamVisibilityBypass.Action()) {
+		return nil
+	}
+	accountID := reqCtx.AccountID
+	return &accountID
+}
@@ -0,0 +1,22 @@
+package adapters
+
+import (
+	"context"
+
+	db "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+// AuditChainBuilder builds the next audit event from the chain head.
+// prevHash is the hash of the latest event (or the genesis hash) and
+// sequence is the sequence number the new event must use.
+type AuditChainBuilder func(prevHash string, sequence int64) (db.InsertAuditEventParams, error)
+
+// AuditStore provides database operations for the audit log
+type AuditStore interface {
+	// AppendAuditEvent appends an event to the organization's hash chain.
+	// The chain head is read and the event inserted in one transaction that
+	// holds a per-organization lock, so concurrent appends cannot fork the chain.
+	AppendAuditEvent(ctx context.Context, organizationID int32, genesisHash string, build AuditChainBuilder) (db.AuditEvent, error)
+	ListAuditEvents(ctx context.Context, arg db.ListAuditEventsParams) ([]db.AuditEvent, error)
+	ListAuditEventChain(ctx context.Context, arg db.ListAuditEventChainParams) ([]db.AuditEvent, error)
+}
@@ -0,0 +1,14 @@
+package adapters
+
+import (
+	"context"
+
+	db "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+// DocumentFieldStore provides database operations for fields extracted from documents
+type DocumentFieldStore interface {
+	UpsertDocumentField(ctx context.Context, arg db.UpsertDocumentFieldParams) error
+	DeleteDocumentFieldsExcept(ctx context.Context, arg db.DeleteDocumentFieldsExceptParams) error
+	ListDocumentFields(ctx context.Context, arg db.ListDocumentFieldsParams) ([]db.DocumentsDocumentField, error)
+}
@@ -0,0 +1,22 @@
+package adapters
+
+import (
+	"context"
+
+	db "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+// DocumentJobStore provides database operations for the document processing queue
+type DocumentJobStore interface {
+	EnqueueDocumentJob(ctx context.Context, arg db.EnqueueDocumentJobParams) (db.DocumentsDocumentJob, error)
+	EnqueueOrphanedDocumentJobs(ctx context.Context, arg db.EnqueueOrphanedDocumentJobsParams) ([]db.DocumentsDocumentJob, error)
+	ClaimDocumentJob(ctx context.Context, arg db.ClaimDocumentJobParams) (db.DocumentsDocumentJob, error)
+	ExtendDocumentJobLease(ctx context.Context, arg db.ExtendDocumentJobLeaseParams) (int64, error)
+	CompleteDocumentJob(ctx context.Context, arg db.CompleteDocumentJobParams) (int64, error)
+	RetryDocumentJob(ctx context.Context, arg db.RetryDocumentJobParams) (int64, error)
+	FailDocumentJob(ctx context.Context, arg db.FailDocumentJobParams) (int64, error)
+	ReleaseDocumentJob(ctx context.Context, arg db.ReleaseDocumentJobParams) (int64, error)
+	RequeueExpiredDocumentJobs(ctx context.Context) ([]db.DocumentsDocumentJob, error)
+	GetDocumentJobByID(ctx context.Context, arg db.GetDocumentJobByIDParams) (db.DocumentsDocumentJob, error)
+	ListDocumentJobsByDocument(ctx context.Context, arg db.ListDocumentJobsByDocumentParams) ([]db.DocumentsDocumentJob, error)
+}
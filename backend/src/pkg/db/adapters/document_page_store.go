@@ -0,0 +1,15 @@
+package adapters
+
+import (
+	"context"
+
+	db "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+// DocumentPageStore provides database operations for per-page OCR output
+type DocumentPageStore interface {
+	UpsertDocumentPage(ctx context.Context, arg db.UpsertDocumentPageParams) error
+	DeleteDocumentPagesAfter(ctx context.Context, arg db.DeleteDocumentPagesAfterParams) error
+	GetDocumentPage(ctx context.Context, arg db.GetDocumentPageParams) (db.DocumentsDocumentPage, error)
+	CountDocumentPages(ctx context.Context, arg db.CountDocumentPagesParams) (int64, error)
+}
@@ -0,0 +1,17 @@
+package adapters
+
+import (
+	"context"
+
+	db "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+// DocumentTypeStore provides database operations for custom document types
+type DocumentTypeStore interface {
+	CreateDocumentType(ctx context.Context, arg db.CreateDocumentTypeParams) (db.DocumentsDocumentType, error)
+	GetDocumentType(ctx context.Context, arg db.GetDocumentTypeParams) (db.DocumentsDocumentType, error)
+	ListDocumentTypes(ctx context.Context, organizationID int32) ([]db.DocumentsDocumentType, error)
+	CountDocumentTypes(ctx context.Context, organizationID int32) (int64, error)
+	UpdateDocumentType(ctx context.Context, arg db.UpdateDocumentTypeParams) (db.DocumentsDocumentType, error)
+	DeleteDocumentType(ctx context.Context, arg db.DeleteDocumentTypeParams) (int64, error)
+}
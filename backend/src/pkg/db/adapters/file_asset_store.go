@@ -22,6 +22,7 @@ type FileAssetStore interface {
 	
 	// Update operations
 	UpdateFileAsset(ctx context.Context, arg db.UpdateFileAssetParams) error
+	UpdateFileAssetContext(ctx context.Context, arg db.UpdateFileAssetContextParams) error
 	
 	// Search and lookup operations
 	GetFileAssetByStoragePath(ctx context.Context, storagePath string) (db.FileManagerFileAsset, error)
@@ -0,0 +1,19 @@
+package adapters
+
+import (
+	"context"
+
+	db "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+// OCRJobStore provides database operations for asynchronous OCR jobs
+type OCRJobStore interface {
+	CreateOCRJob(ctx context.Context, arg db.CreateOCRJobParams) (db.OcrJob, error)
+	GetOCRJob(ctx context.Context, arg db.GetOCRJobParams) (db.OcrJob, error)
+	StartOCRJob(ctx context.Context, arg db.StartOCRJobParams) (int64, error)
+	UpdateOCRJobProgress(ctx context.Context, arg db.UpdateOCRJobProgressParams) (int64, error)
+	CompleteOCRJob(ctx context.Context, arg db.CompleteOCRJobParams) (int64, error)
+	FailOCRJob(ctx context.Context, arg db.FailOCRJobParams) (int64, error)
+	CancelOCRJob(ctx context.Context, arg db.CancelOCRJobParams) (int64, error)
+	FailStaleOCRJobs(ctx context.Context, arg db.FailStaleOCRJobsParams) (int64, error)
+}
@@ -19,6 +19,27 @@ type OrganizationStore interface {
 	ListOrganizations(ctx context.Context, arg db.ListOrganizationsParams) ([]db.OrganizationsOrganization, error)
 	DeleteOrganization(ctx context.Context, id int32) error
 	GetOrganizationStats(ctx context.Context, id int32) (db.GetOrganizationStatsRow, error)
+	GetOrganizationSettings(ctx context.Context, organizationID int32) (db.OrganizationsOrganizationSetting, error)
+	UpsertOrganizationSettings(ctx context.Context, arg db.UpsertOrganizationSettingsParams) (db.OrganizationsOrganizationSetting, error)
+	GetOrganizationSecurityPolicy(ctx context.Context, organizationID int32) (db.OrganizationsOrganizationSecurityPolicy, error)
+	UpsertOrganizationSecurityPolicy(ctx context.Context, arg db.UpsertOrganizationSecurityPolicyParams) (db.OrganizationsOrganizationSecurityPolicy, error)
+	CreateOrganizationDomain(ctx context.Context, arg db.CreateOrganizationDomainParams) (db.OrganizationsOrganizationDomain, error)
+	GetOrganizationDomain(ctx context.Context, arg db.GetOrganizationDomainParams) (db.OrganizationsOrganizationDomain, error)
+	GetVerifiedOrganizationDomain(ctx context.Context, domain string) (db.OrganizationsOrganizationDomain, error)
+	ListOrganizationDomains(ctx context.Context, organizationID int32) ([]db.OrganizationsOrganizationDomain, error)
+	MarkOrganizationDomainVerified(ctx context.Context, arg db.MarkOrganizationDomainVerifiedParams) (db.OrganizationsOrganizationDomain, error)
+	UpdateOrganizationDomainJIT(ctx context.Context, arg db.UpdateOrganizationDomainJITParams) (db.OrganizationsOrganizationDomain, error)
+	DeleteOrganizationDomain(ctx context.Context, arg db.DeleteOrganizationDomainParams) error
+	CreateTeam(ctx context.Context, arg db.CreateTeamParams) (db.OrganizationsTeam, error)
+	GetTeam(ctx context.Context, arg db.GetTeamParams) (db.OrganizationsTeam, error)
+	ListTeams(ctx context.Context, organizationID int32) ([]db.OrganizationsTeam, error)
+	ListTeamsByAccount(ctx context.Context, arg db.ListTeamsByAccountParams) ([]db.OrganizationsTeam, error)
+	UpdateTeam(ctx context.Context, arg db.UpdateTeamParams) (db.OrganizationsTeam, error)
+	DeleteTeam(ctx context.Context, arg db.DeleteTeamParams) error
+	UpsertTeamMember(ctx context.Context, arg db.UpsertTeamMemberParams) (db.OrganizationsTeamMember, error)
+	GetTeamMember(ctx context.Context, arg db.GetTeamMemberParams) (db.OrganizationsTeamMember, error)
+	ListTeamMembers(ctx context.Context, teamID int32) ([]db.ListTeamMembersRow, error)
+	DeleteTeamMember(ctx context.Context, arg db.DeleteTeamMemberParams) error
 }
 
 // AccountStore provides database operations for accounts
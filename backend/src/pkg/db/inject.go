@@ -13,6 +13,8 @@ import (
 	"github.com/moasq/backend/pkg/db/postgres"
 	adapterImpl "github.com/moasq/backend/pkg/db/postgres/adapter_impl"
 	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+	"github.com/moasq/backend/pkg/health"
+	"github.com/moasq/backend/pkg/lifecycle"
 )
 
 // Inject registers all database dependencies in the DI container
@@ -50,9 +52,30 @@ func Inject(container *dig.Container) error {
 	return nil
 }
 
-// provideDBPool creates the database connection pool
-func provideDBPool(config postgres.Config) (*pgxpool.Pool, error) {
-	return postgres.InitDB(config)
+// provideDBPool creates the database connection pool and registers its
+// readiness check and shutdown hook
+func provideDBPool(config postgres.Config, registry *health.Registry, manager *lifecycle.Manager) (*pgxpool.Pool, error) {
+	pool, err := postgres.InitDB(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := registry.Register(postgres.HealthCheck(pool)); err != nil {
+		return nil, err
+	}
+
+	// Close waits for acquired connections to be released
+	if err := manager.Append(lifecycle.Hook{
+		Name: "postgres",
+		OnStop: func(context.Context) error {
+			pool.Close()
+			return nil
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return pool, nil
 }
 
 // provideSQLCStore creates the SQLC store
@@ -61,10 +84,22 @@ func provideSQLCStore(pool *pgxpool.Pool) sqlc.Store {
 }
 
 // provideSQLDB creates a *sql.DB from the pgxpool for compatibility
-func provideSQLDB(pool *pgxpool.Pool) *sql.DB {
+func provideSQLDB(pool *pgxpool.Pool, manager *lifecycle.Manager) (*sql.DB, error) {
 	// Use pgx stdlib to create a sql.DB from the pool connection string
 	connConfig := pool.Config().ConnConfig
-	return stdlib.OpenDB(*connConfig)
+	sqlDB := stdlib.OpenDB(*connConfig)
+
+	// Registered after the pool's hook, so it closes first
+	if err := manager.Append(lifecycle.Hook{
+		Name: "postgres-sql",
+		OnStop: func(context.Context) error {
+			return sqlDB.Close()
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return sqlDB, nil
 }
 
 // provideDBManager creates the database manager for migrations and health checks
@@ -116,6 +151,41 @@ func registerDomainStores(container *dig.Container) error {
 		return fmt.Errorf("failed to provide document store: %w", err)
 	}
 
+	// Register DocumentJobStore - thin wrapper for the document processing queue
+	if err := container.Provide(func(sqlcStore sqlc.Store) adapters.DocumentJobStore {
+		return adapterImpl.NewDocumentJobStore(sqlcStore)
+	}); err != nil {
+		return fmt.Errorf("failed to provide document job store: %w", err)
+	}
+
+	// Register DocumentPageStore - thin wrapper for per-page OCR output
+	if err := container.Provide(func(sqlcStore sqlc.Store) adapters.DocumentPageStore {
+		return adapterImpl.NewDocumentPageStore(sqlcStore)
+	}); err != nil {
+		return fmt.Errorf("failed to provide document page store: %w", err)
+	}
+
+	// Register DocumentFieldStore - thin wrapper for extracted document fields
+	if err := container.Provide(func(sqlcStore sqlc.Store) adapters.DocumentFieldStore {
+		return adapterImpl.NewDocumentFieldStore(sqlcStore)
+	}); err != nil {
+		return fmt.Errorf("failed to provide document field store: %w", err)
+	}
+
+	// Register DocumentTypeStore - thin wrapper for custom document types
+	if err := container.Provide(func(sqlcStore sqlc.Store) adapters.DocumentTypeStore {
+		return adapterImpl.NewDocumentTypeStore(sqlcStore)
+	}); err != nil {
+		return fmt.Errorf("failed to provide document type store: %w", err)
+	}
+
+	// Register OCRJobStore - thin wrapper for asynchronous OCR jobs
+	if err := container.Provide(func(sqlcStore sqlc.Store) adapters.OCRJobStore {
+		return adapterImpl.NewOCRJobStore(sqlcStore)
+	}); err != nil {
+		return fmt.Errorf("failed to provide OCR job store: %w", err)
+	}
+
 	// Register EmbeddingStore - thin wrapper for cognitive embedding operations
 	if err := container.Provide(func(sqlcStore sqlc.Store) adapters.EmbeddingStore {
 		return adapterImpl.NewEmbeddingStore(sqlcStore)
@@ -130,6 +200,13 @@ func registerDomainStores(container *dig.Container) error {
 		return fmt.Errorf("failed to provide chat store: %w", err)
 	}
 
+	// Register AuditStore - appends to the per-organization audit hash chain
+	if err := container.Provide(func(pool *pgxpool.Pool, sqlcStore sqlc.Store) adapters.AuditStore {
+		return adapterImpl.NewAuditStore(pool, sqlcStore)
+	}); err != nil {
+		return fmt.Errorf("failed to provide audit store: %w", err)
+	}
+
 	return nil
 }
 
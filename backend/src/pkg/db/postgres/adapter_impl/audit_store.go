@@ -0,0 +1,68 @@
+package adapterimpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/moasq/backend/pkg/db/adapters"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+// auditStore implements adapters.AuditStore
+type auditStore struct {
+	pool  *pgxpool.Pool
+	store sqlc.Store
+}
+
+func NewAuditStore(pool *pgxpool.Pool, store sqlc.Store) adapters.AuditStore {
+	return &auditStore{pool: pool, store: store}
+}
+
+func (s *auditStore) AppendAuditEvent(ctx context.Context, organizationID int32, genesisHash string, build adapters.AuditChainBuilder) (sqlc.AuditEvent, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return sqlc.AuditEvent{}, fmt.Errorf("failed to begin audit transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	q := sqlc.New(tx)
+	if err := q.LockAuditChain(ctx, organizationID); err != nil {
+		return sqlc.AuditEvent{}, fmt.Errorf("failed to lock audit chain: %w", err)
+	}
+
+	prevHash, sequence := genesisHash, int64(1)
+	head, err := q.GetAuditChainHead(ctx, organizationID)
+	switch {
+	case err == nil:
+		prevHash, sequence = head.Hash, head.Sequence+1
+	case !errors.Is(err, pgx.ErrNoRows):
+		return sqlc.AuditEvent{}, fmt.Errorf("failed to read audit chain head: %w", err)
+	}
+
+	params, err := build(prevHash, sequence)
+	if err != nil {
+		return sqlc.AuditEvent{}, err
+	}
+
+	event, err := q.InsertAuditEvent(ctx, params)
+	if err != nil {
+		return sqlc.AuditEvent{}, fmt.Errorf("failed to insert audit event: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return sqlc.AuditEvent{}, fmt.Errorf("failed to commit audit event: %w", err)
+	}
+	return event, nil
+}
+
+func (s *auditStore) ListAuditEvents(ctx context.Context, arg sqlc.ListAuditEventsParams) ([]sqlc.AuditEvent, error) {
+	return s.store.ListAuditEvents(ctx, arg)
+}
+
+func (s *auditStore) ListAuditEventChain(ctx context.Context, arg sqlc.ListAuditEventChainParams) ([]sqlc.AuditEvent, error) {
+	return s.store.ListAuditEventChain(ctx, arg)
+}
@@ -0,0 +1,29 @@
+package adapterimpl
+
+import (
+	"context"
+
+	"github.com/moasq/backend/pkg/db/adapters"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+// documentFieldStore implements adapters.DocumentFieldStore
+type documentFieldStore struct {
+	store sqlc.Store
+}
+
+func NewDocumentFieldStore(store sqlc.Store) adapters.DocumentFieldStore {
+	return &documentFieldStore{store: store}
+}
+
+func (s *documentFieldStore) UpsertDocumentField(ctx context.Context, arg sqlc.UpsertDocumentFieldParams) error {
+	return s.store.UpsertDocumentField(ctx, arg)
+}
+
+func (s *documentFieldStore) DeleteDocumentFieldsExcept(ctx context.Context, arg sqlc.DeleteDocumentFieldsExceptParams) error {
+	return s.store.DeleteDocumentFieldsExcept(ctx, arg)
+}
+
+func (s *documentFieldStore) ListDocumentFields(ctx context.Context, arg sqlc.ListDocumentFieldsParams) ([]sqlc.DocumentsDocumentField, error) {
+	return s.store.ListDocumentFields(ctx, arg)
+}
@@ -0,0 +1,61 @@
+package adapterimpl
+
+import (
+	"context"
+
+	"github.com/moasq/backend/pkg/db/adapters"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+// documentJobStore implements adapters.DocumentJobStore
+type documentJobStore struct {
+	store sqlc.Store
+}
+
+func NewDocumentJobStore(store sqlc.Store) adapters.DocumentJobStore {
+	return &documentJobStore{store: store}
+}
+
+func (s *documentJobStore) EnqueueDocumentJob(ctx context.Context, arg sqlc.EnqueueDocumentJobParams) (sqlc.DocumentsDocumentJob, error) {
+	return s.store.EnqueueDocumentJob(ctx, arg)
+}
+
+func (s *documentJobStore) EnqueueOrphanedDocumentJobs(ctx context.Context, arg sqlc.EnqueueOrphanedDocumentJobsParams) ([]sqlc.DocumentsDocumentJob, error) {
+	return s.store.EnqueueOrphanedDocumentJobs(ctx, arg)
+}
+
+func (s *documentJobStore) ClaimDocumentJob(ctx context.Context, arg sqlc.ClaimDocumentJobParams) (sqlc.DocumentsDocumentJob, error) {
+	return s.store.ClaimDocumentJob(ctx, arg)
+}
+
+func (s *documentJobStore) ExtendDocumentJobLease(ctx context.Context, arg sqlc.ExtendDocumentJobLeaseParams) (int64, error) {
+	return s.store.ExtendDocumentJobLease(ctx, arg)
+}
+
+func (s *documentJobStore) CompleteDocumentJob(ctx context.Context, arg sqlc.CompleteDocumentJobParams) (int64, error) {
+	return s.store.CompleteDocumentJob(ctx, arg)
+}
+
+func (s *documentJobStore) RetryDocumentJob(ctx context.Context, arg sqlc.RetryDocumentJobParams) (int64, error) {
+	return s.store.RetryDocumentJob(ctx, arg)
+}
+
+func (s *documentJobStore) FailDocumentJob(ctx context.Context, arg sqlc.FailDocumentJobParams) (int64, error) {
+	return s.store.FailDocumentJob(ctx, arg)
+}
+
+func (s *documentJobStore) ReleaseDocumentJob(ctx context.Context, arg sqlc.ReleaseDocumentJobParams) (int64, error) {
+	return s.store.ReleaseDocumentJob(ctx, arg)
+}
+
+func (s *documentJobStore) RequeueExpiredDocumentJobs(ctx context.Context) ([]sqlc.DocumentsDocumentJob, error) {
+	return s.store.RequeueExpiredDocumentJobs(ctx)
+}
+
+func (s *documentJobStore) GetDocumentJobByID(ctx context.Context, arg sqlc.GetDocumentJobByIDParams) (sqlc.DocumentsDocumentJob, error) {
+	return s.store.GetDocumentJobByID(ctx, arg)
+}
+
+func (s *documentJobStore) ListDocumentJobsByDocument(ctx context.Context, arg sqlc.ListDocumentJobsByDocumentParams) ([]sqlc.DocumentsDocumentJob, error) {
+	return s.store.ListDocumentJobsByDocument(ctx, arg)
+}
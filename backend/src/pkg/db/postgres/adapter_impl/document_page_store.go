@@ -0,0 +1,33 @@
+package adapterimpl
+
+import (
+	"context"
+
+	"github.com/moasq/backend/pkg/db/adapters"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+// documentPageStore implements adapters.DocumentPageStore
+type documentPageStore struct {
+	store sqlc.Store
+}
+
+func NewDocumentPageStore(store sqlc.Store) adapters.DocumentPageStore {
+	return &documentPageStore{store: store}
+}
+
+func (s *documentPageStore) UpsertDocumentPage(ctx context.Context, arg sqlc.UpsertDocumentPageParams) error {
+	return s.store.UpsertDocumentPage(ctx, arg)
+}
+
+func (s *documentPageStore) DeleteDocumentPagesAfter(ctx context.Context, arg sqlc.DeleteDocumentPagesAfterParams) error {
+	return s.store.DeleteDocumentPagesAfter(ctx, arg)
+}
+
+func (s *documentPageStore) GetDocumentPage(ctx context.Context, arg sqlc.GetDocumentPageParams) (sqlc.DocumentsDocumentPage, error) {
+	return s.store.GetDocumentPage(ctx, arg)
+}
+
+func (s *documentPageStore) CountDocumentPages(ctx context.Context, arg sqlc.CountDocumentPagesParams) (int64, error) {
+	return s.store.CountDocumentPages(ctx, arg)
+}
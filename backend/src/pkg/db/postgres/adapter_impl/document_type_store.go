@@ -0,0 +1,41 @@
+package adapterimpl
+
+import (
+	"context"
+
+	"github.com/moasq/backend/pkg/db/adapters"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+// documentTypeStore implements adapters.DocumentTypeStore
+type documentTypeStore struct {
+	store sqlc.Store
+}
+
+func NewDocumentTypeStore(store sqlc.Store) adapters.DocumentTypeStore {
+	return &documentTypeStore{store: store}
+}
+
+func (s *documentTypeStore) CreateDocumentType(ctx context.Context, arg sqlc.CreateDocumentTypeParams) (sqlc.DocumentsDocumentType, error) {
+	return s.store.CreateDocumentType(ctx, arg)
+}
+
+func (s *documentTypeStore) GetDocumentType(ctx context.Context, arg sqlc.GetDocumentTypeParams) (sqlc.DocumentsDocumentType, error) {
+	return s.store.GetDocumentType(ctx, arg)
+}
+
+func (s *documentTypeStore) ListDocumentTypes(ctx context.Context, organizationID int32) ([]sqlc.DocumentsDocumentType, error) {
+	return s.store.ListDocumentTypes(ctx, organizationID)
+}
+
+func (s *documentTypeStore) CountDocumentTypes(ctx context.Context, organizationID int32) (int64, error) {
+	return s.store.CountDocumentTypes(ctx, organizationID)
+}
+
+func (s *documentTypeStore) UpdateDocumentType(ctx context.Context, arg sqlc.UpdateDocumentTypeParams) (sqlc.DocumentsDocumentType, error) {
+	return s.store.UpdateDocumentType(ctx, arg)
+}
+
+func (s *documentTypeStore) DeleteDocumentType(ctx context.Context, arg sqlc.DeleteDocumentTypeParams) (int64, error) {
+	return s.store.DeleteDocumentType(ctx, arg)
+}
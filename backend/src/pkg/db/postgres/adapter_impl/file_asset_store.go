@@ -54,6 +54,10 @@ func (f *fileAssetStore) UpdateFileAsset(ctx context.Context, arg sqlc.UpdateFil
 	return f.store.UpdateFileAsset(ctx, arg)
 }
 
+func (f *fileAssetStore) UpdateFileAssetContext(ctx context.Context, arg sqlc.UpdateFileAssetContextParams) error {
+	return f.store.UpdateFileAssetContext(ctx, arg)
+}
+
 // Search and lookup operations - direct delegation
 func (f *fileAssetStore) GetFileAssetByStoragePath(ctx context.Context, storagePath string) (sqlc.FileManagerFileAsset, error) {
 	return f.store.GetFileAssetByStoragePath(ctx, storagePath)
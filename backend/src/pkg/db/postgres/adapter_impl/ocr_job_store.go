@@ -0,0 +1,49 @@
+package adapterimpl
+
+import (
+	"context"
+
+	"github.com/moasq/backend/pkg/db/adapters"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+)
+
+// ocrJobStore implements adapters.OCRJobStore
+type ocrJobStore struct {
+	store sqlc.Store
+}
+
+func NewOCRJobStore(store sqlc.Store) adapters.OCRJobStore {
+	return &ocrJobStore{store: store}
+}
+
+func (s *ocrJobStore) CreateOCRJob(ctx context.Context, arg sqlc.CreateOCRJobParams) (sqlc.OcrJob, error) {
+	return s.store.CreateOCRJob(ctx, arg)
+}
+
+func (s *ocrJobStore) GetOCRJob(ctx context.Context, arg sqlc.GetOCRJobParams) (sqlc.OcrJob, error) {
+	return s.store.GetOCRJob(ctx, arg)
+}
+
+func (s *ocrJobStore) StartOCRJob(ctx context.Context, arg sqlc.StartOCRJobParams) (int64, error) {
+	return s.store.StartOCRJob(ctx, arg)
+}
+
+func (s *ocrJobStore) UpdateOCRJobProgress(ctx context.Context, arg sqlc.UpdateOCRJobProgressParams) (int64, error) {
+	return s.store.UpdateOCRJobProgress(ctx, arg)
+}
+
+func (s *ocrJobStore) CompleteOCRJob(ctx context.Context, arg sqlc.CompleteOCRJobParams) (int64, error) {
+	return s.store.CompleteOCRJob(ctx, arg)
+}
+
+func (s *ocrJobStore) FailOCRJob(ctx context.Context, arg sqlc.FailOCRJobParams) (int64, error) {
+	return s.store.FailOCRJob(ctx, arg)
+}
+
+func (s *ocrJobStore) CancelOCRJob(ctx context.Context, arg sqlc.CancelOCRJobParams) (int64, error) {
+	return s.store.CancelOCRJob(ctx, arg)
+}
+
+func (s *ocrJobStore) FailStaleOCRJobs(ctx context.Context, arg sqlc.FailStaleOCRJobsParams) (int64, error) {
+	return s.store.FailStaleOCRJobs(ctx, arg)
+}
@@ -57,6 +57,90 @@ func (s *organizationStore) GetOrganizationStats(ctx context.Context, id int32)
 	return s.store.GetOrganizationStats(ctx, id)
 }
 
+func (s *organizationStore) GetOrganizationSettings(ctx context.Context, organizationID int32) (sqlc.OrganizationsOrganizationSetting, error) {
+	return s.store.GetOrganizationSettings(ctx, organizationID)
+}
+
+func (s *organizationStore) UpsertOrganizationSettings(ctx context.Context, arg sqlc.UpsertOrganizationSettingsParams) (sqlc.OrganizationsOrganizationSetting, error) {
+	return s.store.UpsertOrganizationSettings(ctx, arg)
+}
+
+func (s *organizationStore) GetOrganizationSecurityPolicy(ctx context.Context, organizationID int32) (sqlc.OrganizationsOrganizationSecurityPolicy, error) {
+	return s.store.GetOrganizationSecurityPolicy(ctx, organizationID)
+}
+
+func (s *organizationStore) UpsertOrganizationSecurityPolicy(ctx context.Context, arg sqlc.UpsertOrganizationSecurityPolicyParams) (sqlc.OrganizationsOrganizationSecurityPolicy, error) {
+	return s.store.UpsertOrganizationSecurityPolicy(ctx, arg)
+}
+
+func (s *organizationStore) CreateOrganizationDomain(ctx context.Context, arg sqlc.CreateOrganizationDomainParams) (sqlc.OrganizationsOrganizationDomain, error) {
+	return s.store.CreateOrganizationDomain(ctx, arg)
+}
+
+func (s *organizationStore) GetOrganizationDomain(ctx context.Context, arg sqlc.GetOrganizationDomainParams) (sqlc.OrganizationsOrganizationDomain, error) {
+	return s.store.GetOrganizationDomain(ctx, arg)
+}
+
+func (s *organizationStore) GetVerifiedOrganizationDomain(ctx context.Context, domain string) (sqlc.OrganizationsOrganizationDomain, error) {
+	return s.store.GetVerifiedOrganizationDomain(ctx, domain)
+}
+
+func (s *organizationStore) ListOrganizationDomains(ctx context.Context, organizationID int32) ([]sqlc.OrganizationsOrganizationDomain, error) {
+	return s.store.ListOrganizationDomains(ctx, organizationID)
+}
+
+func (s *organizationStore) MarkOrganizationDomainVerified(ctx context.Context, arg sqlc.MarkOrganizationDomainVerifiedParams) (sqlc.OrganizationsOrganizationDomain, error) {
+	return s.store.MarkOrganizationDomainVerified(ctx, arg)
+}
+
+func (s *organizationStore) UpdateOrganizationDomainJIT(ctx context.Context, arg sqlc.UpdateOrganizationDomainJITParams) (sqlc.OrganizationsOrganizationDomain, error) {
+	return s.store.UpdateOrganizationDomainJIT(ctx, arg)
+}
+
+func (s *organizationStore) DeleteOrganizationDomain(ctx context.Context, arg sqlc.DeleteOrganizationDomainParams) error {
+	return s.store.DeleteOrganizationDomain(ctx, arg)
+}
+
+func (s *organizationStore) CreateTeam(ctx context.Context, arg sqlc.CreateTeamParams) (sqlc.OrganizationsTeam, error) {
+	return s.store.CreateTeam(ctx, arg)
+}
+
+func (s *organizationStore) GetTeam(ctx context.Context, arg sqlc.GetTeamParams) (sqlc.OrganizationsTeam, error) {
+	return s.store.GetTeam(ctx, arg)
+}
+
+func (s *organizationStore) ListTeams(ctx context.Context, organizationID int32) ([]sqlc.OrganizationsTeam, error) {
+	return s.store.ListTeams(ctx, organizationID)
+}
+
+func (s *organizationStore) ListTeamsByAccount(ctx context.Context, arg sqlc.ListTeamsByAccountParams) ([]sqlc.OrganizationsTeam, error) {
+	return s.store.ListTeamsByAccount(ctx, arg)
+}
+
+func (s *organizationStore) UpdateTeam(ctx context.Context, arg sqlc.UpdateTeamParams) (sqlc.OrganizationsTeam, error) {
+	return s.store.UpdateTeam(ctx, arg)
+}
+
+func (s *organizationStore) DeleteTeam(ctx context.Context, arg sqlc.DeleteTeamParams) error {
+	return s.store.DeleteTeam(ctx, arg)
+}
+
+func (s *organizationStore) UpsertTeamMember(ctx context.Context, arg sqlc.UpsertTeamMemberParams) (sqlc.OrganizationsTeamMember, error) {
+	return s.store.UpsertTeamMember(ctx, arg)
+}
+
+func (s *organizationStore) GetTeamMember(ctx context.Context, arg sqlc.GetTeamMemberParams) (sqlc.OrganizationsTeamMember, error) {
+	return s.store.GetTeamMember(ctx, arg)
+}
+
+func (s *organizationStore) ListTeamMembers(ctx context.Context, teamID int32) ([]sqlc.ListTeamMembersRow, error) {
+	return s.store.ListTeamMembers(ctx, teamID)
+}
+
+func (s *organizationStore) DeleteTeamMember(ctx context.Context, arg sqlc.DeleteTeamMemberParams) error {
+	return s.store.DeleteTeamMember(ctx, arg)
+}
+
 // accountStore implements adapters.AccountStore
 type accountStore struct {
 	store sqlc.Store
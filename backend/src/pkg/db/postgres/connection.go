@@ -23,6 +23,9 @@ func connPool(cfg Config) (*pgxpool.Pool, error) {
 	poolConfig.MaxConnIdleTime = cfg.ConnIdleTime
 	poolConfig.HealthCheckPeriod = cfg.HealthCheckPeriod
 
+	// Trace every query (no-op until a tracer provider is installed)
+	poolConfig.ConnConfig.Tracer = newQueryTracer(cfg.DBName)
+
 	// Add connection lifecycle callbacks
 	poolConfig.BeforeAcquire = func(ctx context.Context, conn *pgx.Conn) bool {
 		// Optional validation before using a connection
@@ -45,6 +48,10 @@ func connPool(cfg Config) (*pgxpool.Pool, error) {
 		return nil, fmt.Errorf("unable to ping database: %w", err)
 	}
 
+	if err := registerPoolMetrics(pool, cfg.DBName); err != nil {
+		log.Printf("Warning: failed to register database pool metrics: %v", err)
+	}
+
 	log.Printf("Successfully connected to PostgreSQL database at %s:%s", cfg.Host, cfg.Port)
 
 	return pool, nil
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/moasq/backend/pkg/health"
+)
+
+// poolSaturationThreshold is the share of connections in use above which
+// the pool is reported degraded: queries are about to queue.
+const poolSaturationThreshold = 0.9
+
+// HealthCheck pings the database and reports pool saturation.
+func HealthCheck(pool *pgxpool.Pool) health.Check {
+	return health.Check{
+		Name:     "postgres",
+		Critical: true,
+		Run: func(ctx context.Context) (health.Details, error) {
+			stat := pool.Stat()
+			saturation := 0.0
+			if stat.MaxConns() > 0 {
+				saturation = float64(stat.AcquiredConns()) / float64(stat.MaxConns())
+			}
+			details := health.Details{
+				"acquired_connections": stat.AcquiredConns(),
+				"idle_connections":     stat.IdleConns(),
+				"total_connections":    stat.TotalConns(),
+				"max_connections":      stat.MaxConns(),
+				"saturation":           saturation,
+			}
+
+			if err := pool.Ping(ctx); err != nil {
+				return details, err
+			}
+			if saturation >= poolSaturationThreshold {
+				return details, health.Degraded("connection pool %.0f%% in use", saturation*100)
+			}
+			return details, nil
+		},
+	}
+}
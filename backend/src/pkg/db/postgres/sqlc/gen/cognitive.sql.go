@@ -425,15 +425,23 @@ SELECT
     de.updated_at,
     (1 - (de.embedding <=> $1::vector))::double precision as similarity_score
 FROM cognitive.document_embeddings de
+JOIN documents.documents d ON d.id = de.document_id
 WHERE de.organization_id = $2
+    AND ($3::integer IS NULL
+        OR d.team_id IS NULL
+        OR d.team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = $3
+        ))
 ORDER BY de.embedding <=> $1::vector
-LIMIT $3
+LIMIT $4
 `
 
 type SearchSimilarDocumentsParams struct {
-	Column1        pgvector_go.Vector `json:"column_1"`
-	OrganizationID int32              `json:"organization_id"`
-	Limit          int32              `json:"limit"`
+	Embedding       pgvector_go.Vector `json:"embedding"`
+	OrganizationID  int32              `json:"organization_id"`
+	ViewerAccountID pgtype.Int4        `json:"viewer_account_id"`
+	Limit           int32              `json:"limit"`
 }
 
 type SearchSimilarDocumentsRow struct {
@@ -448,8 +456,15 @@ type SearchSimilarDocumentsRow struct {
 	SimilarityScore float64          `json:"similarity_score"`
 }
 
+// viewer_account_id limits matches to documents the account can see (no team,
+// or one of its teams); NULL searches every team's documents.
 func (q *Queries) SearchSimilarDocuments(ctx context.Context, arg SearchSimilarDocumentsParams) ([]SearchSimilarDocumentsRow, error) {
-	rows, err := q.db.Query(ctx, searchSimilarDocuments, arg.Column1, arg.OrganizationID, arg.Limit)
+	rows, err := q.db.Query(ctx, searchSimilarDocuments,
+		arg.Embedding,
+		arg.OrganizationID,
+		arg.ViewerAccountID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -14,10 +14,22 @@ import (
 const countDocumentsByOrganization = `-- name: CountDocumentsByOrganization :one
 SELECT COUNT(*) FROM documents.documents
 WHERE organization_id = $1
+    AND ($2::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = $2
+        ))
 `
 
-func (q *Queries) CountDocumentsByOrganization(ctx context.Context, organizationID int32) (int64, error) {
-	row := q.db.QueryRow(ctx, countDocumentsByOrganization, organizationID)
+type CountDocumentsByOrganizationParams struct {
+	OrganizationID  int32       `json:"organization_id"`
+	ViewerAccountID pgtype.Int4 `json:"viewer_account_id"`
+}
+
+// Team visibility as in ListDocumentsByOrganization
+func (q *Queries) CountDocumentsByOrganization(ctx context.Context, arg CountDocumentsByOrganizationParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countDocumentsByOrganization, arg.OrganizationID, arg.ViewerAccountID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -26,15 +38,23 @@ func (q *Queries) CountDocumentsByOrganization(ctx context.Context, organization
 const countDocumentsByStatus = `-- name: CountDocumentsByStatus :one
 SELECT COUNT(*) FROM documents.documents
 WHERE organization_id = $1 AND status = $2
+    AND ($3::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = $3
+        ))
 `
 
 type CountDocumentsByStatusParams struct {
-	OrganizationID int32  `json:"organization_id"`
-	Status         string `json:"status"`
+	OrganizationID  int32       `json:"organization_id"`
+	Status          string      `json:"status"`
+	ViewerAccountID pgtype.Int4 `json:"viewer_account_id"`
 }
 
+// Team visibility as in ListDocumentsByOrganization
 func (q *Queries) CountDocumentsByStatus(ctx context.Context, arg CountDocumentsByStatusParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countDocumentsByStatus, arg.OrganizationID, arg.Status)
+	row := q.db.QueryRow(ctx, countDocumentsByStatus, arg.OrganizationID, arg.Status, arg.ViewerAccountID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -51,22 +71,26 @@ INSERT INTO documents.documents (
     file_size,
     extracted_text,
     status,
-    metadata
+    metadata,
+    team_id,
+    created_by_account_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
-) RETURNING id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) RETURNING id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at, team_id, created_by_account_id
 `
 
 type CreateDocumentParams struct {
-	OrganizationID int32       `json:"organization_id"`
-	FileAssetID    int32       `json:"file_asset_id"`
-	Title          string      `json:"title"`
-	FileName       string      `json:"file_name"`
-	ContentType    string      `json:"content_type"`
-	FileSize       int64       `json:"file_size"`
-	ExtractedText  pgtype.Text `json:"extracted_text"`
-	Status         string      `json:"status"`
-	Metadata       []byte      `json:"metadata"`
+	OrganizationID     int32       `json:"organization_id"`
+	FileAssetID        int32       `json:"file_asset_id"`
+	Title              string      `json:"title"`
+	FileName           string      `json:"file_name"`
+	ContentType        string      `json:"content_type"`
+	FileSize           int64       `json:"file_size"`
+	ExtractedText      pgtype.Text `json:"extracted_text"`
+	Status             string      `json:"status"`
+	Metadata           []byte      `json:"metadata"`
+	TeamID             pgtype.Int4 `json:"team_id"`
+	CreatedByAccountID pgtype.Int4 `json:"created_by_account_id"`
 }
 
 // Documents queries
@@ -81,6 +105,8 @@ func (q *Queries) CreateDocument(ctx context.Context, arg CreateDocumentParams)
 		arg.ExtractedText,
 		arg.Status,
 		arg.Metadata,
+		arg.TeamID,
+		arg.CreatedByAccountID,
 	)
 	var i DocumentsDocument
 	err := row.Scan(
@@ -96,6 +122,8 @@ func (q *Queries) CreateDocument(ctx context.Context, arg CreateDocumentParams)
 		&i.Metadata,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TeamID,
+		&i.CreatedByAccountID,
 	)
 	return i, err
 }
@@ -116,17 +144,25 @@ func (q *Queries) DeleteDocument(ctx context.Context, arg DeleteDocumentParams)
 }
 
 const getDocumentByFileAssetID = `-- name: GetDocumentByFileAssetID :one
-SELECT id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at FROM documents.documents
+SELECT id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at, team_id, created_by_account_id FROM documents.documents
 WHERE file_asset_id = $1 AND organization_id = $2
+    AND ($3::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = $3
+        ))
 `
 
 type GetDocumentByFileAssetIDParams struct {
-	FileAssetID    int32 `json:"file_asset_id"`
-	OrganizationID int32 `json:"organization_id"`
+	FileAssetID     int32       `json:"file_asset_id"`
+	OrganizationID  int32       `json:"organization_id"`
+	ViewerAccountID pgtype.Int4 `json:"viewer_account_id"`
 }
 
+// Team visibility as in ListDocumentsByOrganization
 func (q *Queries) GetDocumentByFileAssetID(ctx context.Context, arg GetDocumentByFileAssetIDParams) (DocumentsDocument, error) {
-	row := q.db.QueryRow(ctx, getDocumentByFileAssetID, arg.FileAssetID, arg.OrganizationID)
+	row := q.db.QueryRow(ctx, getDocumentByFileAssetID, arg.FileAssetID, arg.OrganizationID, arg.ViewerAccountID)
 	var i DocumentsDocument
 	err := row.Scan(
 		&i.ID,
@@ -141,22 +177,32 @@ func (q *Queries) GetDocumentByFileAssetID(ctx context.Context, arg GetDocumentB
 		&i.Metadata,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TeamID,
+		&i.CreatedByAccountID,
 	)
 	return i, err
 }
 
 const getDocumentByID = `-- name: GetDocumentByID :one
-SELECT id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at FROM documents.documents
+SELECT id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at, team_id, created_by_account_id FROM documents.documents
 WHERE id = $1 AND organization_id = $2
+    AND ($3::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = $3
+        ))
 `
 
 type GetDocumentByIDParams struct {
-	ID             int32 `json:"id"`
-	OrganizationID int32 `json:"organization_id"`
+	ID              int32       `json:"id"`
+	OrganizationID  int32       `json:"organization_id"`
+	ViewerAccountID pgtype.Int4 `json:"viewer_account_id"`
 }
 
+// Team visibility as in ListDocumentsByOrganization
 func (q *Queries) GetDocumentByID(ctx context.Context, arg GetDocumentByIDParams) (DocumentsDocument, error) {
-	row := q.db.QueryRow(ctx, getDocumentByID, arg.ID, arg.OrganizationID)
+	row := q.db.QueryRow(ctx, getDocumentByID, arg.ID, arg.OrganizationID, arg.ViewerAccountID)
 	var i DocumentsDocument
 	err := row.Scan(
 		&i.ID,
@@ -171,25 +217,41 @@ func (q *Queries) GetDocumentByID(ctx context.Context, arg GetDocumentByIDParams
 		&i.Metadata,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TeamID,
+		&i.CreatedByAccountID,
 	)
 	return i, err
 }
 
 const listDocumentsByOrganization = `-- name: ListDocumentsByOrganization :many
-SELECT id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at FROM documents.documents
+SELECT id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at, team_id, created_by_account_id FROM documents.documents
 WHERE organization_id = $1
+    AND ($2::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = $2
+        ))
 ORDER BY created_at DESC
-LIMIT $2 OFFSET $3
+LIMIT $3 OFFSET $4
 `
 
 type ListDocumentsByOrganizationParams struct {
-	OrganizationID int32 `json:"organization_id"`
-	Limit          int32 `json:"limit"`
-	Offset         int32 `json:"offset"`
+	OrganizationID  int32       `json:"organization_id"`
+	ViewerAccountID pgtype.Int4 `json:"viewer_account_id"`
+	Limit           int32       `json:"limit"`
+	Offset          int32       `json:"offset"`
 }
 
+// viewer_account_id limits results to documents without a team or owned by one
+// of the account's teams; NULL includes every team's documents (org-wide access).
 func (q *Queries) ListDocumentsByOrganization(ctx context.Context, arg ListDocumentsByOrganizationParams) ([]DocumentsDocument, error) {
-	rows, err := q.db.Query(ctx, listDocumentsByOrganization, arg.OrganizationID, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listDocumentsByOrganization,
+		arg.OrganizationID,
+		arg.ViewerAccountID,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -210,6 +272,9 @@ func (q *Queries) ListDocumentsByOrganization(ctx context.Context, arg ListDocum
 			&i.Metadata,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TeamID,
+			&i.CreatedByAccountID,
+			&i.CreatedByAccountID,
 		); err != nil {
 			return nil, err
 		}
@@ -222,23 +287,32 @@ func (q *Queries) ListDocumentsByOrganization(ctx context.Context, arg ListDocum
 }
 
 const listDocumentsByStatus = `-- name: ListDocumentsByStatus :many
-SELECT id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at FROM documents.documents
+SELECT id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at, team_id, created_by_account_id FROM documents.documents
 WHERE organization_id = $1 AND status = $2
+    AND ($3::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = $3
+        ))
 ORDER BY created_at DESC
-LIMIT $3 OFFSET $4
+LIMIT $4 OFFSET $5
 `
 
 type ListDocumentsByStatusParams struct {
-	OrganizationID int32  `json:"organization_id"`
-	Status         string `json:"status"`
-	Limit          int32  `json:"limit"`
-	Offset         int32  `json:"offset"`
+	OrganizationID  int32       `json:"organization_id"`
+	Status          string      `json:"status"`
+	ViewerAccountID pgtype.Int4 `json:"viewer_account_id"`
+	Limit           int32       `json:"limit"`
+	Offset          int32       `json:"offset"`
 }
 
+// Team visibility as in ListDocumentsByOrganization
 func (q *Queries) ListDocumentsByStatus(ctx context.Context, arg ListDocumentsByStatusParams) ([]DocumentsDocument, error) {
 	rows, err := q.db.Query(ctx, listDocumentsByStatus,
 		arg.OrganizationID,
 		arg.Status,
+		arg.ViewerAccountID,
 		arg.Limit,
 		arg.Offset,
 	)
@@ -262,6 +336,9 @@ func (q *Queries) ListDocumentsByStatus(ctx context.Context, arg ListDocumentsBy
 			&i.Metadata,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TeamID,
+			&i.CreatedByAccountID,
+			&i.CreatedByAccountID,
 		); err != nil {
 			return nil, err
 		}
@@ -280,7 +357,7 @@ SET
     metadata = COALESCE($4, metadata),
     updated_at = NOW()
 WHERE id = $1 AND organization_id = $2
-RETURNING id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at
+RETURNING id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at, team_id, created_by_account_id
 `
 
 type UpdateDocumentParams struct {
@@ -311,6 +388,8 @@ func (q *Queries) UpdateDocument(ctx context.Context, arg UpdateDocumentParams)
 		&i.Metadata,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TeamID,
+		&i.CreatedByAccountID,
 	)
 	return i, err
 }
@@ -319,7 +398,7 @@ const updateDocumentExtractedText = `-- name: UpdateDocumentExtractedText :one
 UPDATE documents.documents
 SET extracted_text = $3, status = 'processed', updated_at = NOW()
 WHERE id = $1 AND organization_id = $2
-RETURNING id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at
+RETURNING id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at, team_id, created_by_account_id
 `
 
 type UpdateDocumentExtractedTextParams struct {
@@ -344,6 +423,8 @@ func (q *Queries) UpdateDocumentExtractedText(ctx context.Context, arg UpdateDoc
 		&i.Metadata,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TeamID,
+		&i.CreatedByAccountID,
 	)
 	return i, err
 }
@@ -352,7 +433,7 @@ const updateDocumentStatus = `-- name: UpdateDocumentStatus :one
 UPDATE documents.documents
 SET status = $3, updated_at = NOW()
 WHERE id = $1 AND organization_id = $2
-RETURNING id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at
+RETURNING id, organization_id, file_asset_id, title, file_name, content_type, file_size, extracted_text, status, metadata, created_at, updated_at, team_id, created_by_account_id
 `
 
 type UpdateDocumentStatusParams struct {
@@ -377,6 +458,8 @@ func (q *Queries) UpdateDocumentStatus(ctx context.Context, arg UpdateDocumentSt
 		&i.Metadata,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TeamID,
+		&i.CreatedByAccountID,
 	)
 	return i, err
 }
@@ -56,22 +56,30 @@ WHERE organization_id = $1 AND is_active = true
     AND ($2::smallint IS NULL OR status_id = $2)
     AND ($3::varchar IS NULL OR approval_status = $3)
     AND ($4::text IS NULL OR title ILIKE '%' || $4 || '%' OR description ILIKE '%' || $4 || '%')
+    AND ($5::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = $5
+        ))
 `
 
 type CountResourcesParams struct {
-	OrganizationID int32  `json:"organization_id"`
-	Column2        int16  `json:"column_2"`
-	Column3        string `json:"column_3"`
-	Column4        string `json:"column_4"`
+	OrganizationID  int32       `json:"organization_id"`
+	StatusID        int16       `json:"status_id"`
+	ApprovalStatus  string      `json:"approval_status"`
+	Search          string      `json:"search"`
+	ViewerAccountID pgtype.Int4 `json:"viewer_account_id"`
 }
 
-// Count resources for pagination
+// Count resources for pagination (same filters and team visibility as ListResources)
 func (q *Queries) CountResources(ctx context.Context, arg CountResourcesParams) (int64, error) {
 	row := q.db.QueryRow(ctx, countResources,
 		arg.OrganizationID,
-		arg.Column2,
-		arg.Column3,
-		arg.Column4,
+		arg.StatusID,
+		arg.ApprovalStatus,
+		arg.Search,
+		arg.ViewerAccountID,
 	)
 	var count int64
 	err := row.Scan(&count)
@@ -83,7 +91,7 @@ INSERT INTO example_resources (
     resource_number, title, organization_id, created_by_account_id, status_id
 ) VALUES (
     $1, $2, $3, $4, 1
-) RETURNING id, resource_number, title, description, status_id, file_id, extracted_data, processed_data, confidence, organization_id, created_by_account_id, approval_status, approval_assigned_to_id, approval_action_taker_id, approval_notes, metadata, is_active, created_at, updated_at
+) RETURNING id, resource_number, title, description, status_id, file_id, extracted_data, processed_data, confidence, organization_id, created_by_account_id, approval_status, approval_assigned_to_id, approval_action_taker_id, approval_notes, metadata, is_active, created_at, updated_at, team_id
 `
 
 type CreateMinimalResourceParams struct {
@@ -122,6 +130,7 @@ func (q *Queries) CreateMinimalResource(ctx context.Context, arg CreateMinimalRe
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TeamID,
 	)
 	return i, err
 }
@@ -132,10 +141,10 @@ const createResource = `-- name: CreateResource :one
 INSERT INTO example_resources (
     resource_number, title, description, status_id, file_id,
     extracted_data, processed_data, confidence,
-    organization_id, created_by_account_id, metadata
+    organization_id, created_by_account_id, metadata, team_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
-) RETURNING id, resource_number, title, description, status_id, file_id, extracted_data, processed_data, confidence, organization_id, created_by_account_id, approval_status, approval_assigned_to_id, approval_action_taker_id, approval_notes, metadata, is_active, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+) RETURNING id, resource_number, title, description, status_id, file_id, extracted_data, processed_data, confidence, organization_id, created_by_account_id, approval_status, approval_assigned_to_id, approval_action_taker_id, approval_notes, metadata, is_active, created_at, updated_at, team_id
 `
 
 type CreateResourceParams struct {
@@ -150,6 +159,7 @@ type CreateResourceParams struct {
 	OrganizationID     int32          `json:"organization_id"`
 	CreatedByAccountID pgtype.Int4    `json:"created_by_account_id"`
 	Metadata           []byte         `json:"metadata"`
+	TeamID             pgtype.Int4    `json:"team_id"`
 }
 
 // Example Resource Queries
@@ -169,6 +179,7 @@ func (q *Queries) CreateResource(ctx context.Context, arg CreateResourceParams)
 		arg.OrganizationID,
 		arg.CreatedByAccountID,
 		arg.Metadata,
+		arg.TeamID,
 	)
 	var i ExampleResource
 	err := row.Scan(
@@ -191,6 +202,7 @@ func (q *Queries) CreateResource(ctx context.Context, arg CreateResourceParams)
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TeamID,
 	)
 	return i, err
 }
@@ -271,7 +283,7 @@ func (q *Queries) GetRecentResources(ctx context.Context, arg GetRecentResources
 
 const getResourceByID = `-- name: GetResourceByID :one
 
-SELECT id, resource_number, title, description, status_id, file_id, extracted_data, processed_data, confidence, organization_id, created_by_account_id, approval_status, approval_assigned_to_id, approval_action_taker_id, approval_notes, metadata, is_active, created_at, updated_at FROM example_resources
+SELECT id, resource_number, title, description, status_id, file_id, extracted_data, processed_data, confidence, organization_id, created_by_account_id, approval_status, approval_assigned_to_id, approval_action_taker_id, approval_notes, metadata, is_active, created_at, updated_at, team_id FROM example_resources
 WHERE id = $1 AND organization_id = $2 AND is_active = true
 `
 
@@ -304,12 +316,13 @@ func (q *Queries) GetResourceByID(ctx context.Context, arg GetResourceByIDParams
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TeamID,
 	)
 	return i, err
 }
 
 const getResourceByNumber = `-- name: GetResourceByNumber :one
-SELECT id, resource_number, title, description, status_id, file_id, extracted_data, processed_data, confidence, organization_id, created_by_account_id, approval_status, approval_assigned_to_id, approval_action_taker_id, approval_notes, metadata, is_active, created_at, updated_at FROM example_resources
+SELECT id, resource_number, title, description, status_id, file_id, extracted_data, processed_data, confidence, organization_id, created_by_account_id, approval_status, approval_assigned_to_id, approval_action_taker_id, approval_notes, metadata, is_active, created_at, updated_at, team_id FROM example_resources
 WHERE resource_number = $1 AND organization_id = $2 AND is_active = true
 `
 
@@ -341,6 +354,7 @@ func (q *Queries) GetResourceByNumber(ctx context.Context, arg GetResourceByNumb
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TeamID,
 	)
 	return i, err
 }
@@ -387,7 +401,7 @@ func (q *Queries) GetResourceStats(ctx context.Context, organizationID int32) (G
 }
 
 const getResourcesByCreator = `-- name: GetResourcesByCreator :many
-SELECT id, resource_number, title, description, status_id, file_id, extracted_data, processed_data, confidence, organization_id, created_by_account_id, approval_status, approval_assigned_to_id, approval_action_taker_id, approval_notes, metadata, is_active, created_at, updated_at FROM example_resources
+SELECT id, resource_number, title, description, status_id, file_id, extracted_data, processed_data, confidence, organization_id, created_by_account_id, approval_status, approval_assigned_to_id, approval_action_taker_id, approval_notes, metadata, is_active, created_at, updated_at, team_id FROM example_resources
 WHERE organization_id = $1
     AND created_by_account_id = $2
     AND is_active = true
@@ -437,6 +451,7 @@ func (q *Queries) GetResourcesByCreator(ctx context.Context, arg GetResourcesByC
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TeamID,
 		); err != nil {
 			return nil, err
 		}
@@ -468,24 +483,31 @@ const listResources = `-- name: ListResources :many
 SELECT
     id, resource_number, title, description, status_id, file_id,
     confidence, organization_id, created_by_account_id,
-    approval_status, approval_assigned_to_id,
+    approval_status, approval_assigned_to_id, team_id,
     is_active, created_at, updated_at
 FROM example_resources
 WHERE organization_id = $1 AND is_active = true
     AND ($2::smallint IS NULL OR status_id = $2)
     AND ($3::varchar IS NULL OR approval_status = $3)
     AND ($4::text IS NULL OR title ILIKE '%' || $4 || '%' OR description ILIKE '%' || $4 || '%')
+    AND ($5::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = $5
+        ))
 ORDER BY created_at DESC
-LIMIT $5 OFFSET $6
+LIMIT $6 OFFSET $7
 `
 
 type ListResourcesParams struct {
-	OrganizationID int32  `json:"organization_id"`
-	Column2        int16  `json:"column_2"`
-	Column3        string `json:"column_3"`
-	Column4        string `json:"column_4"`
-	Limit          int32  `json:"limit"`
-	Offset         int32  `json:"offset"`
+	OrganizationID  int32       `json:"organization_id"`
+	StatusID        int16       `json:"status_id"`
+	ApprovalStatus  string      `json:"approval_status"`
+	Search          string      `json:"search"`
+	ViewerAccountID pgtype.Int4 `json:"viewer_account_id"`
+	Limit           int32       `json:"limit"`
+	Offset          int32       `json:"offset"`
 }
 
 type ListResourcesRow struct {
@@ -500,18 +522,22 @@ type ListResourcesRow struct {
 	CreatedByAccountID   pgtype.Int4      `json:"created_by_account_id"`
 	ApprovalStatus       pgtype.Text      `json:"approval_status"`
 	ApprovalAssignedToID pgtype.Int4      `json:"approval_assigned_to_id"`
+	TeamID               pgtype.Int4      `json:"team_id"`
 	IsActive             bool             `json:"is_active"`
 	CreatedAt            pgtype.Timestamp `json:"created_at"`
 	UpdatedAt            pgtype.Timestamp `json:"updated_at"`
 }
 
-// List resources with filtering and pagination
+// List resources with filtering and pagination. viewer_account_id limits
+// results to resources without a team or owned by one of the account's teams;
+// NULL includes every team's resources (org-wide access).
 func (q *Queries) ListResources(ctx context.Context, arg ListResourcesParams) ([]ListResourcesRow, error) {
 	rows, err := q.db.Query(ctx, listResources,
 		arg.OrganizationID,
-		arg.Column2,
-		arg.Column3,
-		arg.Column4,
+		arg.StatusID,
+		arg.ApprovalStatus,
+		arg.Search,
+		arg.ViewerAccountID,
 		arg.Limit,
 		arg.Offset,
 	)
@@ -534,6 +560,7 @@ func (q *Queries) ListResources(ctx context.Context, arg ListResourcesParams) ([
 			&i.CreatedByAccountID,
 			&i.ApprovalStatus,
 			&i.ApprovalAssignedToID,
+			&i.TeamID,
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -558,15 +585,22 @@ FROM example_resources
 WHERE organization_id = $1
     AND is_active = true
     AND to_tsvector('english', coalesce(title, '') || ' ' || coalesce(description, '')) @@ to_tsquery('english', $2)
+    AND ($3::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = $3
+        ))
 ORDER BY rank DESC, created_at DESC
-LIMIT $3 OFFSET $4
+LIMIT $4 OFFSET $5
 `
 
 type SearchResourcesByTextParams struct {
-	OrganizationID int32  `json:"organization_id"`
-	ToTsquery      string `json:"to_tsquery"`
-	Limit          int32  `json:"limit"`
-	Offset         int32  `json:"offset"`
+	OrganizationID  int32       `json:"organization_id"`
+	Query           string      `json:"query"`
+	ViewerAccountID pgtype.Int4 `json:"viewer_account_id"`
+	Limit           int32       `json:"limit"`
+	Offset          int32       `json:"offset"`
 }
 
 type SearchResourcesByTextRow struct {
@@ -582,11 +616,12 @@ type SearchResourcesByTextRow struct {
 }
 
 // SEARCH operations
-// Full-text search on title and description
+// Full-text search on title and description (team visibility as in ListResources)
 func (q *Queries) SearchResourcesByText(ctx context.Context, arg SearchResourcesByTextParams) ([]SearchResourcesByTextRow, error) {
 	rows, err := q.db.Query(ctx, searchResourcesByText,
 		arg.OrganizationID,
-		arg.ToTsquery,
+		arg.Query,
+		arg.ViewerAccountID,
 		arg.Limit,
 		arg.Offset,
 	)
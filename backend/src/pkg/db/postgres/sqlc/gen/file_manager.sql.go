@@ -533,3 +533,21 @@ func (q *Queries) UpdateFileAsset(ctx context.Context, arg UpdateFileAssetParams
 	)
 	return err
 }
+
+const updateFileAssetContext = `-- name: UpdateFileAssetContext :exec
+UPDATE file_manager.file_assets
+SET
+    file_context_id = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+type UpdateFileAssetContextParams struct {
+	ID            int32 `json:"id"`
+	FileContextID int16 `json:"file_context_id"`
+}
+
+func (q *Queries) UpdateFileAssetContext(ctx context.Context, arg UpdateFileAssetContextParams) error {
+	_, err := q.db.Exec(ctx, updateFileAssetContext, arg.ID, arg.FileContextID)
+	return err
+}
@@ -9,6 +9,27 @@ import (
 	pgvector_go "github.com/pgvector/pgvector-go"
 )
 
+// Append-only, per-organization hash-chained audit events
+type AuditEvent struct {
+	ID             int64              `json:"id"`
+	OrganizationID int32              `json:"organization_id"`
+	Sequence       int64              `json:"sequence"`
+	OccurredAt     pgtype.Timestamptz `json:"occurred_at"`
+	ActorType      string             `json:"actor_type"`
+	ActorAccountID pgtype.Int4        `json:"actor_account_id"`
+	ActorEmail     pgtype.Text        `json:"actor_email"`
+	Action         string             `json:"action"`
+	TargetType     pgtype.Text        `json:"target_type"`
+	TargetID       pgtype.Text        `json:"target_id"`
+	IpAddress      pgtype.Text        `json:"ip_address"`
+	UserAgent      pgtype.Text        `json:"user_agent"`
+	RequestID      pgtype.Text        `json:"request_id"`
+	Changes        []byte             `json:"changes"`
+	Metadata       []byte             `json:"metadata"`
+	PrevHash       string             `json:"prev_hash"`
+	Hash           string             `json:"hash"`
+}
+
 // Messages within chat sessions with role (user/assistant/system)
 type CognitiveChatMessage struct {
 	ID             int32            `json:"id"`
@@ -61,6 +82,81 @@ type DocumentsDocument struct {
 	Metadata  []byte           `json:"metadata"`
 	CreatedAt pgtype.Timestamp `json:"created_at"`
 	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+	// Owning team; NULL means visible to the whole organization
+	TeamID pgtype.Int4 `json:"team_id"`
+	// Account that uploaded the document; NULL for documents created before ownership was recorded
+	CreatedByAccountID pgtype.Int4 `json:"created_by_account_id"`
+}
+
+// Fields extracted from a document, one row per top-level schema property
+type DocumentsDocumentField struct {
+	ID             int32  `json:"id"`
+	DocumentID     int32  `json:"document_id"`
+	OrganizationID int32  `json:"organization_id"`
+	Name           string `json:"name"`
+	// Extracted JSON value; follows the field schema of the document type
+	Value []byte `json:"value"`
+	// Extractor confidence in the value, 0-1
+	Confidence float32 `json:"confidence"`
+	// Page the value was read from; NULL when unknown
+	PageNumber pgtype.Int4      `json:"page_number"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+}
+
+// Document processing jobs claimed by the worker pool
+type DocumentsDocumentJob struct {
+	ID             int32 `json:"id"`
+	DocumentID     int32 `json:"document_id"`
+	OrganizationID int32 `json:"organization_id"`
+	// Job status: queued, running, succeeded, failed
+	Status      string `json:"status"`
+	Attempts    int32  `json:"attempts"`
+	MaxAttempts int32  `json:"max_attempts"`
+	// Earliest time a queued job may be claimed (retry backoff)
+	RunAfter pgtype.Timestamp `json:"run_after"`
+	LockedBy pgtype.Text      `json:"locked_by"`
+	// Lease expiry of a running job; expired jobs are requeued
+	LockedUntil pgtype.Timestamp `json:"locked_until"`
+	LastError   pgtype.Text      `json:"last_error"`
+	StartedAt   pgtype.Timestamp `json:"started_at"`
+	FinishedAt  pgtype.Timestamp `json:"finished_at"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+}
+
+// Per-page OCR text and layout of a document
+type DocumentsDocumentPage struct {
+	ID             int32  `json:"id"`
+	DocumentID     int32  `json:"document_id"`
+	OrganizationID int32  `json:"organization_id"`
+	PageNumber     int32  `json:"page_number"`
+	Text           string `json:"text"`
+	// Extractor that produced the page: pdf_text, mistral, tesseract
+	Extractor string `json:"extractor"`
+	// Page width in points or pixels as reported by the extractor; 0 when unknown
+	Width  float32 `json:"width"`
+	Height float32 `json:"height"`
+	// Text blocks with bounding boxes relative to the page (0-1, origin top-left)
+	Blocks []byte `json:"blocks"`
+	// Tables detected on the page, as rows of cells
+	Tables    []byte           `json:"tables"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
+
+// Custom document types of an organization, used by the document classifier
+type DocumentsDocumentType struct {
+	ID             int32 `json:"id"`
+	OrganizationID int32 `json:"organization_id"`
+	// Type name stored in document metadata; lowercase letters, digits and underscores
+	Name string `json:"name"`
+	// What documents of this type contain; part of the classification prompt
+	Description string           `json:"description"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	// JSON schema of the fields extracted from documents of this type; NULL extracts none
+	ExtractionSchema []byte `json:"extraction_schema"`
 }
 
 // Stores potential duplicate resources found via vector similarity and LLM adjudication
@@ -107,6 +203,8 @@ type ExampleResource struct {
 	IsActive              bool             `json:"is_active"`
 	CreatedAt             pgtype.Timestamp `json:"created_at"`
 	UpdatedAt             pgtype.Timestamp `json:"updated_at"`
+	// Owning team; NULL means visible to the whole organization
+	TeamID pgtype.Int4 `json:"team_id"`
 }
 
 type FileManagerFileAsset struct {
@@ -139,6 +237,27 @@ type FileManagerFileContext struct {
 	Name string `json:"name"`
 }
 
+// Asynchronous OCR jobs and their results
+type OcrJob struct {
+	ID int32 `json:"id"`
+	// Organization that submitted the job; every lookup is scoped to it
+	OrganizationID int32 `json:"organization_id"`
+	// Job status: queued, running, succeeded, failed, canceled
+	Status     string `json:"status"`
+	MimeType   string `json:"mime_type"`
+	TotalPages int32  `json:"total_pages"`
+	// Pages extracted so far, updated as page ranges finish
+	CompletedPages int32       `json:"completed_pages"`
+	CallbackUrl    pgtype.Text `json:"callback_url"`
+	// OCR response of a succeeded job
+	Result     []byte           `json:"result"`
+	Error      pgtype.Text      `json:"error"`
+	StartedAt  pgtype.Timestamp `json:"started_at"`
+	FinishedAt pgtype.Timestamp `json:"finished_at"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+}
+
 // User accounts within organizations
 type OrganizationsAccount struct {
 	ID             int32  `json:"id"`
@@ -178,6 +297,62 @@ type OrganizationsOrganization struct {
 	UpdatedAt            pgtype.Timestamp `json:"updated_at"`
 }
 
+// Email domains claimed by organizations (DNS TXT verification, JIT provisioning)
+// Email domains claimed by organizations (DNS TXT verification, JIT provisioning)
+type OrganizationsOrganizationDomain struct {
+	ID             int32  `json:"id"`
+	OrganizationID int32  `json:"organization_id"`
+	Domain         string `json:"domain"`
+	// Token the organization publishes in a DNS TXT record to prove ownership
+	VerificationToken string `json:"verification_token"`
+	// When ownership was verified; NULL while pending
+	VerifiedAt pgtype.Timestamp `json:"verified_at"`
+	// Auto-add users from this domain on first login (verified domains only)
+	JitEnabled bool `json:"jit_enabled"`
+	// Role given to just-in-time provisioned members
+	DefaultRole string           `json:"default_role"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+}
+
+// Per-organization security policy (MFA, allowed auth methods, IP allowlist)
+type OrganizationsOrganizationSecurityPolicy struct {
+	OrganizationID int32 `json:"organization_id"`
+	RequireMfa     bool  `json:"require_mfa"`
+	// Login methods allowed for members (e.g. sso, password); empty allows all
+	AllowedAuthMethods []string `json:"allowed_auth_methods"`
+	// CIDR blocks clients must connect from; empty allows all
+	IpAllowlist []string         `json:"ip_allowlist"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+}
+
+// Per-organization settings (policy flags, tenant preferences)
+type OrganizationsOrganizationSetting struct {
+	OrganizationID int32            `json:"organization_id"`
+	Settings       []byte           `json:"settings"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+}
+
+// Teams (departments) within an organization
+type OrganizationsTeam struct {
+	ID             int32            `json:"id"`
+	OrganizationID int32            `json:"organization_id"`
+	Name           string           `json:"name"`
+	Description    pgtype.Text      `json:"description"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+}
+
+// Team membership and team role
+type OrganizationsTeamMember struct {
+	TeamID    int32 `json:"team_id"`
+	AccountID int32 `json:"account_id"`
+	// Team role: lead (manages the team) or member
+	Role      string           `json:"role"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
+
 // Stores vector embeddings for resources using OpenAI text-embedding-3-small (1536 dimensions)
 type ResourceEmbedding struct {
 	ID         int32 `json:"id"`
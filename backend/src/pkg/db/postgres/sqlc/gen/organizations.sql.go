@@ -172,6 +172,85 @@ func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganization
 	return i, err
 }
 
+const createOrganizationDomain = `-- name: CreateOrganizationDomain :one
+
+INSERT INTO organizations.organization_domains (
+    organization_id,
+    domain,
+    verification_token,
+    jit_enabled,
+    default_role
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING id, organization_id, domain, verification_token, verified_at, jit_enabled, default_role, created_at, updated_at
+`
+
+type CreateOrganizationDomainParams struct {
+	OrganizationID    int32  `json:"organization_id"`
+	Domain            string `json:"domain"`
+	VerificationToken string `json:"verification_token"`
+	JitEnabled        bool   `json:"jit_enabled"`
+	DefaultRole       string `json:"default_role"`
+}
+
+// Organization domain queries
+func (q *Queries) CreateOrganizationDomain(ctx context.Context, arg CreateOrganizationDomainParams) (OrganizationsOrganizationDomain, error) {
+	row := q.db.QueryRow(ctx, createOrganizationDomain,
+		arg.OrganizationID,
+		arg.Domain,
+		arg.VerificationToken,
+		arg.JitEnabled,
+		arg.DefaultRole,
+	)
+	var i OrganizationsOrganizationDomain
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Domain,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.JitEnabled,
+		&i.DefaultRole,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createTeam = `-- name: CreateTeam :one
+
+INSERT INTO organizations.teams (
+    organization_id,
+    name,
+    description
+) VALUES (
+    $1, $2, $3
+)
+RETURNING id, organization_id, name, description, created_at, updated_at
+`
+
+type CreateTeamParams struct {
+	OrganizationID int32       `json:"organization_id"`
+	Name           string      `json:"name"`
+	Description    pgtype.Text `json:"description"`
+}
+
+// Team queries
+func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (OrganizationsTeam, error) {
+	row := q.db.QueryRow(ctx, createTeam, arg.OrganizationID, arg.Name, arg.Description)
+	var i OrganizationsTeam
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const deleteAccount = `-- name: DeleteAccount :exec
 UPDATE organizations.accounts
 SET
@@ -200,6 +279,51 @@ func (q *Queries) DeleteOrganization(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteOrganizationDomain = `-- name: DeleteOrganizationDomain :exec
+DELETE FROM organizations.organization_domains
+WHERE id = $1 AND organization_id = $2
+`
+
+type DeleteOrganizationDomainParams struct {
+	ID             int32 `json:"id"`
+	OrganizationID int32 `json:"organization_id"`
+}
+
+func (q *Queries) DeleteOrganizationDomain(ctx context.Context, arg DeleteOrganizationDomainParams) error {
+	_, err := q.db.Exec(ctx, deleteOrganizationDomain, arg.ID, arg.OrganizationID)
+	return err
+}
+
+const deleteTeam = `-- name: DeleteTeam :exec
+DELETE FROM organizations.teams
+WHERE id = $1 AND organization_id = $2
+`
+
+type DeleteTeamParams struct {
+	ID             int32 `json:"id"`
+	OrganizationID int32 `json:"organization_id"`
+}
+
+func (q *Queries) DeleteTeam(ctx context.Context, arg DeleteTeamParams) error {
+	_, err := q.db.Exec(ctx, deleteTeam, arg.ID, arg.OrganizationID)
+	return err
+}
+
+const deleteTeamMember = `-- name: DeleteTeamMember :exec
+DELETE FROM organizations.team_members
+WHERE team_id = $1 AND account_id = $2
+`
+
+type DeleteTeamMemberParams struct {
+	TeamID    int32 `json:"team_id"`
+	AccountID int32 `json:"account_id"`
+}
+
+func (q *Queries) DeleteTeamMember(ctx context.Context, arg DeleteTeamMemberParams) error {
+	_, err := q.db.Exec(ctx, deleteTeamMember, arg.TeamID, arg.AccountID)
+	return err
+}
+
 const getAccountByEmail = `-- name: GetAccountByEmail :one
 SELECT
     id,
@@ -520,6 +644,74 @@ func (q *Queries) GetOrganizationByUserEmail(ctx context.Context, email string)
 	return i, err
 }
 
+const getOrganizationDomain = `-- name: GetOrganizationDomain :one
+SELECT id, organization_id, domain, verification_token, verified_at, jit_enabled, default_role, created_at, updated_at
+FROM organizations.organization_domains
+WHERE id = $1 AND organization_id = $2
+`
+
+type GetOrganizationDomainParams struct {
+	ID             int32 `json:"id"`
+	OrganizationID int32 `json:"organization_id"`
+}
+
+func (q *Queries) GetOrganizationDomain(ctx context.Context, arg GetOrganizationDomainParams) (OrganizationsOrganizationDomain, error) {
+	row := q.db.QueryRow(ctx, getOrganizationDomain, arg.ID, arg.OrganizationID)
+	var i OrganizationsOrganizationDomain
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Domain,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.JitEnabled,
+		&i.DefaultRole,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getOrganizationSecurityPolicy = `-- name: GetOrganizationSecurityPolicy :one
+
+SELECT organization_id, require_mfa, allowed_auth_methods, ip_allowlist, updated_at
+FROM organizations.organization_security_policies
+WHERE organization_id = $1
+`
+
+// Organization security policy queries
+func (q *Queries) GetOrganizationSecurityPolicy(ctx context.Context, organizationID int32) (OrganizationsOrganizationSecurityPolicy, error) {
+	row := q.db.QueryRow(ctx, getOrganizationSecurityPolicy, organizationID)
+	var i OrganizationsOrganizationSecurityPolicy
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.RequireMfa,
+		&i.AllowedAuthMethods,
+		&i.IpAllowlist,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getOrganizationSettings = `-- name: GetOrganizationSettings :one
+
+SELECT organization_id, settings, updated_at
+FROM organizations.organization_settings
+WHERE organization_id = $1
+`
+
+// Organization settings queries
+func (q *Queries) GetOrganizationSettings(ctx context.Context, organizationID int32) (OrganizationsOrganizationSetting, error) {
+	row := q.db.QueryRow(ctx, getOrganizationSettings, organizationID)
+	var i OrganizationsOrganizationSetting
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.Settings,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getOrganizationStats = `-- name: GetOrganizationStats :one
 
 SELECT
@@ -574,6 +766,78 @@ func (q *Queries) GetOrganizationStats(ctx context.Context, id int32) (GetOrgani
 	return i, err
 }
 
+const getTeam = `-- name: GetTeam :one
+SELECT id, organization_id, name, description, created_at, updated_at
+FROM organizations.teams
+WHERE id = $1 AND organization_id = $2
+`
+
+type GetTeamParams struct {
+	ID             int32 `json:"id"`
+	OrganizationID int32 `json:"organization_id"`
+}
+
+func (q *Queries) GetTeam(ctx context.Context, arg GetTeamParams) (OrganizationsTeam, error) {
+	row := q.db.QueryRow(ctx, getTeam, arg.ID, arg.OrganizationID)
+	var i OrganizationsTeam
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTeamMember = `-- name: GetTeamMember :one
+SELECT team_id, account_id, role, created_at, updated_at
+FROM organizations.team_members
+WHERE team_id = $1 AND account_id = $2
+`
+
+type GetTeamMemberParams struct {
+	TeamID    int32 `json:"team_id"`
+	AccountID int32 `json:"account_id"`
+}
+
+func (q *Queries) GetTeamMember(ctx context.Context, arg GetTeamMemberParams) (OrganizationsTeamMember, error) {
+	row := q.db.QueryRow(ctx, getTeamMember, arg.TeamID, arg.AccountID)
+	var i OrganizationsTeamMember
+	err := row.Scan(
+		&i.TeamID,
+		&i.AccountID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getVerifiedOrganizationDomain = `-- name: GetVerifiedOrganizationDomain :one
+SELECT id, organization_id, domain, verification_token, verified_at, jit_enabled, default_role, created_at, updated_at
+FROM organizations.organization_domains
+WHERE domain = $1 AND verified_at IS NOT NULL
+`
+
+func (q *Queries) GetVerifiedOrganizationDomain(ctx context.Context, domain string) (OrganizationsOrganizationDomain, error) {
+	row := q.db.QueryRow(ctx, getVerifiedOrganizationDomain, domain)
+	var i OrganizationsOrganizationDomain
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Domain,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.JitEnabled,
+		&i.DefaultRole,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const listAccountsByOrganization = `-- name: ListAccountsByOrganization :many
 SELECT
     id,
@@ -628,6 +892,43 @@ func (q *Queries) ListAccountsByOrganization(ctx context.Context, organizationID
 	return items, nil
 }
 
+const listOrganizationDomains = `-- name: ListOrganizationDomains :many
+SELECT id, organization_id, domain, verification_token, verified_at, jit_enabled, default_role, created_at, updated_at
+FROM organizations.organization_domains
+WHERE organization_id = $1
+ORDER BY domain
+`
+
+func (q *Queries) ListOrganizationDomains(ctx context.Context, organizationID int32) ([]OrganizationsOrganizationDomain, error) {
+	rows, err := q.db.Query(ctx, listOrganizationDomains, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrganizationsOrganizationDomain{}
+	for rows.Next() {
+		var i OrganizationsOrganizationDomain
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Domain,
+			&i.VerificationToken,
+			&i.VerifiedAt,
+			&i.JitEnabled,
+			&i.DefaultRole,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOrganizations = `-- name: ListOrganizations :many
 SELECT
     id,
@@ -679,6 +980,164 @@ func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsPa
 	return items, nil
 }
 
+const listTeamMembers = `-- name: ListTeamMembers :many
+SELECT
+    tm.team_id,
+    tm.account_id,
+    tm.role,
+    tm.created_at,
+    tm.updated_at,
+    a.email,
+    a.full_name
+FROM organizations.team_members tm
+JOIN organizations.accounts a ON a.id = tm.account_id
+WHERE tm.team_id = $1
+ORDER BY a.full_name, a.email
+`
+
+type ListTeamMembersRow struct {
+	TeamID    int32            `json:"team_id"`
+	AccountID int32            `json:"account_id"`
+	Role      string           `json:"role"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+	Email     string           `json:"email"`
+	FullName  string           `json:"full_name"`
+}
+
+func (q *Queries) ListTeamMembers(ctx context.Context, teamID int32) ([]ListTeamMembersRow, error) {
+	rows, err := q.db.Query(ctx, listTeamMembers, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTeamMembersRow{}
+	for rows.Next() {
+		var i ListTeamMembersRow
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.AccountID,
+			&i.Role,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Email,
+			&i.FullName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeams = `-- name: ListTeams :many
+SELECT id, organization_id, name, description, created_at, updated_at
+FROM organizations.teams
+WHERE organization_id = $1
+ORDER BY name
+`
+
+func (q *Queries) ListTeams(ctx context.Context, organizationID int32) ([]OrganizationsTeam, error) {
+	rows, err := q.db.Query(ctx, listTeams, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrganizationsTeam{}
+	for rows.Next() {
+		var i OrganizationsTeam
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamsByAccount = `-- name: ListTeamsByAccount :many
+SELECT t.id, t.organization_id, t.name, t.description, t.created_at, t.updated_at
+FROM organizations.teams t
+JOIN organizations.team_members tm ON tm.team_id = t.id
+WHERE t.organization_id = $1 AND tm.account_id = $2
+ORDER BY t.name
+`
+
+type ListTeamsByAccountParams struct {
+	OrganizationID int32 `json:"organization_id"`
+	AccountID      int32 `json:"account_id"`
+}
+
+func (q *Queries) ListTeamsByAccount(ctx context.Context, arg ListTeamsByAccountParams) ([]OrganizationsTeam, error) {
+	rows, err := q.db.Query(ctx, listTeamsByAccount, arg.OrganizationID, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrganizationsTeam{}
+	for rows.Next() {
+		var i OrganizationsTeam
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markOrganizationDomainVerified = `-- name: MarkOrganizationDomainVerified :one
+UPDATE organizations.organization_domains
+SET
+    verified_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND organization_id = $2
+RETURNING id, organization_id, domain, verification_token, verified_at, jit_enabled, default_role, created_at, updated_at
+`
+
+type MarkOrganizationDomainVerifiedParams struct {
+	ID             int32 `json:"id"`
+	OrganizationID int32 `json:"organization_id"`
+}
+
+func (q *Queries) MarkOrganizationDomainVerified(ctx context.Context, arg MarkOrganizationDomainVerifiedParams) (OrganizationsOrganizationDomain, error) {
+	row := q.db.QueryRow(ctx, markOrganizationDomainVerified, arg.ID, arg.OrganizationID)
+	var i OrganizationsOrganizationDomain
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Domain,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.JitEnabled,
+		&i.DefaultRole,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateAccount = `-- name: UpdateAccount :one
 UPDATE organizations.accounts
 SET
@@ -912,6 +1371,45 @@ func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganization
 	return i, err
 }
 
+const updateOrganizationDomainJIT = `-- name: UpdateOrganizationDomainJIT :one
+UPDATE organizations.organization_domains
+SET
+    jit_enabled = $3,
+    default_role = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND organization_id = $2
+RETURNING id, organization_id, domain, verification_token, verified_at, jit_enabled, default_role, created_at, updated_at
+`
+
+type UpdateOrganizationDomainJITParams struct {
+	ID             int32  `json:"id"`
+	OrganizationID int32  `json:"organization_id"`
+	JitEnabled     bool   `json:"jit_enabled"`
+	DefaultRole    string `json:"default_role"`
+}
+
+func (q *Queries) UpdateOrganizationDomainJIT(ctx context.Context, arg UpdateOrganizationDomainJITParams) (OrganizationsOrganizationDomain, error) {
+	row := q.db.QueryRow(ctx, updateOrganizationDomainJIT,
+		arg.ID,
+		arg.OrganizationID,
+		arg.JitEnabled,
+		arg.DefaultRole,
+	)
+	var i OrganizationsOrganizationDomain
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Domain,
+		&i.VerificationToken,
+		&i.VerifiedAt,
+		&i.JitEnabled,
+		&i.DefaultRole,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateOrganizationStytchInfo = `-- name: UpdateOrganizationStytchInfo :one
 UPDATE organizations.organizations
 SET
@@ -960,3 +1458,143 @@ func (q *Queries) UpdateOrganizationStytchInfo(ctx context.Context, arg UpdateOr
 	)
 	return i, err
 }
+
+const updateTeam = `-- name: UpdateTeam :one
+UPDATE organizations.teams
+SET
+    name = $3,
+    description = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND organization_id = $2
+RETURNING id, organization_id, name, description, created_at, updated_at
+`
+
+type UpdateTeamParams struct {
+	ID             int32       `json:"id"`
+	OrganizationID int32       `json:"organization_id"`
+	Name           string      `json:"name"`
+	Description    pgtype.Text `json:"description"`
+}
+
+func (q *Queries) UpdateTeam(ctx context.Context, arg UpdateTeamParams) (OrganizationsTeam, error) {
+	row := q.db.QueryRow(ctx, updateTeam,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Description,
+	)
+	var i OrganizationsTeam
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertOrganizationSecurityPolicy = `-- name: UpsertOrganizationSecurityPolicy :one
+INSERT INTO organizations.organization_security_policies (
+    organization_id,
+    require_mfa,
+    allowed_auth_methods,
+    ip_allowlist
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (organization_id) DO UPDATE SET
+    require_mfa = EXCLUDED.require_mfa,
+    allowed_auth_methods = EXCLUDED.allowed_auth_methods,
+    ip_allowlist = EXCLUDED.ip_allowlist,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING organization_id, require_mfa, allowed_auth_methods, ip_allowlist, updated_at
+`
+
+type UpsertOrganizationSecurityPolicyParams struct {
+	OrganizationID     int32    `json:"organization_id"`
+	RequireMfa         bool     `json:"require_mfa"`
+	AllowedAuthMethods []string `json:"allowed_auth_methods"`
+	IpAllowlist        []string `json:"ip_allowlist"`
+}
+
+func (q *Queries) UpsertOrganizationSecurityPolicy(ctx context.Context, arg UpsertOrganizationSecurityPolicyParams) (OrganizationsOrganizationSecurityPolicy, error) {
+	row := q.db.QueryRow(ctx, upsertOrganizationSecurityPolicy,
+		arg.OrganizationID,
+		arg.RequireMfa,
+		arg.AllowedAuthMethods,
+		arg.IpAllowlist,
+	)
+	var i OrganizationsOrganizationSecurityPolicy
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.RequireMfa,
+		&i.AllowedAuthMethods,
+		&i.IpAllowlist,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertOrganizationSettings = `-- name: UpsertOrganizationSettings :one
+INSERT INTO organizations.organization_settings (
+    organization_id,
+    settings
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (organization_id) DO UPDATE SET
+    settings = EXCLUDED.settings,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING organization_id, settings, updated_at
+`
+
+type UpsertOrganizationSettingsParams struct {
+	OrganizationID int32  `json:"organization_id"`
+	Settings       []byte `json:"settings"`
+}
+
+func (q *Queries) UpsertOrganizationSettings(ctx context.Context, arg UpsertOrganizationSettingsParams) (OrganizationsOrganizationSetting, error) {
+	row := q.db.QueryRow(ctx, upsertOrganizationSettings, arg.OrganizationID, arg.Settings)
+	var i OrganizationsOrganizationSetting
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.Settings,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertTeamMember = `-- name: UpsertTeamMember :one
+INSERT INTO organizations.team_members (
+    team_id,
+    account_id,
+    role
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (team_id, account_id) DO UPDATE SET
+    role = EXCLUDED.role,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING team_id, account_id, role, created_at, updated_at
+`
+
+type UpsertTeamMemberParams struct {
+	TeamID    int32  `json:"team_id"`
+	AccountID int32  `json:"account_id"`
+	Role      string `json:"role"`
+}
+
+func (q *Queries) UpsertTeamMember(ctx context.Context, arg UpsertTeamMemberParams) (OrganizationsTeamMember, error) {
+	row := q.db.QueryRow(ctx, upsertTeamMember, arg.TeamID, arg.AccountID, arg.Role)
+	var i OrganizationsTeamMember
+	err := row.Scan(
+		&i.TeamID,
+		&i.AccountID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -15,12 +15,23 @@ type Querier interface {
 	AssignResourceApproval(ctx context.Context, arg AssignResourceApprovalParams) error
 	// Attach a file to a resource
 	AttachFileToResource(ctx context.Context, arg AttachFileToResourceParams) error
+	CancelOCRJob(ctx context.Context, arg CancelOCRJobParams) (int64, error)
 	CheckAccountPermission(ctx context.Context, arg CheckAccountPermissionParams) (CheckAccountPermissionRow, error)
+	// Claims the oldest runnable job of an organization below its concurrency cap.
+	// Concurrent claims skip each other's rows, so the cap can be exceeded briefly
+	// by at most the number of workers claiming at the same instant.
+	ClaimDocumentJob(ctx context.Context, arg ClaimDocumentJobParams) (DocumentsDocumentJob, error)
+	CompleteDocumentJob(ctx context.Context, arg CompleteDocumentJobParams) (int64, error)
+	CompleteOCRJob(ctx context.Context, arg CompleteOCRJobParams) (int64, error)
 	CountChatMessagesBySession(ctx context.Context, sessionID int32) (int64, error)
 	CountDocumentEmbeddingsByOrganization(ctx context.Context, organizationID int32) (int64, error)
-	CountDocumentsByOrganization(ctx context.Context, organizationID int32) (int64, error)
+	CountDocumentPages(ctx context.Context, arg CountDocumentPagesParams) (int64, error)
+	CountDocumentTypes(ctx context.Context, organizationID int32) (int64, error)
+	// Team visibility as in ListDocumentsByOrganization
+	CountDocumentsByOrganization(ctx context.Context, arg CountDocumentsByOrganizationParams) (int64, error)
+	// Team visibility as in ListDocumentsByOrganization
 	CountDocumentsByStatus(ctx context.Context, arg CountDocumentsByStatusParams) (int64, error)
-	// Count resources for pagination
+	// Count resources for pagination (same filters and team visibility as ListResources)
 	CountResources(ctx context.Context, arg CountResourcesParams) (int64, error)
 	// Accounts queries
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (OrganizationsAccount, error)
@@ -33,15 +44,27 @@ type Querier interface {
 	// Cognitive Agent queries
 	// Document Embeddings
 	CreateDocumentEmbedding(ctx context.Context, arg CreateDocumentEmbeddingParams) (CognitiveDocumentEmbedding, error)
+	// Document type queries
+	CreateDocumentType(ctx context.Context, arg CreateDocumentTypeParams) (DocumentsDocumentType, error)
 	CreateFileAsset(ctx context.Context, arg CreateFileAssetParams) (FileManagerFileAsset, error)
 	// Creates a minimal placeholder resource
 	CreateMinimalResource(ctx context.Context, arg CreateMinimalResourceParams) (ExampleResource, error)
+	// Asynchronous OCR job queries. Every query but the stale sweep is scoped to
+	// the organization that submitted the job.
+	// Inserts nothing once the organization has org_limit queued or running jobs.
+	// Concurrent submissions can exceed the cap briefly, by at most the number
+	// submitted at the same instant.
+	CreateOCRJob(ctx context.Context, arg CreateOCRJobParams) (OcrJob, error)
 	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (OrganizationsOrganization, error)
+	// Organization domain queries
+	CreateOrganizationDomain(ctx context.Context, arg CreateOrganizationDomainParams) (OrganizationsOrganizationDomain, error)
 	// Example Resource Queries
 	// Demonstrates Clean Architecture patterns with CRUD operations,
 	// file attachments, OCR/LLM processing, and approval workflows
 	// CREATE operations
 	CreateResource(ctx context.Context, arg CreateResourceParams) (ExampleResource, error)
+	// Team queries
+	CreateTeam(ctx context.Context, arg CreateTeamParams) (OrganizationsTeam, error)
 	// Decrement invoice count by 1 (called after successful invoice processing)
 	DecrementInvoiceCount(ctx context.Context, organizationID int32) (SubscriptionBillingQuotaTracking, error)
 	DeleteAccount(ctx context.Context, arg DeleteAccountParams) error
@@ -49,23 +72,48 @@ type Querier interface {
 	DeleteChatSession(ctx context.Context, arg DeleteChatSessionParams) error
 	DeleteDocument(ctx context.Context, arg DeleteDocumentParams) error
 	DeleteDocumentEmbeddings(ctx context.Context, arg DeleteDocumentEmbeddingsParams) error
+	// Removes fields of an earlier extraction that the latest one did not find
+	DeleteDocumentFieldsExcept(ctx context.Context, arg DeleteDocumentFieldsExceptParams) error
+	// Removes pages left over from an earlier run that found more pages
+	DeleteDocumentPagesAfter(ctx context.Context, arg DeleteDocumentPagesAfterParams) error
+	DeleteDocumentType(ctx context.Context, arg DeleteDocumentTypeParams) (int64, error)
 	DeleteFileAsset(ctx context.Context, id int32) error
 	DeleteOrganization(ctx context.Context, id int32) error
+	DeleteOrganizationDomain(ctx context.Context, arg DeleteOrganizationDomainParams) error
 	// DELETE operations
 	// Soft delete a resource
 	DeleteResource(ctx context.Context, arg DeleteResourceParams) error
 	// Delete subscription (when subscription is permanently deleted)
 	DeleteSubscription(ctx context.Context, organizationID int32) error
+	DeleteTeam(ctx context.Context, arg DeleteTeamParams) error
+	DeleteTeamMember(ctx context.Context, arg DeleteTeamMemberParams) error
+	// Document job queue queries
+	// Returns no row when the document already has a queued or running job
+	EnqueueDocumentJob(ctx context.Context, arg EnqueueDocumentJobParams) (DocumentsDocumentJob, error)
+	// Queues documents left pending or processing without an active job, such as
+	// uploads that predate the queue or whose enqueue failed
+	EnqueueOrphanedDocumentJobs(ctx context.Context, arg EnqueueOrphanedDocumentJobsParams) ([]DocumentsDocumentJob, error)
+	ExtendDocumentJobLease(ctx context.Context, arg ExtendDocumentJobLeaseParams) (int64, error)
+	FailDocumentJob(ctx context.Context, arg FailDocumentJobParams) (int64, error)
+	FailOCRJob(ctx context.Context, arg FailOCRJobParams) (int64, error)
+	// Fails jobs whose instance stopped reporting progress, across organizations
+	FailStaleOCRJobs(ctx context.Context, arg FailStaleOCRJobsParams) (int64, error)
 	GetAccountByEmail(ctx context.Context, arg GetAccountByEmailParams) (OrganizationsAccount, error)
 	GetAccountByID(ctx context.Context, arg GetAccountByIDParams) (OrganizationsAccount, error)
 	GetAccountOrganization(ctx context.Context, id int32) (OrganizationsOrganization, error)
 	GetAccountStats(ctx context.Context, id int32) (GetAccountStatsRow, error)
+	GetAuditChainHead(ctx context.Context, organizationID int32) (GetAuditChainHeadRow, error)
 	GetChatMessagesBySession(ctx context.Context, sessionID int32) ([]CognitiveChatMessage, error)
 	GetChatSessionByID(ctx context.Context, arg GetChatSessionByIDParams) (CognitiveChatSession, error)
+	// Team visibility as in ListDocumentsByOrganization
 	GetDocumentByFileAssetID(ctx context.Context, arg GetDocumentByFileAssetIDParams) (DocumentsDocument, error)
+	// Team visibility as in ListDocumentsByOrganization
 	GetDocumentByID(ctx context.Context, arg GetDocumentByIDParams) (DocumentsDocument, error)
 	GetDocumentEmbeddingByID(ctx context.Context, arg GetDocumentEmbeddingByIDParams) (CognitiveDocumentEmbedding, error)
 	GetDocumentEmbeddingsByDocumentID(ctx context.Context, arg GetDocumentEmbeddingsByDocumentIDParams) ([]CognitiveDocumentEmbedding, error)
+	GetDocumentJobByID(ctx context.Context, arg GetDocumentJobByIDParams) (DocumentsDocumentJob, error)
+	GetDocumentPage(ctx context.Context, arg GetDocumentPageParams) (DocumentsDocumentPage, error)
+	GetDocumentType(ctx context.Context, arg GetDocumentTypeParams) (DocumentsDocumentType, error)
 	GetFileAssetByID(ctx context.Context, id int32) (FileManagerFileAsset, error)
 	GetFileAssetByStoragePath(ctx context.Context, storagePath string) (FileManagerFileAsset, error)
 	GetFileAssetsByCategory(ctx context.Context, name string) ([]GetFileAssetsByCategoryRow, error)
@@ -74,12 +122,18 @@ type Querier interface {
 	GetFileAssetsByEntityAndPurpose(ctx context.Context, arg GetFileAssetsByEntityAndPurposeParams) ([]FileManagerFileAsset, error)
 	GetFileCategories(ctx context.Context) ([]FileManagerFileCategory, error)
 	GetFileContexts(ctx context.Context) ([]FileManagerFileContext, error)
+	GetOCRJob(ctx context.Context, arg GetOCRJobParams) (OcrJob, error)
 	GetOrganizationByID(ctx context.Context, id int32) (OrganizationsOrganization, error)
 	GetOrganizationBySlug(ctx context.Context, slug string) (OrganizationsOrganization, error)
 	GetOrganizationByStytchID(ctx context.Context, stytchOrgID pgtype.Text) (OrganizationsOrganization, error)
 	// Organization membership queries
 	GetOrganizationByUserEmail(ctx context.Context, email string) (OrganizationsOrganization, error)
+	GetOrganizationDomain(ctx context.Context, arg GetOrganizationDomainParams) (OrganizationsOrganizationDomain, error)
 	// Statistics queries (useful for admin panels)
+	// Organization security policy queries
+	GetOrganizationSecurityPolicy(ctx context.Context, organizationID int32) (OrganizationsOrganizationSecurityPolicy, error)
+	// Organization settings queries
+	GetOrganizationSettings(ctx context.Context, organizationID int32) (OrganizationsOrganizationSetting, error)
 	GetOrganizationStats(ctx context.Context, id int32) (GetOrganizationStatsRow, error)
 	// Get quota tracking for an organization
 	GetQuotaByOrgID(ctx context.Context, organizationID int32) (SubscriptionBillingQuotaTracking, error)
@@ -100,26 +154,58 @@ type Querier interface {
 	GetSubscriptionByOrgID(ctx context.Context, organizationID int32) (SubscriptionBillingSubscription, error)
 	// Get subscription by Polar subscription ID
 	GetSubscriptionBySubscriptionID(ctx context.Context, subscriptionID string) (SubscriptionBillingSubscription, error)
+	GetTeam(ctx context.Context, arg GetTeamParams) (OrganizationsTeam, error)
+	GetTeamMember(ctx context.Context, arg GetTeamMemberParams) (OrganizationsTeamMember, error)
+	GetVerifiedOrganizationDomain(ctx context.Context, domain string) (OrganizationsOrganizationDomain, error)
 	// Hard delete a resource (use with caution)
 	HardDeleteResource(ctx context.Context, arg HardDeleteResourceParams) error
+	InsertAuditEvent(ctx context.Context, arg InsertAuditEventParams) (AuditEvent, error)
 	ListAccountsByOrganization(ctx context.Context, organizationID int32) ([]OrganizationsAccount, error)
 	// List all active subscriptions for monitoring/admin purposes
 	ListActiveSubscriptions(ctx context.Context) ([]SubscriptionBillingSubscription, error)
+	// Oldest first, for chain verification
+	ListAuditEventChain(ctx context.Context, arg ListAuditEventChainParams) ([]AuditEvent, error)
+	// Newest first, keyset-paginated by sequence
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
 	ListChatSessionsByAccount(ctx context.Context, arg ListChatSessionsByAccountParams) ([]CognitiveChatSession, error)
+	ListDocumentFields(ctx context.Context, arg ListDocumentFieldsParams) ([]DocumentsDocumentField, error)
+	ListDocumentJobsByDocument(ctx context.Context, arg ListDocumentJobsByDocumentParams) ([]DocumentsDocumentJob, error)
+	ListDocumentTypes(ctx context.Context, organizationID int32) ([]DocumentsDocumentType, error)
+	// viewer_account_id limits results to documents without a team or owned by one
+	// of the account's teams; NULL includes every team's documents (org-wide access).
 	ListDocumentsByOrganization(ctx context.Context, arg ListDocumentsByOrganizationParams) ([]DocumentsDocument, error)
+	// Team visibility as in ListDocumentsByOrganization
 	ListDocumentsByStatus(ctx context.Context, arg ListDocumentsByStatusParams) ([]DocumentsDocument, error)
 	ListFileAssets(ctx context.Context, arg ListFileAssetsParams) ([]ListFileAssetsRow, error)
+	ListOrganizationDomains(ctx context.Context, organizationID int32) ([]OrganizationsOrganizationDomain, error)
 	ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]OrganizationsOrganization, error)
 	// List organizations approaching their quota limit (for alerting)
 	ListQuotasNearLimit(ctx context.Context, invoiceCount int32) ([]ListQuotasNearLimitRow, error)
-	// List resources with filtering and pagination
+	// List resources with filtering and pagination. viewer_account_id limits
+	// results to resources without a team or owned by one of the account's teams;
+	// NULL includes every team's resources (org-wide access).
 	ListResources(ctx context.Context, arg ListResourcesParams) ([]ListResourcesRow, error)
+	ListTeamMembers(ctx context.Context, teamID int32) ([]ListTeamMembersRow, error)
+	ListTeams(ctx context.Context, organizationID int32) ([]OrganizationsTeam, error)
+	ListTeamsByAccount(ctx context.Context, arg ListTeamsByAccountParams) ([]OrganizationsTeam, error)
+	// Serialize appends to one organization's chain for the rest of the transaction
+	LockAuditChain(ctx context.Context, organizationID int32) error
+	MarkOrganizationDomainVerified(ctx context.Context, arg MarkOrganizationDomainVerifiedParams) (OrganizationsOrganizationDomain, error)
+	// Returns a job interrupted by shutdown to the queue without using up an attempt
+	ReleaseDocumentJob(ctx context.Context, arg ReleaseDocumentJobParams) (int64, error)
+	// Returns running jobs whose lease expired to the queue, or fails them once
+	// their attempts are used up
+	RequeueExpiredDocumentJobs(ctx context.Context) ([]DocumentsDocumentJob, error)
 	// Reset quota counters for a new billing period
 	ResetQuotaForPeriod(ctx context.Context, arg ResetQuotaForPeriodParams) (SubscriptionBillingQuotaTracking, error)
+	RetryDocumentJob(ctx context.Context, arg RetryDocumentJobParams) (int64, error)
 	// SEARCH operations
-	// Full-text search on title and description
+	// Full-text search on title and description (team visibility as in ListResources)
 	SearchResourcesByText(ctx context.Context, arg SearchResourcesByTextParams) ([]SearchResourcesByTextRow, error)
+	// viewer_account_id limits matches to documents the account can see (no team,
+	// or one of its teams); NULL searches every team's documents.
 	SearchSimilarDocuments(ctx context.Context, arg SearchSimilarDocumentsParams) ([]SearchSimilarDocumentsRow, error)
+	StartOCRJob(ctx context.Context, arg StartOCRJobParams) (int64, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (OrganizationsAccount, error)
 	UpdateAccountLastLogin(ctx context.Context, arg UpdateAccountLastLoginParams) (OrganizationsAccount, error)
 	UpdateAccountStytchInfo(ctx context.Context, arg UpdateAccountStytchInfoParams) (OrganizationsAccount, error)
@@ -127,8 +213,14 @@ type Querier interface {
 	UpdateDocument(ctx context.Context, arg UpdateDocumentParams) (DocumentsDocument, error)
 	UpdateDocumentExtractedText(ctx context.Context, arg UpdateDocumentExtractedTextParams) (DocumentsDocument, error)
 	UpdateDocumentStatus(ctx context.Context, arg UpdateDocumentStatusParams) (DocumentsDocument, error)
+	// Only the description changes; the name is stored on classified documents
+	UpdateDocumentType(ctx context.Context, arg UpdateDocumentTypeParams) (DocumentsDocumentType, error)
 	UpdateFileAsset(ctx context.Context, arg UpdateFileAssetParams) error
+	UpdateFileAssetContext(ctx context.Context, arg UpdateFileAssetContextParams) error
+	// Returns 0 once the job is no longer running, e.g. after a cancel
+	UpdateOCRJobProgress(ctx context.Context, arg UpdateOCRJobProgressParams) (int64, error)
 	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (OrganizationsOrganization, error)
+	UpdateOrganizationDomainJIT(ctx context.Context, arg UpdateOrganizationDomainJITParams) (OrganizationsOrganizationDomain, error)
 	UpdateOrganizationStytchInfo(ctx context.Context, arg UpdateOrganizationStytchInfoParams) (OrganizationsOrganization, error)
 	// UPDATE operations
 	UpdateResource(ctx context.Context, arg UpdateResourceParams) error
@@ -137,10 +229,18 @@ type Querier interface {
 	// Update OCR/LLM processing results
 	UpdateResourceProcessingData(ctx context.Context, arg UpdateResourceProcessingDataParams) error
 	UpdateResourceStatus(ctx context.Context, arg UpdateResourceStatusParams) error
+	UpdateTeam(ctx context.Context, arg UpdateTeamParams) (OrganizationsTeam, error)
+	// Document field queries
+	UpsertDocumentField(ctx context.Context, arg UpsertDocumentFieldParams) error
+	// Document page queries
+	UpsertDocumentPage(ctx context.Context, arg UpsertDocumentPageParams) error
+	UpsertOrganizationSecurityPolicy(ctx context.Context, arg UpsertOrganizationSecurityPolicyParams) (OrganizationsOrganizationSecurityPolicy, error)
+	UpsertOrganizationSettings(ctx context.Context, arg UpsertOrganizationSettingsParams) (OrganizationsOrganizationSetting, error)
 	// Create or update quota tracking
 	UpsertQuota(ctx context.Context, arg UpsertQuotaParams) (SubscriptionBillingQuotaTracking, error)
 	// Create or update subscription from Polar webhook
 	UpsertSubscription(ctx context.Context, arg UpsertSubscriptionParams) (SubscriptionBillingSubscription, error)
+	UpsertTeamMember(ctx context.Context, arg UpsertTeamMemberParams) (OrganizationsTeamMember, error)
 }
 
 var _ Querier = (*Queries)(nil)
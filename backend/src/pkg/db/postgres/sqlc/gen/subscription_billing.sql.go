@@ -79,6 +79,7 @@ SELECT
     s.current_period_start,
     s.current_period_end,
     s.cancel_at_period_end,
+    s.plan_name,
     q.invoice_count,
     q.max_seats,
     CASE
@@ -97,6 +98,7 @@ type GetQuotaStatusRow struct {
 	CurrentPeriodStart pgtype.Timestamp `json:"current_period_start"`
 	CurrentPeriodEnd   pgtype.Timestamp `json:"current_period_end"`
 	CancelAtPeriodEnd  pgtype.Bool      `json:"cancel_at_period_end"`
+	PlanName           pgtype.Text      `json:"plan_name"`
 	InvoiceCount       int32            `json:"invoice_count"`
 	MaxSeats           pgtype.Int4      `json:"max_seats"`
 	CanProcessInvoice  bool             `json:"can_process_invoice"`
@@ -111,6 +113,7 @@ func (q *Queries) GetQuotaStatus(ctx context.Context, organizationID int32) (Get
 		&i.CurrentPeriodStart,
 		&i.CurrentPeriodEnd,
 		&i.CancelAtPeriodEnd,
+		&i.PlanName,
 		&i.InvoiceCount,
 		&i.MaxSeats,
 		&i.CanProcessInvoice,
@@ -0,0 +1,11 @@
+-- Per-organization settings consumed by authorization policies and other
+-- tenant-level features. Stored as a JSON object so new keys need no migration.
+CREATE TABLE organizations.organization_settings (
+    organization_id INTEGER PRIMARY KEY REFERENCES organizations.organizations(id) ON DELETE CASCADE,
+    settings JSONB DEFAULT '{}'::jsonb NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    CONSTRAINT chk_organization_settings_object CHECK (jsonb_typeof(settings) = 'object')
+);
+
+COMMENT ON TABLE organizations.organization_settings IS 'Per-organization settings (policy flags, tenant preferences)';
@@ -0,0 +1,6 @@
+-- Drop audit schema
+DROP TRIGGER IF EXISTS events_no_truncate ON audit.events;
+DROP TRIGGER IF EXISTS events_append_only ON audit.events;
+DROP FUNCTION IF EXISTS audit.prevent_mutation();
+DROP TABLE IF EXISTS audit.events;
+DROP SCHEMA IF EXISTS audit;
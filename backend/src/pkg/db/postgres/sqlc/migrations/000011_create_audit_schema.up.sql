@@ -0,0 +1,64 @@
+-- Create audit schema
+CREATE SCHEMA IF NOT EXISTS audit;
+
+-- Append-only audit trail of security-relevant actions.
+-- Each organization's events form a hash chain: hash = sha256(prev_hash || canonical event),
+-- and sequence numbers are gapless per organization, so edits, deletions and
+-- reordering are detectable by re-walking the chain.
+CREATE TABLE audit.events (
+    id BIGSERIAL PRIMARY KEY,
+    organization_id INTEGER NOT NULL,
+    sequence BIGINT NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+
+    -- Who
+    actor_type VARCHAR(20) NOT NULL,
+    actor_account_id INTEGER,
+    actor_email VARCHAR(255),
+
+    -- What
+    action VARCHAR(100) NOT NULL,
+    target_type VARCHAR(50),
+    target_id VARCHAR(255),
+
+    -- Where from
+    ip_address VARCHAR(64),
+    user_agent TEXT,
+    request_id VARCHAR(100),
+
+    -- Details: {"field": {"before": x, "after": y}} and free-form metadata
+    changes JSONB DEFAULT '{}'::jsonb NOT NULL,
+    metadata JSONB DEFAULT '{}'::jsonb NOT NULL,
+
+    -- Hash chain
+    prev_hash CHAR(64) NOT NULL,
+    hash CHAR(64) NOT NULL,
+
+    -- No foreign key to organizations: the trail must outlive the tenant row.
+    CONSTRAINT uq_audit_events_org_sequence UNIQUE (organization_id, sequence),
+    CONSTRAINT chk_audit_events_actor_type CHECK (actor_type IN ('user', 'system', 'webhook'))
+);
+
+CREATE INDEX idx_audit_events_org_occurred ON audit.events (organization_id, occurred_at DESC);
+CREATE INDEX idx_audit_events_org_action ON audit.events (organization_id, action);
+CREATE INDEX idx_audit_events_org_actor ON audit.events (organization_id, actor_account_id);
+CREATE INDEX idx_audit_events_org_target ON audit.events (organization_id, target_type, target_id);
+
+-- Reject updates, deletes and truncation
+CREATE OR REPLACE FUNCTION audit.prevent_mutation()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit.events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER events_append_only
+    BEFORE UPDATE OR DELETE ON audit.events
+    FOR EACH ROW EXECUTE FUNCTION audit.prevent_mutation();
+
+CREATE TRIGGER events_no_truncate
+    BEFORE TRUNCATE ON audit.events
+    FOR EACH STATEMENT EXECUTE FUNCTION audit.prevent_mutation();
+
+COMMENT ON SCHEMA audit IS 'Schema for the tamper-evident audit log';
+COMMENT ON TABLE audit.events IS 'Append-only, per-organization hash-chained audit events';
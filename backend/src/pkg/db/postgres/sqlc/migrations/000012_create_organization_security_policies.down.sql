@@ -0,0 +1 @@
+DROP TABLE IF EXISTS organizations.organization_security_policies;
@@ -0,0 +1,14 @@
+-- Per-organization security policy enforced on every authenticated request:
+-- required MFA, allowed login methods, and client IP allowlist (CIDRs).
+-- Empty arrays mean "no restriction".
+CREATE TABLE organizations.organization_security_policies (
+    organization_id INTEGER PRIMARY KEY REFERENCES organizations.organizations(id) ON DELETE CASCADE,
+    require_mfa BOOLEAN DEFAULT FALSE NOT NULL,
+    allowed_auth_methods TEXT[] DEFAULT '{}'::text[] NOT NULL,
+    ip_allowlist TEXT[] DEFAULT '{}'::text[] NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+COMMENT ON TABLE organizations.organization_security_policies IS 'Per-organization security policy (MFA, allowed auth methods, IP allowlist)';
+COMMENT ON COLUMN organizations.organization_security_policies.allowed_auth_methods IS 'Login methods allowed for members (e.g. sso, password); empty allows all';
+COMMENT ON COLUMN organizations.organization_security_policies.ip_allowlist IS 'CIDR blocks clients must connect from; empty allows all';
@@ -0,0 +1,31 @@
+-- Email domains claimed by organizations. A domain is claimed unverified with a
+-- random token, and verified once the token is published in a DNS TXT record.
+-- A domain can be claimed by several organizations but verified by only one.
+-- Verified domains with JIT provisioning enabled auto-add users from that
+-- domain with default_role when they first authenticate.
+CREATE TABLE organizations.organization_domains (
+    id SERIAL PRIMARY KEY,
+    organization_id INTEGER NOT NULL REFERENCES organizations.organizations(id) ON DELETE CASCADE,
+    domain VARCHAR(253) NOT NULL,
+    verification_token VARCHAR(64) NOT NULL,
+    verified_at TIMESTAMP,
+    jit_enabled BOOLEAN DEFAULT FALSE NOT NULL,
+    default_role VARCHAR(50) DEFAULT 'member' NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    UNIQUE(organization_id, domain),
+
+    CONSTRAINT chk_organization_domains_domain CHECK (domain = LOWER(domain)),
+    CONSTRAINT chk_organization_domains_default_role CHECK (default_role IN ('member', 'approver'))
+);
+
+CREATE INDEX idx_organization_domains_org_id ON organizations.organization_domains(organization_id);
+CREATE UNIQUE INDEX idx_organization_domains_verified_domain
+    ON organizations.organization_domains(domain) WHERE verified_at IS NOT NULL;
+
+COMMENT ON TABLE organizations.organization_domains IS 'Email domains claimed by organizations (DNS TXT verification, JIT provisioning)';
+COMMENT ON COLUMN organizations.organization_domains.verification_token IS 'Token the organization publishes in a DNS TXT record to prove ownership';
+COMMENT ON COLUMN organizations.organization_domains.verified_at IS 'When ownership was verified; NULL while pending';
+COMMENT ON COLUMN organizations.organization_domains.jit_enabled IS 'Auto-add users from this domain on first login (verified domains only)';
+COMMENT ON COLUMN organizations.organization_domains.default_role IS 'Role given to just-in-time provisioned members';
@@ -0,0 +1,5 @@
+ALTER TABLE example_resources DROP COLUMN IF EXISTS team_id;
+ALTER TABLE documents.documents DROP COLUMN IF EXISTS team_id;
+
+DROP TABLE IF EXISTS organizations.team_members;
+DROP TABLE IF EXISTS organizations.teams;
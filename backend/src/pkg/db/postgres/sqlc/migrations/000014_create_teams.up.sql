@@ -0,0 +1,46 @@
+-- Teams group accounts within an organization (departments). Documents and
+-- resources can be owned by a team; team-owned rows are visible only to the
+-- team's members and to callers with org-wide access. Rows without a team stay
+-- visible to the whole organization.
+CREATE TABLE organizations.teams (
+    id SERIAL PRIMARY KEY,
+    organization_id INTEGER NOT NULL REFERENCES organizations.organizations(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    UNIQUE(organization_id, name)
+);
+
+CREATE INDEX idx_teams_org_id ON organizations.teams(organization_id);
+
+CREATE TABLE organizations.team_members (
+    team_id INTEGER NOT NULL REFERENCES organizations.teams(id) ON DELETE CASCADE,
+    account_id INTEGER NOT NULL REFERENCES organizations.accounts(id) ON DELETE CASCADE,
+    role VARCHAR(20) DEFAULT 'member' NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (team_id, account_id),
+
+    CONSTRAINT chk_team_members_role CHECK (role IN ('lead', 'member'))
+);
+
+CREATE INDEX idx_team_members_account_id ON organizations.team_members(account_id);
+
+COMMENT ON TABLE organizations.teams IS 'Teams (departments) within an organization';
+COMMENT ON TABLE organizations.team_members IS 'Team membership and team role';
+COMMENT ON COLUMN organizations.team_members.role IS 'Team role: lead (manages the team) or member';
+
+-- Team ownership. A team that still owns data cannot be deleted, so its rows
+-- never silently become visible to the whole organization.
+ALTER TABLE documents.documents
+    ADD COLUMN team_id INTEGER REFERENCES organizations.teams(id) ON DELETE RESTRICT;
+CREATE INDEX idx_documents_team ON documents.documents(team_id) WHERE team_id IS NOT NULL;
+COMMENT ON COLUMN documents.documents.team_id IS 'Owning team; NULL means visible to the whole organization';
+
+ALTER TABLE example_resources
+    ADD COLUMN team_id INTEGER REFERENCES organizations.teams(id) ON DELETE RESTRICT;
+CREATE INDEX idx_example_resources_team ON example_resources(team_id) WHERE team_id IS NOT NULL;
+COMMENT ON COLUMN example_resources.team_id IS 'Owning team; NULL means visible to the whole organization';
@@ -0,0 +1,33 @@
+-- Persistent queue for document processing. Workers claim queued jobs with
+-- FOR UPDATE SKIP LOCKED and hold a lease (locked_until) while they run; a
+-- job whose lease expires is returned to the queue by the sweeper, so a
+-- crashed instance never leaves a document stuck.
+CREATE TABLE documents.document_jobs (
+    id SERIAL PRIMARY KEY,
+    document_id INTEGER NOT NULL REFERENCES documents.documents(id) ON DELETE CASCADE,
+    organization_id INTEGER NOT NULL REFERENCES organizations.organizations(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'queued',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL,
+    run_after TIMESTAMP NOT NULL DEFAULT NOW(),
+    locked_by VARCHAR(100),
+    locked_until TIMESTAMP,
+    last_error TEXT,
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT valid_job_status CHECK (status IN ('queued', 'running', 'succeeded', 'failed'))
+);
+
+-- At most one active job per document
+CREATE UNIQUE INDEX idx_document_jobs_active ON documents.document_jobs(document_id)
+    WHERE status IN ('queued', 'running');
+CREATE INDEX idx_document_jobs_queued ON documents.document_jobs(run_after, id) WHERE status = 'queued';
+CREATE INDEX idx_document_jobs_running ON documents.document_jobs(organization_id) WHERE status = 'running';
+CREATE INDEX idx_document_jobs_document ON documents.document_jobs(document_id, created_at DESC);
+
+COMMENT ON TABLE documents.document_jobs IS 'Document processing jobs claimed by the worker pool';
+COMMENT ON COLUMN documents.document_jobs.status IS 'Job status: queued, running, succeeded, failed';
+COMMENT ON COLUMN documents.document_jobs.run_after IS 'Earliest time a queued job may be claimed (retry backoff)';
+COMMENT ON COLUMN documents.document_jobs.locked_until IS 'Lease expiry of a running job; expired jobs are requeued';
@@ -0,0 +1,25 @@
+-- Per-page OCR output. The document keeps the full text; pages keep the
+-- layout (blocks with bounding boxes, tables) so answers can cite and
+-- highlight the page region they came from.
+CREATE TABLE documents.document_pages (
+    id SERIAL PRIMARY KEY,
+    document_id INTEGER NOT NULL REFERENCES documents.documents(id) ON DELETE CASCADE,
+    organization_id INTEGER NOT NULL REFERENCES organizations.organizations(id) ON DELETE CASCADE,
+    page_number INTEGER NOT NULL,
+    text TEXT NOT NULL DEFAULT '',
+    extractor VARCHAR(50) NOT NULL,
+    width REAL NOT NULL DEFAULT 0,
+    height REAL NOT NULL DEFAULT 0,
+    blocks JSONB NOT NULL DEFAULT '[]',
+    tables JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_document_pages_number UNIQUE (document_id, page_number),
+    CONSTRAINT valid_page_number CHECK (page_number > 0)
+);
+
+COMMENT ON TABLE documents.document_pages IS 'Per-page OCR text and layout of a document';
+COMMENT ON COLUMN documents.document_pages.extractor IS 'Extractor that produced the page: pdf_text, mistral, tesseract';
+COMMENT ON COLUMN documents.document_pages.width IS 'Page width in points or pixels as reported by the extractor; 0 when unknown';
+COMMENT ON COLUMN documents.document_pages.blocks IS 'Text blocks with bounding boxes relative to the page (0-1, origin top-left)';
+COMMENT ON COLUMN documents.document_pages.tables IS 'Tables detected on the page, as rows of cells';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ocr.jobs;
+DROP SCHEMA IF EXISTS ocr;
@@ -0,0 +1,30 @@
+CREATE SCHEMA IF NOT EXISTS ocr;
+
+-- Asynchronous OCR jobs. The file is processed by the instance that accepted
+-- it and is not stored here; a job whose instance stops updating it is failed
+-- after the job timeout so callers can resubmit.
+CREATE TABLE ocr.jobs (
+    id SERIAL PRIMARY KEY,
+    organization_id INTEGER NOT NULL REFERENCES organizations.organizations(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'queued',
+    mime_type VARCHAR(100) NOT NULL,
+    total_pages INTEGER NOT NULL DEFAULT 0,
+    completed_pages INTEGER NOT NULL DEFAULT 0,
+    callback_url TEXT,
+    result JSONB,
+    error TEXT,
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT valid_ocr_job_status CHECK (status IN ('queued', 'running', 'succeeded', 'failed', 'canceled'))
+);
+
+CREATE INDEX idx_ocr_jobs_organization ON ocr.jobs(organization_id);
+CREATE INDEX idx_ocr_jobs_active ON ocr.jobs(updated_at) WHERE status IN ('queued', 'running');
+
+COMMENT ON TABLE ocr.jobs IS 'Asynchronous OCR jobs and their results';
+COMMENT ON COLUMN ocr.jobs.organization_id IS 'Organization that submitted the job; every lookup is scoped to it';
+COMMENT ON COLUMN ocr.jobs.status IS 'Job status: queued, running, succeeded, failed, canceled';
+COMMENT ON COLUMN ocr.jobs.completed_pages IS 'Pages extracted so far, updated as page ranges finish';
+COMMENT ON COLUMN ocr.jobs.result IS 'OCR response of a succeeded job';
@@ -0,0 +1,17 @@
+-- Document types an organization adds to the built-in ones (invoice,
+-- receipt, contract, report, other). The classifier offers them to the LLM
+-- with their description.
+CREATE TABLE documents.document_types (
+    id SERIAL PRIMARY KEY,
+    organization_id INTEGER NOT NULL REFERENCES organizations.organizations(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    description TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_document_types_name UNIQUE (organization_id, name),
+    CONSTRAINT valid_document_type_name CHECK (name ~ '^[a-z][a-z0-9_]*$')
+);
+
+COMMENT ON TABLE documents.document_types IS 'Custom document types of an organization, used by the document classifier';
+COMMENT ON COLUMN documents.document_types.name IS 'Type name stored in document metadata; lowercase letters, digits and underscores';
+COMMENT ON COLUMN documents.document_types.description IS 'What documents of this type contain; part of the classification prompt';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS documents.document_fields;
+
+ALTER TABLE documents.document_types DROP COLUMN IF EXISTS extraction_schema;
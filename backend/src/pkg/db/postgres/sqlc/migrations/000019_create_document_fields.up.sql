@@ -0,0 +1,26 @@
+-- Structured fields extracted from documents with the extraction schema of
+-- their type. Built-in types carry their schema in code; custom types store
+-- theirs here.
+ALTER TABLE documents.document_types
+    ADD COLUMN extraction_schema JSONB;
+COMMENT ON COLUMN documents.document_types.extraction_schema IS 'JSON schema of the fields extracted from documents of this type; NULL extracts none';
+
+CREATE TABLE documents.document_fields (
+    id SERIAL PRIMARY KEY,
+    document_id INTEGER NOT NULL REFERENCES documents.documents(id) ON DELETE CASCADE,
+    organization_id INTEGER NOT NULL REFERENCES organizations.organizations(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    value JSONB NOT NULL,
+    confidence REAL NOT NULL,
+    page_number INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_document_fields_name UNIQUE (document_id, name),
+    CONSTRAINT valid_field_confidence CHECK (confidence >= 0 AND confidence <= 1),
+    CONSTRAINT valid_field_page_number CHECK (page_number IS NULL OR page_number > 0)
+);
+
+COMMENT ON TABLE documents.document_fields IS 'Fields extracted from a document, one row per top-level schema property';
+COMMENT ON COLUMN documents.document_fields.value IS 'Extracted JSON value; follows the field schema of the document type';
+COMMENT ON COLUMN documents.document_fields.confidence IS 'Extractor confidence in the value, 0-1';
+COMMENT ON COLUMN documents.document_fields.page_number IS 'Page the value was read from; NULL when unknown';
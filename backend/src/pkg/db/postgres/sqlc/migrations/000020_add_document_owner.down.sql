@@ -0,0 +1 @@
+ALTER TABLE documents.documents DROP COLUMN IF EXISTS created_by_account_id;
@@ -0,0 +1,5 @@
+-- Record who uploaded each document so ownership policies can apply to it
+ALTER TABLE documents.documents
+    ADD COLUMN created_by_account_id INTEGER REFERENCES organizations.accounts(id) ON DELETE SET NULL;
+CREATE INDEX idx_documents_created_by ON documents.documents(created_by_account_id) WHERE created_by_account_id IS NOT NULL;
+COMMENT ON COLUMN documents.documents.created_by_account_id IS 'Account that uploaded the document; NULL for documents created before ownership was recorded';
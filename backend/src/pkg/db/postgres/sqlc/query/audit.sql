@@ -0,0 +1,56 @@
+-- Audit event queries
+
+-- name: LockAuditChain :exec
+-- Serialize appends to one organization's chain for the rest of the transaction
+SELECT pg_advisory_xact_lock(hashtext('audit.events'), sqlc.arg(organization_id)::int);
+
+-- name: GetAuditChainHead :one
+SELECT sequence, hash
+FROM audit.events
+WHERE organization_id = $1
+ORDER BY sequence DESC
+LIMIT 1;
+
+-- name: InsertAuditEvent :one
+INSERT INTO audit.events (
+    organization_id,
+    sequence,
+    occurred_at,
+    actor_type,
+    actor_account_id,
+    actor_email,
+    action,
+    target_type,
+    target_id,
+    ip_address,
+    user_agent,
+    request_id,
+    changes,
+    metadata,
+    prev_hash,
+    hash
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+)
+RETURNING *;
+
+-- name: ListAuditEvents :many
+-- Newest first, keyset-paginated by sequence
+SELECT * FROM audit.events
+WHERE organization_id = sqlc.arg(organization_id)
+    AND (sqlc.narg('action')::text IS NULL OR action LIKE sqlc.narg('action'))
+    AND (sqlc.narg('actor_account_id')::int IS NULL OR actor_account_id = sqlc.narg('actor_account_id'))
+    AND (sqlc.narg('target_type')::text IS NULL OR target_type = sqlc.narg('target_type'))
+    AND (sqlc.narg('target_id')::text IS NULL OR target_id = sqlc.narg('target_id'))
+    AND (sqlc.narg('occurred_from')::timestamptz IS NULL OR occurred_at >= sqlc.narg('occurred_from'))
+    AND (sqlc.narg('occurred_to')::timestamptz IS NULL OR occurred_at < sqlc.narg('occurred_to'))
+    AND (sqlc.narg('before_sequence')::bigint IS NULL OR sequence < sqlc.narg('before_sequence'))
+ORDER BY sequence DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListAuditEventChain :many
+-- Oldest first, for chain verification
+SELECT * FROM audit.events
+WHERE organization_id = $1 AND sequence > $2
+ORDER BY sequence ASC
+LIMIT $3;
@@ -24,6 +24,8 @@ WHERE document_id = $1 AND organization_id = $2
 ORDER BY chunk_index;
 
 -- name: SearchSimilarDocuments :many
+-- viewer_account_id limits matches to documents the account can see (no team,
+-- or one of its teams); NULL searches every team's documents.
 SELECT
     de.id,
     de.document_id,
@@ -33,11 +35,18 @@ SELECT
     de.chunk_index,
     de.created_at,
     de.updated_at,
-    (1 - (de.embedding <=> $1::vector))::double precision as similarity_score
+    (1 - (de.embedding <=> sqlc.arg('embedding')::vector))::double precision as similarity_score
 FROM cognitive.document_embeddings de
-WHERE de.organization_id = $2
-ORDER BY de.embedding <=> $1::vector
-LIMIT $3;
+JOIN documents.documents d ON d.id = de.document_id
+WHERE de.organization_id = sqlc.arg('organization_id')
+    AND (sqlc.narg('viewer_account_id')::integer IS NULL
+        OR d.team_id IS NULL
+        OR d.team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = sqlc.narg('viewer_account_id')
+        ))
+ORDER BY de.embedding <=> sqlc.arg('embedding')::vector
+LIMIT sqlc.arg('limit');
 
 -- name: DeleteDocumentEmbeddings :exec
 DELETE FROM cognitive.document_embeddings
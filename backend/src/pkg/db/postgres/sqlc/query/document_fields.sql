@@ -0,0 +1,29 @@
+-- Document field queries
+
+-- name: UpsertDocumentField :exec
+INSERT INTO documents.document_fields (
+    document_id,
+    organization_id,
+    name,
+    value,
+    confidence,
+    page_number
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (document_id, name) DO UPDATE
+SET value = EXCLUDED.value,
+    confidence = EXCLUDED.confidence,
+    page_number = EXCLUDED.page_number,
+    updated_at = NOW();
+
+-- name: DeleteDocumentFieldsExcept :exec
+-- Removes fields of an earlier extraction that the latest one did not find
+DELETE FROM documents.document_fields
+WHERE document_id = sqlc.arg('document_id')
+    AND NOT (name = ANY(sqlc.arg('names')::text[]));
+
+-- name: ListDocumentFields :many
+SELECT * FROM documents.document_fields
+WHERE document_id = $1 AND organization_id = $2
+ORDER BY name;
@@ -0,0 +1,125 @@
+-- Document job queue queries
+
+-- name: EnqueueDocumentJob :one
+-- Returns no row when the document already has a queued or running job
+INSERT INTO documents.document_jobs (
+    document_id,
+    organization_id,
+    max_attempts
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (document_id) WHERE status IN ('queued', 'running') DO NOTHING
+RETURNING *;
+
+-- name: ClaimDocumentJob :one
+-- Claims the oldest runnable job of an organization below its concurrency cap.
+-- Concurrent claims skip each other's rows, so the cap can be exceeded briefly
+-- by at most the number of workers claiming at the same instant.
+UPDATE documents.document_jobs
+SET status = 'running',
+    attempts = attempts + 1,
+    locked_by = sqlc.arg('worker_id')::text,
+    locked_until = NOW() + make_interval(secs => sqlc.arg('lease_seconds')::integer),
+    started_at = NOW(),
+    updated_at = NOW()
+WHERE id = (
+    SELECT q.id FROM documents.document_jobs q
+    WHERE q.status = 'queued' AND q.run_after <= NOW()
+        AND (
+            SELECT COUNT(*) FROM documents.document_jobs r
+            WHERE r.organization_id = q.organization_id AND r.status = 'running'
+        ) < sqlc.arg('org_concurrency')::integer
+    ORDER BY q.run_after, q.id
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: ExtendDocumentJobLease :execrows
+UPDATE documents.document_jobs
+SET locked_until = NOW() + make_interval(secs => sqlc.arg('lease_seconds')::integer),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND status = 'running' AND locked_by = sqlc.arg('worker_id')::text;
+
+-- name: CompleteDocumentJob :execrows
+UPDATE documents.document_jobs
+SET status = 'succeeded',
+    last_error = NULL,
+    locked_by = NULL,
+    locked_until = NULL,
+    finished_at = NOW(),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND status = 'running' AND locked_by = sqlc.arg('worker_id')::text;
+
+-- name: RetryDocumentJob :execrows
+UPDATE documents.document_jobs
+SET status = 'queued',
+    last_error = sqlc.arg('last_error'),
+    run_after = NOW() + make_interval(secs => sqlc.arg('delay_seconds')::integer),
+    locked_by = NULL,
+    locked_until = NULL,
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND status = 'running' AND locked_by = sqlc.arg('worker_id')::text;
+
+-- name: FailDocumentJob :execrows
+UPDATE documents.document_jobs
+SET status = 'failed',
+    last_error = sqlc.arg('last_error'),
+    locked_by = NULL,
+    locked_until = NULL,
+    finished_at = NOW(),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND status = 'running' AND locked_by = sqlc.arg('worker_id')::text;
+
+-- name: ReleaseDocumentJob :execrows
+-- Returns a job interrupted by shutdown to the queue without using up an attempt
+UPDATE documents.document_jobs
+SET status = 'queued',
+    attempts = GREATEST(attempts - 1, 0),
+    run_after = NOW(),
+    locked_by = NULL,
+    locked_until = NULL,
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND status = 'running' AND locked_by = sqlc.arg('worker_id')::text;
+
+-- name: RequeueExpiredDocumentJobs :many
+-- Returns running jobs whose lease expired to the queue, or fails them once
+-- their attempts are used up
+UPDATE documents.document_jobs
+SET status = CASE WHEN attempts >= max_attempts THEN 'failed' ELSE 'queued' END,
+    last_error = 'lease expired',
+    run_after = NOW(),
+    finished_at = CASE WHEN attempts >= max_attempts THEN NOW() END,
+    locked_by = NULL,
+    locked_until = NULL,
+    updated_at = NOW()
+WHERE status = 'running' AND locked_until < NOW()
+RETURNING *;
+
+-- name: EnqueueOrphanedDocumentJobs :many
+-- Queues documents left pending or processing without an active job, such as
+-- uploads that predate the queue or whose enqueue failed
+INSERT INTO documents.document_jobs (document_id, organization_id, max_attempts)
+SELECT d.id, d.organization_id, sqlc.arg('max_attempts')::integer
+FROM documents.documents d
+WHERE d.status IN ('pending', 'processing')
+    AND d.updated_at < NOW() - make_interval(secs => sqlc.arg('grace_seconds')::integer)
+    AND NOT EXISTS (
+        SELECT 1 FROM documents.document_jobs j
+        WHERE j.document_id = d.id AND j.status IN ('queued', 'running')
+    )
+ORDER BY d.id
+LIMIT sqlc.arg('limit')::integer
+ON CONFLICT (document_id) WHERE status IN ('queued', 'running') DO NOTHING
+RETURNING *;
+
+-- name: GetDocumentJobByID :one
+SELECT * FROM documents.document_jobs
+WHERE id = $1 AND organization_id = $2;
+
+-- name: ListDocumentJobsByDocument :many
+SELECT * FROM documents.document_jobs
+WHERE document_id = $1 AND organization_id = $2
+ORDER BY created_at DESC, id DESC
+LIMIT $3;
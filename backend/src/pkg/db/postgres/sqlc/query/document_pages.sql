@@ -0,0 +1,37 @@
+-- Document page queries
+
+-- name: UpsertDocumentPage :exec
+INSERT INTO documents.document_pages (
+    document_id,
+    organization_id,
+    page_number,
+    text,
+    extractor,
+    width,
+    height,
+    blocks,
+    tables
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+ON CONFLICT (document_id, page_number) DO UPDATE
+SET text = EXCLUDED.text,
+    extractor = EXCLUDED.extractor,
+    width = EXCLUDED.width,
+    height = EXCLUDED.height,
+    blocks = EXCLUDED.blocks,
+    tables = EXCLUDED.tables,
+    updated_at = NOW();
+
+-- name: DeleteDocumentPagesAfter :exec
+-- Removes pages left over from an earlier run that found more pages
+DELETE FROM documents.document_pages
+WHERE document_id = $1 AND page_number > $2;
+
+-- name: GetDocumentPage :one
+SELECT * FROM documents.document_pages
+WHERE document_id = $1 AND organization_id = $2 AND page_number = $3;
+
+-- name: CountDocumentPages :one
+SELECT COUNT(*) FROM documents.document_pages
+WHERE document_id = $1 AND organization_id = $2;
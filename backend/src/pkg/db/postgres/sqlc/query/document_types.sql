@@ -0,0 +1,38 @@
+-- Document type queries
+
+-- name: CreateDocumentType :one
+INSERT INTO documents.document_types (
+    organization_id,
+    name,
+    description,
+    extraction_schema
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: GetDocumentType :one
+SELECT * FROM documents.document_types
+WHERE id = $1 AND organization_id = $2;
+
+-- name: ListDocumentTypes :many
+SELECT * FROM documents.document_types
+WHERE organization_id = $1
+ORDER BY name;
+
+-- name: CountDocumentTypes :one
+SELECT COUNT(*) FROM documents.document_types
+WHERE organization_id = $1;
+
+-- name: UpdateDocumentType :one
+-- The name never changes; it is stored on classified documents
+UPDATE documents.document_types
+SET description = $3,
+    extraction_schema = $4,
+    updated_at = NOW()
+WHERE id = $1 AND organization_id = $2
+RETURNING *;
+
+-- name: DeleteDocumentType :execrows
+DELETE FROM documents.document_types
+WHERE id = $1 AND organization_id = $2;
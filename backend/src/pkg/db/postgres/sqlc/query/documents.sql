@@ -10,30 +10,61 @@ INSERT INTO documents.documents (
     file_size,
     extracted_text,
     status,
-    metadata
+    metadata,
+    team_id,
+    created_by_account_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 ) RETURNING *;
 
 -- name: GetDocumentByID :one
+-- Team visibility as in ListDocumentsByOrganization
 SELECT * FROM documents.documents
-WHERE id = $1 AND organization_id = $2;
+WHERE id = sqlc.arg('id') AND organization_id = sqlc.arg('organization_id')
+    AND (sqlc.narg('viewer_account_id')::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = sqlc.narg('viewer_account_id')
+        ));
 
 -- name: GetDocumentByFileAssetID :one
+-- Team visibility as in ListDocumentsByOrganization
 SELECT * FROM documents.documents
-WHERE file_asset_id = $1 AND organization_id = $2;
+WHERE file_asset_id = sqlc.arg('file_asset_id') AND organization_id = sqlc.arg('organization_id')
+    AND (sqlc.narg('viewer_account_id')::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = sqlc.narg('viewer_account_id')
+        ));
 
 -- name: ListDocumentsByOrganization :many
+-- viewer_account_id limits results to documents without a team or owned by one
+-- of the account's teams; NULL includes every team's documents (org-wide access).
 SELECT * FROM documents.documents
-WHERE organization_id = $1
+WHERE organization_id = sqlc.arg('organization_id')
+    AND (sqlc.narg('viewer_account_id')::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = sqlc.narg('viewer_account_id')
+        ))
 ORDER BY created_at DESC
-LIMIT $2 OFFSET $3;
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: ListDocumentsByStatus :many
+-- Team visibility as in ListDocumentsByOrganization
 SELECT * FROM documents.documents
-WHERE organization_id = $1 AND status = $2
+WHERE organization_id = sqlc.arg('organization_id') AND status = sqlc.arg('status')
+    AND (sqlc.narg('viewer_account_id')::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = sqlc.narg('viewer_account_id')
+        ))
 ORDER BY created_at DESC
-LIMIT $3 OFFSET $4;
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: UpdateDocumentStatus :one
 UPDATE documents.documents
@@ -61,9 +92,23 @@ DELETE FROM documents.documents
 WHERE id = $1 AND organization_id = $2;
 
 -- name: CountDocumentsByOrganization :one
+-- Team visibility as in ListDocumentsByOrganization
 SELECT COUNT(*) FROM documents.documents
-WHERE organization_id = $1;
+WHERE organization_id = sqlc.arg('organization_id')
+    AND (sqlc.narg('viewer_account_id')::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = sqlc.narg('viewer_account_id')
+        ));
 
 -- name: CountDocumentsByStatus :one
+-- Team visibility as in ListDocumentsByOrganization
 SELECT COUNT(*) FROM documents.documents
-WHERE organization_id = $1 AND status = $2;
+WHERE organization_id = sqlc.arg('organization_id') AND status = sqlc.arg('status')
+    AND (sqlc.narg('viewer_account_id')::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = sqlc.narg('viewer_account_id')
+        ));
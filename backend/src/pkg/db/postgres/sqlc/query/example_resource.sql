@@ -8,9 +8,9 @@
 INSERT INTO example_resources (
     resource_number, title, description, status_id, file_id,
     extracted_data, processed_data, confidence,
-    organization_id, created_by_account_id, metadata
+    organization_id, created_by_account_id, metadata, team_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 ) RETURNING *;
 
 -- name: CreateMinimalResource :one
@@ -32,27 +32,41 @@ SELECT * FROM example_resources
 WHERE resource_number = $1 AND organization_id = $2 AND is_active = true;
 
 -- name: ListResources :many
--- List resources with filtering and pagination
+-- List resources with filtering and pagination. viewer_account_id limits
+-- results to resources without a team or owned by one of the account's teams;
+-- NULL includes every team's resources (org-wide access).
 SELECT
     id, resource_number, title, description, status_id, file_id,
     confidence, organization_id, created_by_account_id,
-    approval_status, approval_assigned_to_id,
+    approval_status, approval_assigned_to_id, team_id,
     is_active, created_at, updated_at
 FROM example_resources
-WHERE organization_id = $1 AND is_active = true
-    AND ($2::smallint IS NULL OR status_id = $2)
-    AND ($3::varchar IS NULL OR approval_status = $3)
-    AND ($4::text IS NULL OR title ILIKE '%' || $4 || '%' OR description ILIKE '%' || $4 || '%')
+WHERE organization_id = sqlc.arg('organization_id') AND is_active = true
+    AND (sqlc.arg('status_id')::smallint IS NULL OR status_id = sqlc.arg('status_id'))
+    AND (sqlc.arg('approval_status')::varchar IS NULL OR approval_status = sqlc.arg('approval_status'))
+    AND (sqlc.arg('search')::text IS NULL OR title ILIKE '%' || sqlc.arg('search') || '%' OR description ILIKE '%' || sqlc.arg('search') || '%')
+    AND (sqlc.narg('viewer_account_id')::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = sqlc.narg('viewer_account_id')
+        ))
 ORDER BY created_at DESC
-LIMIT $5 OFFSET $6;
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: CountResources :one
--- Count resources for pagination
+-- Count resources for pagination (same filters and team visibility as ListResources)
 SELECT COUNT(*) FROM example_resources
-WHERE organization_id = $1 AND is_active = true
-    AND ($2::smallint IS NULL OR status_id = $2)
-    AND ($3::varchar IS NULL OR approval_status = $3)
-    AND ($4::text IS NULL OR title ILIKE '%' || $4 || '%' OR description ILIKE '%' || $4 || '%');
+WHERE organization_id = sqlc.arg('organization_id') AND is_active = true
+    AND (sqlc.arg('status_id')::smallint IS NULL OR status_id = sqlc.arg('status_id'))
+    AND (sqlc.arg('approval_status')::varchar IS NULL OR approval_status = sqlc.arg('approval_status'))
+    AND (sqlc.arg('search')::text IS NULL OR title ILIKE '%' || sqlc.arg('search') || '%' OR description ILIKE '%' || sqlc.arg('search') || '%')
+    AND (sqlc.narg('viewer_account_id')::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = sqlc.narg('viewer_account_id')
+        ));
 
 -- UPDATE operations
 
@@ -122,17 +136,23 @@ WHERE id = $1 AND organization_id = $2;
 -- SEARCH operations
 
 -- name: SearchResourcesByText :many
--- Full-text search on title and description
+-- Full-text search on title and description (team visibility as in ListResources)
 SELECT
     id, resource_number, title, description, status_id,
     confidence, created_at, updated_at,
-    ts_rank(to_tsvector('english', coalesce(title, '') || ' ' || coalesce(description, '')), to_tsquery('english', $2)) AS rank
+    ts_rank(to_tsvector('english', coalesce(title, '') || ' ' || coalesce(description, '')), to_tsquery('english', sqlc.arg('query'))) AS rank
 FROM example_resources
-WHERE organization_id = $1
+WHERE organization_id = sqlc.arg('organization_id')
     AND is_active = true
-    AND to_tsvector('english', coalesce(title, '') || ' ' || coalesce(description, '')) @@ to_tsquery('english', $2)
+    AND to_tsvector('english', coalesce(title, '') || ' ' || coalesce(description, '')) @@ to_tsquery('english', sqlc.arg('query'))
+    AND (sqlc.narg('viewer_account_id')::integer IS NULL
+        OR team_id IS NULL
+        OR team_id IN (
+            SELECT tm.team_id FROM organizations.team_members tm
+            WHERE tm.account_id = sqlc.narg('viewer_account_id')
+        ))
 ORDER BY rank DESC, created_at DESC
-LIMIT $3 OFFSET $4;
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- ANALYTICS queries
 
@@ -75,4 +75,11 @@ LIMIT $1 OFFSET $2;
 SELECT * FROM file_manager.file_categories ORDER BY name;
 
 -- name: GetFileContexts :many
-SELECT * FROM file_manager.file_contexts ORDER BY name;
\ No newline at end of file
+SELECT * FROM file_manager.file_contexts ORDER BY name;
+
+-- name: UpdateFileAssetContext :exec
+UPDATE file_manager.file_assets
+SET
+    file_context_id = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
@@ -0,0 +1,71 @@
+-- Asynchronous OCR job queries. Every query but the stale sweep is scoped to
+-- the organization that submitted the job.
+
+-- name: CreateOCRJob :one
+-- Inserts nothing once the organization has org_limit queued or running jobs.
+-- Concurrent submissions can exceed the cap briefly, by at most the number
+-- submitted at the same instant.
+INSERT INTO ocr.jobs (
+    organization_id,
+    mime_type,
+    callback_url
+)
+SELECT sqlc.arg('organization_id')::integer, sqlc.arg('mime_type')::text, sqlc.narg('callback_url')::text
+WHERE (
+    SELECT COUNT(*) FROM ocr.jobs
+    WHERE organization_id = sqlc.arg('organization_id')::integer AND status IN ('queued', 'running')
+) < sqlc.arg('org_limit')::integer
+RETURNING *;
+
+-- name: GetOCRJob :one
+SELECT * FROM ocr.jobs
+WHERE id = $1 AND organization_id = $2;
+
+-- name: StartOCRJob :execrows
+UPDATE ocr.jobs
+SET status = 'running',
+    total_pages = sqlc.arg('total_pages'),
+    started_at = NOW(),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND organization_id = sqlc.arg('organization_id') AND status = 'queued';
+
+-- name: UpdateOCRJobProgress :execrows
+-- Returns 0 once the job is no longer running, e.g. after a cancel
+UPDATE ocr.jobs
+SET completed_pages = sqlc.arg('completed_pages'),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND organization_id = sqlc.arg('organization_id') AND status = 'running';
+
+-- name: CompleteOCRJob :execrows
+UPDATE ocr.jobs
+SET status = 'succeeded',
+    result = sqlc.arg('result'),
+    completed_pages = total_pages,
+    finished_at = NOW(),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND organization_id = sqlc.arg('organization_id') AND status = 'running';
+
+-- name: FailOCRJob :execrows
+UPDATE ocr.jobs
+SET status = 'failed',
+    error = sqlc.arg('error'),
+    finished_at = NOW(),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND organization_id = sqlc.arg('organization_id') AND status IN ('queued', 'running');
+
+-- name: CancelOCRJob :execrows
+UPDATE ocr.jobs
+SET status = 'canceled',
+    finished_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND organization_id = $2 AND status IN ('queued', 'running');
+
+-- name: FailStaleOCRJobs :execrows
+-- Fails jobs whose instance stopped reporting progress, across organizations
+UPDATE ocr.jobs
+SET status = 'failed',
+    error = sqlc.arg('error'),
+    finished_at = NOW(),
+    updated_at = NOW()
+WHERE status IN ('queued', 'running')
+    AND updated_at < NOW() - make_interval(secs => sqlc.arg('stale_seconds')::integer);
@@ -376,3 +376,175 @@ FROM organizations.accounts a
 INNER JOIN organizations.organizations o ON a.organization_id = o.id
 WHERE a.id = $1;
 
+
+-- Organization settings queries
+
+-- name: GetOrganizationSettings :one
+SELECT organization_id, settings, updated_at
+FROM organizations.organization_settings
+WHERE organization_id = $1;
+
+-- name: UpsertOrganizationSettings :one
+INSERT INTO organizations.organization_settings (
+    organization_id,
+    settings
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (organization_id) DO UPDATE SET
+    settings = EXCLUDED.settings,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING organization_id, settings, updated_at;
+
+-- Organization security policy queries
+
+-- name: GetOrganizationSecurityPolicy :one
+SELECT organization_id, require_mfa, allowed_auth_methods, ip_allowlist, updated_at
+FROM organizations.organization_security_policies
+WHERE organization_id = $1;
+
+-- name: UpsertOrganizationSecurityPolicy :one
+INSERT INTO organizations.organization_security_policies (
+    organization_id,
+    require_mfa,
+    allowed_auth_methods,
+    ip_allowlist
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (organization_id) DO UPDATE SET
+    require_mfa = EXCLUDED.require_mfa,
+    allowed_auth_methods = EXCLUDED.allowed_auth_methods,
+    ip_allowlist = EXCLUDED.ip_allowlist,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING organization_id, require_mfa, allowed_auth_methods, ip_allowlist, updated_at;
+
+-- Organization domain queries
+
+-- name: CreateOrganizationDomain :one
+INSERT INTO organizations.organization_domains (
+    organization_id,
+    domain,
+    verification_token,
+    jit_enabled,
+    default_role
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING id, organization_id, domain, verification_token, verified_at, jit_enabled, default_role, created_at, updated_at;
+
+-- name: GetOrganizationDomain :one
+SELECT id, organization_id, domain, verification_token, verified_at, jit_enabled, default_role, created_at, updated_at
+FROM organizations.organization_domains
+WHERE id = $1 AND organization_id = $2;
+
+-- name: GetVerifiedOrganizationDomain :one
+SELECT id, organization_id, domain, verification_token, verified_at, jit_enabled, default_role, created_at, updated_at
+FROM organizations.organization_domains
+WHERE domain = $1 AND verified_at IS NOT NULL;
+
+-- name: ListOrganizationDomains :many
+SELECT id, organization_id, domain, verification_token, verified_at, jit_enabled, default_role, created_at, updated_at
+FROM organizations.organization_domains
+WHERE organization_id = $1
+ORDER BY domain;
+
+-- name: MarkOrganizationDomainVerified :one
+UPDATE organizations.organization_domains
+SET
+    verified_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND organization_id = $2
+RETURNING id, organization_id, domain, verification_token, verified_at, jit_enabled, default_role, created_at, updated_at;
+
+-- name: UpdateOrganizationDomainJIT :one
+UPDATE organizations.organization_domains
+SET
+    jit_enabled = $3,
+    default_role = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND organization_id = $2
+RETURNING id, organization_id, domain, verification_token, verified_at, jit_enabled, default_role, created_at, updated_at;
+
+-- name: DeleteOrganizationDomain :exec
+DELETE FROM organizations.organization_domains
+WHERE id = $1 AND organization_id = $2;
+
+-- Team queries
+
+-- name: CreateTeam :one
+INSERT INTO organizations.teams (
+    organization_id,
+    name,
+    description
+) VALUES (
+    $1, $2, $3
+)
+RETURNING id, organization_id, name, description, created_at, updated_at;
+
+-- name: GetTeam :one
+SELECT id, organization_id, name, description, created_at, updated_at
+FROM organizations.teams
+WHERE id = $1 AND organization_id = $2;
+
+-- name: ListTeams :many
+SELECT id, organization_id, name, description, created_at, updated_at
+FROM organizations.teams
+WHERE organization_id = $1
+ORDER BY name;
+
+-- name: ListTeamsByAccount :many
+SELECT t.id, t.organization_id, t.name, t.description, t.created_at, t.updated_at
+FROM organizations.teams t
+JOIN organizations.team_members tm ON tm.team_id = t.id
+WHERE t.organization_id = $1 AND tm.account_id = $2
+ORDER BY t.name;
+
+-- name: UpdateTeam :one
+UPDATE organizations.teams
+SET
+    name = $3,
+    description = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND organization_id = $2
+RETURNING id, organization_id, name, description, created_at, updated_at;
+
+-- name: DeleteTeam :exec
+DELETE FROM organizations.teams
+WHERE id = $1 AND organization_id = $2;
+
+-- name: UpsertTeamMember :one
+INSERT INTO organizations.team_members (
+    team_id,
+    account_id,
+    role
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (team_id, account_id) DO UPDATE SET
+    role = EXCLUDED.role,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING team_id, account_id, role, created_at, updated_at;
+
+-- name: GetTeamMember :one
+SELECT team_id, account_id, role, created_at, updated_at
+FROM organizations.team_members
+WHERE team_id = $1 AND account_id = $2;
+
+-- name: ListTeamMembers :many
+SELECT
+    tm.team_id,
+    tm.account_id,
+    tm.role,
+    tm.created_at,
+    tm.updated_at,
+    a.email,
+    a.full_name
+FROM organizations.team_members tm
+JOIN organizations.accounts a ON a.id = tm.account_id
+WHERE tm.team_id = $1
+ORDER BY a.full_name, a.email;
+
+-- name: DeleteTeamMember :exec
+DELETE FROM organizations.team_members
+WHERE team_id = $1 AND account_id = $2;
@@ -106,6 +106,7 @@ SELECT
     s.current_period_start,
     s.current_period_end,
     s.cancel_at_period_end,
+    s.plan_name,
     q.invoice_count,
     q.max_seats,
     CASE
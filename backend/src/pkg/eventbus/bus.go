@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // EventBus handles publishing and subscribing to events
@@ -25,6 +27,9 @@ type InMemoryEventBus struct {
 	subscribers map[string][]EventHandler[Event]
 	middleware  []EventMiddleware
 	closed      bool
+
+	// inFlight counts handlers currently running, the bus's backlog.
+	inFlight atomic.Int64
 }
 
 func NewInMemoryEventBus(middleware ...EventMiddleware) EventBus {
@@ -36,7 +41,14 @@ func NewInMemoryEventBus(middleware ...EventMiddleware) EventBus {
 }
 
 // Publish publishes an event to all registered handlers
-func (bus *InMemoryEventBus) Publish(ctx context.Context, event Event) error {
+func (bus *InMemoryEventBus) Publish(ctx context.Context, event Event) (err error) {
+	start := time.Now()
+	ctx, span := startPublishSpan(ctx, event)
+	defer func() {
+		endSpan(span, err)
+		observePublish(event, start, err)
+	}()
+
 	bus.mu.RLock()
 	if bus.closed {
 		bus.mu.RUnlock()
@@ -61,8 +73,10 @@ func (bus *InMemoryEventBus) Publish(ctx context.Context, event Event) error {
 
 	for i, handler := range handlers {
 		wg.Add(1)
+		bus.inFlight.Add(1)
 		go func(handlerIndex int, h EventHandler[Event]) {
 			defer wg.Done()
+			defer bus.inFlight.Add(-1)
 
 			fmt.Printf("DEBUG EventBus: Executing handler #%d for event '%s' (ID: %s)\n",
 				handlerIndex+1, event.EventName(), event.EventID())
@@ -73,7 +87,12 @@ func (bus *InMemoryEventBus) Publish(ctx context.Context, event Event) error {
 				finalHandler = bus.middleware[i](finalHandler)
 			}
 
-			if err := finalHandler(ctx, event); err != nil {
+			handlerStart := time.Now()
+			handlerCtx, span := startHandlerSpan(ctx, event)
+			err := finalHandler(handlerCtx, event)
+			endSpan(span, err)
+			observeHandler(event, handlerStart, err)
+			if err != nil {
 				fmt.Printf("DEBUG EventBus: Handler #%d ERROR for event '%s': %v\n",
 					handlerIndex+1, event.EventName(), err)
 				errCh <- fmt.Errorf("handler error for event %s: %w", event.EventName(), err)
@@ -146,6 +165,11 @@ func (bus *InMemoryEventBus) Close() error {
 	return nil
 }
 
+// InFlight returns the number of handlers currently running
+func (bus *InMemoryEventBus) InFlight() int64 {
+	return bus.inFlight.Load()
+}
+
 // GetSubscriberCount returns the number of subscribers for an event (for testing/debugging)
 func (bus *InMemoryEventBus) GetSubscriberCount(eventName string) int {
 	bus.mu.RLock()
@@ -1,21 +1,42 @@
 package cmd
 
 import (
+	"context"
+
 	"go.uber.org/dig"
 	
 	"github.com/moasq/backend/pkg/eventbus"
+	"github.com/moasq/backend/pkg/health"
+	"github.com/moasq/backend/pkg/lifecycle"
 	"github.com/moasq/backend/pkg/logger/domain"
 )
 
 // ProvideEventBus creates and configures the event bus with middleware
 func ProvideEventBus(container *dig.Container) error {
-	return container.Provide(func(logger domain.Logger) eventbus.EventBus {
+	return container.Provide(func(logger domain.Logger, registry *health.Registry, manager *lifecycle.Manager) (eventbus.EventBus, error) {
 		middleware := []eventbus.EventMiddleware{
 			eventbus.RecoveryMiddleware(logger),
 			eventbus.LoggingMiddleware(logger),
 			eventbus.MetricsMiddleware(),
 		}
 		
-		return eventbus.NewInMemoryEventBus(middleware...)
+		bus := eventbus.NewInMemoryEventBus(middleware...)
+		if checker, ok := bus.(interface{ HealthCheck() health.Check }); ok {
+			if err := registry.Register(checker.HealthCheck()); err != nil {
+				return nil, err
+			}
+		}
+
+		// Reject new events once background work has drained
+		if err := manager.Append(lifecycle.Hook{
+			Name: "eventbus",
+			OnStop: func(context.Context) error {
+				return bus.Close()
+			},
+		}); err != nil {
+			return nil, err
+		}
+
+		return bus, nil
 	})
 }
\ No newline at end of file
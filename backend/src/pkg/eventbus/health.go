@@ -0,0 +1,27 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/moasq/backend/pkg/health"
+)
+
+// backlogThreshold is the number of running handlers above which the bus is
+// reported degraded.
+const backlogThreshold = 100
+
+// HealthCheck reports the handler backlog. It is not critical: a busy bus
+// slows background work but does not break request handling.
+func (bus *InMemoryEventBus) HealthCheck() health.Check {
+	return health.Check{
+		Name: "eventbus",
+		Run: func(ctx context.Context) (health.Details, error) {
+			inFlight := bus.InFlight()
+			details := health.Details{"in_flight_handlers": inFlight}
+			if inFlight > backlogThreshold {
+				return details, health.Degraded("%d event handlers in flight", inFlight)
+			}
+			return details, nil
+		},
+	}
+}
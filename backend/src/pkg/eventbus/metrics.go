@@ -0,0 +1,42 @@
+package eventbus
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/moasq/backend/pkg/metrics"
+)
+
+// Event names are code constants, so labelling by them keeps cardinality
+// bounded.
+var (
+	publishDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "eventbus_publish_duration_seconds",
+		Help:    "Time to publish an event, including its synchronous handlers.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"event", "outcome"})
+
+	handlerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "eventbus_handler_duration_seconds",
+		Help:    "Event handler latency.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"event", "outcome"})
+
+	handlerFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "eventbus_handler_failures_total",
+		Help: "Event handler invocations that returned an error.",
+	}, []string{"event"})
+)
+
+func observePublish(event Event, start time.Time, err error) {
+	publishDuration.WithLabelValues(event.EventName(), metrics.Outcome(err)).Observe(time.Since(start).Seconds())
+}
+
+func observeHandler(event Event, start time.Time, err error) {
+	handlerDuration.WithLabelValues(event.EventName(), metrics.Outcome(err)).Observe(time.Since(start).Seconds())
+	if err != nil {
+		handlerFailures.WithLabelValues(event.EventName()).Inc()
+	}
+}
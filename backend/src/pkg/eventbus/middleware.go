@@ -6,6 +6,7 @@ import (
 	"runtime/debug"
 	"time"
 
+	loggerpkg "github.com/moasq/backend/pkg/logger"
 	"github.com/moasq/backend/pkg/logger/domain"
 )
 
@@ -14,6 +15,7 @@ func LoggingMiddleware(logger domain.Logger) EventMiddleware {
 	return func(next EventHandler[Event]) EventHandler[Event] {
 		return func(ctx context.Context, event Event) error {
 			start := time.Now()
+			logger := loggerpkg.WithTrace(ctx, logger)
 			logger.Info("Processing event", map[string]interface{}{
 				"event_name": event.EventName(),
 				"event_id":   event.EventID(),
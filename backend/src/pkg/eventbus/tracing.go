@@ -0,0 +1,77 @@
+package eventbus
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/moasq/backend/pkg/eventbus"
+
+var tracer = otel.Tracer(tracerName)
+
+// metaCarrier stores trace context (traceparent, tracestate, baggage) in
+// event metadata so handlers, including ones that run after the publishing
+// request has finished, continue the publisher's trace.
+type metaCarrier map[string]interface{}
+
+func (m metaCarrier) Get(key string) string {
+	value, _ := m[key].(string)
+	return value
+}
+
+func (m metaCarrier) Set(key, value string) {
+	m[key] = value
+}
+
+func (m metaCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// startPublishSpan starts the producer span for event and records its
+// context in the event metadata.
+func startPublishSpan(ctx context.Context, event Event) (context.Context, trace.Span) {
+	ctx, span := tracer.Start(ctx, "publish "+event.EventName(),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(eventAttributes(event)...),
+	)
+	if meta := event.Metadata(); meta != nil {
+		otel.GetTextMapPropagator().Inject(ctx, metaCarrier(meta))
+	}
+	return ctx, span
+}
+
+// startHandlerSpan starts the consumer span for one handler, parented to the
+// trace context carried in the event metadata.
+func startHandlerSpan(ctx context.Context, event Event) (context.Context, trace.Span) {
+	if meta := event.Metadata(); meta != nil {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metaCarrier(meta))
+	}
+	return tracer.Start(ctx, "process "+event.EventName(),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(eventAttributes(event)...),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func eventAttributes(event Event) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("messaging.system", "eventbus"),
+		attribute.String("messaging.destination.name", event.EventName()),
+		attribute.String("messaging.message.id", event.EventID()),
+	}
+}
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/moasq/backend/pkg/file_manager/domain"
+	"github.com/moasq/backend/pkg/health"
+)
+
+// r2HealthCheck looks up the sentinel object to prove the bucket is
+// reachable with the configured credentials.
+func r2HealthCheck(repo domain.R2Repository, sentinelKey string) health.Check {
+	return health.Check{
+		Name:     "r2",
+		Critical: true,
+		Run: func(ctx context.Context) (health.Details, error) {
+			exists, err := repo.ObjectExists(ctx, sentinelKey)
+			return health.Details{"sentinel_key": sentinelKey, "sentinel_exists": exists}, err
+		},
+	}
+}
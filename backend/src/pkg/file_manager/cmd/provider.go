@@ -9,23 +9,33 @@ import (
 	"github.com/moasq/backend/pkg/file_manager/config"
 	"github.com/moasq/backend/pkg/file_manager/domain"
 	"github.com/moasq/backend/pkg/file_manager/internal/infra"
+	"github.com/moasq/backend/pkg/health"
 	"github.com/moasq/backend/pkg/logger"
 )
 
 func SetupDependencies(container *dig.Container) error {
 	// Provider for R2 repository with development mode support
-	if err := container.Provide(func(cfg *config.Config, log logger.Logger) (domain.R2Repository, error) {
+	if err := container.Provide(func(cfg *config.Config, log logger.Logger, registry *health.Registry) (domain.R2Repository, error) {
 		// Check for placeholder credentials (development mode)
 		if isPlaceholderR2Credentials(cfg) {
 			log.Warn("R2 credentials are placeholders - using mock file storage (development mode)", map[string]any{
 				"account_id": cfg.R2.AccountID,
 				"message":    "File upload/download will not work. Update R2_* variables in app.env with real credentials",
 			})
-			// Return mock repository for development mode
+			// Return mock repository for development mode (no readiness check)
 			return infra.NewMockR2Repository(log), nil
 		}
 
-		return infra.NewR2Repository(cfg)
+		repo, err := infra.NewR2Repository(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := registry.Register(r2HealthCheck(repo, cfg.R2.HealthSentinelKey)); err != nil {
+			return nil, err
+		}
+
+		return repo, nil
 	}); err != nil {
 		fmt.Printf("Error providing R2 repository: %v", err)
 		return err
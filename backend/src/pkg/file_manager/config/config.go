@@ -14,6 +14,9 @@ type R2Config struct {
 	SecretAccessKey string
 	BucketName      string
 	Region          string
+	// HealthSentinelKey is the object the readiness check looks up. It does
+	// not need to exist; a clean "not found" proves the bucket is reachable.
+	HealthSentinelKey string
 }
 
 func LoadConfig() (*Config, error) {
@@ -29,6 +32,7 @@ func LoadConfig() (*Config, error) {
 	// Set default values for R2
 	viper.SetDefault("r2.region", "auto")
 	viper.SetDefault("r2.bucketName", "invoices")
+	viper.SetDefault("r2.healthSentinelKey", ".health/sentinel")
 
 	if err := viper.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
@@ -42,6 +46,7 @@ func LoadConfig() (*Config, error) {
 	viper.BindEnv("r2.secretAccessKey", "R2_SECRET_ACCESS_KEY")
 	viper.BindEnv("r2.bucketName", "R2_BUCKET")
 	viper.BindEnv("r2.region", "R2_REGION")
+	viper.BindEnv("r2.healthSentinelKey", "R2_HEALTH_SENTINEL_KEY")
 
 	config := &Config{
 		R2: R2Config{
@@ -50,6 +55,8 @@ func LoadConfig() (*Config, error) {
 			SecretAccessKey: viper.GetString("r2.secretAccessKey"),
 			BucketName:      viper.GetString("r2.bucketName"),
 			Region:          viper.GetString("r2.region"),
+
+			HealthSentinelKey: viper.GetString("r2.healthSentinelKey"),
 		},
 	}
 
@@ -14,12 +14,13 @@ const (
 )
 
 // Supported file types
-// SECURITY: Restricted to invoice-safe formats only (PDF and common image formats)
-// Removed: Office documents (.doc, .docx, .xls, .xlsx), text files (.txt, .csv),
-//          archives (.zip, .rar, etc.), and risky image formats (.svg, .gif)
+// SECURITY: Restricted to formats whose content is verified by magic bytes.
+// Office documents are limited to the OOXML formats (.docx, .xlsx); legacy
+// binary formats (.doc, .xls) with macros, archives (.zip, .rar, etc.) and
+// risky image formats (.svg, .gif) stay disabled
 var (
-	DocumentTypes = []string{".pdf"}
-	ImageTypes    = []string{".jpg", ".jpeg", ".png"}
+	DocumentTypes = []string{".pdf", ".docx", ".xlsx", ".csv", ".txt"}
+	ImageTypes    = []string{".jpg", ".jpeg", ".png", ".webp", ".tif", ".tiff"}
 	ArchiveTypes  = []string{} // Archives disabled for security
 )
 
@@ -41,7 +42,7 @@ const (
 // SECURITY: Strict limits for invoice processing to minimize attack surface
 const (
 	MaxDocumentSize = 2 * 1024 * 1024 // 2MB - sufficient for most invoice PDFs
-	MaxImageSize    = 1 * 1024 * 1024 // 1MB - sufficient for scanned invoices
+	MaxImageSize    = 8 * 1024 * 1024 // 8MB - phone photos of receipts, below the 10MB request limit
 	MaxArchiveSize  = 0               // Archives disabled
 )
 
@@ -21,6 +21,7 @@ type FileRepository interface {
 	GetByCategory(ctx context.Context, category file_manager.FileCategory, limit, offset int) ([]*FileAsset, error)
 	GetByContext(ctx context.Context, context file_manager.FileContext, limit, offset int) ([]*FileAsset, error)
 	GetByEntity(ctx context.Context, entityType string, entityID int32) ([]*FileAsset, error)
+	UpdateContext(ctx context.Context, id int32, context file_manager.FileContext) error
 }
 
 // R2Repository handles only object storage operations (Cloudflare R2)
@@ -43,4 +44,5 @@ type FileMetadataRepository interface {
 	GetByCategory(ctx context.Context, category string, limit, offset int) ([]*FileAsset, error)
 	GetByContext(ctx context.Context, context string, limit, offset int) ([]*FileAsset, error)
 	GetByEntity(ctx context.Context, entityType string, entityID int32) ([]*FileAsset, error)
+	UpdateContext(ctx context.Context, id int32, context file_manager.FileContext) error
 }
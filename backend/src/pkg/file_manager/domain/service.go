@@ -17,6 +17,9 @@ type FileService interface {
 	DeleteFile(ctx context.Context, id int32) error
 	ListFiles(ctx context.Context, filter *FileSearchFilter, limit, offset int) ([]*FileAsset, error)
 	GetFileURL(ctx context.Context, id int32, expiryHours int) (string, error)
+	// UpdateFileContext moves a file to another business context. The storage
+	// path keeps the context the file was uploaded with.
+	UpdateFileContext(ctx context.Context, id int32, context file_manager.FileContext) error
 }
 
 type fileService struct {
@@ -161,6 +164,13 @@ func (s *fileService) GetFileURL(ctx context.Context, id int32, expiryHours int)
 	return url, nil
 }
 
+func (s *fileService) UpdateFileContext(ctx context.Context, id int32, context file_manager.FileContext) error {
+	if err := s.repo.UpdateContext(ctx, id, context); err != nil {
+		return fmt.Errorf("failed to update file context: %w", err)
+	}
+	return nil
+}
+
 // generateFilePath creates a logical path for organizing files
 func generateFilePath(category file_manager.FileCategory, context file_manager.FileContext, filename string) string {
 	timestamp := time.Now().Format("2006/01/02")
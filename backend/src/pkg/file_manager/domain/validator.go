@@ -28,20 +28,20 @@ func ValidateFileContent(reader io.Reader, filename string) error {
 		return fmt.Errorf("unsupported file extension: %s", ext)
 	}
 
-	// Check if detected MIME type matches expected types
-	detectedMIME := mtype.String()
+	// Check if detected MIME type matches expected types. Is ignores
+	// parameters such as the charset of text files.
 	for _, allowed := range allowedMIMEs {
-		if detectedMIME == allowed {
+		if mtype.Is(allowed) {
 			return nil
 		}
 	}
 
-	return fmt.Errorf("file content type (%s) does not match extension (%s)", detectedMIME, ext)
+	return fmt.Errorf("file content type (%s) does not match extension (%s)", mtype.String(), ext)
 }
 
 // getAllowedMIMETypes returns the list of allowed MIME types for a given file extension
 func getAllowedMIMETypes(ext string) ([]string, bool) {
-	// Invoice-specific allowed MIME types
+	// Allowed MIME types as detected from content
 	mimeMap := map[string][]string{
 		".pdf": {
 			"application/pdf",
@@ -55,6 +55,29 @@ func getAllowedMIMETypes(ext string) ([]string, bool) {
 		".jpeg": {
 			"image/jpeg",
 		},
+		".webp": {
+			"image/webp",
+		},
+		".tif": {
+			"image/tiff",
+		},
+		".tiff": {
+			"image/tiff",
+		},
+		".docx": {
+			"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		},
+		".xlsx": {
+			"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		},
+		// Files with a single column or ragged rows are detected as plain text
+		".csv": {
+			"text/csv",
+			"text/plain",
+		},
+		".txt": {
+			"text/plain",
+		},
 	}
 
 	mimes, ok := mimeMap[ext]
@@ -268,6 +268,10 @@ func (r *compositeRepository) GetByEntity(ctx context.Context, entityType string
 	return r.metadataRepo.GetByEntity(ctx, entityType, entityID)
 }
 
+func (r *compositeRepository) UpdateContext(ctx context.Context, id int32, context file_manager.FileContext) error {
+	return r.metadataRepo.UpdateContext(ctx, id, context)
+}
+
 // Helper methods
 func (r *compositeRepository) generateStoragePath(category file_manager.FileCategory, context file_manager.FileContext, filename string) string {
 	timestamp := time.Now().Format("2006/01/02")
@@ -219,6 +219,22 @@ func (r *dbRepository) GetByEntity(ctx context.Context, entityType string, entit
 	return files, nil
 }
 
+func (r *dbRepository) UpdateContext(ctx context.Context, id int32, context file_manager.FileContext) error {
+	contextID, err := r.getContextID(ctx, context)
+	if err != nil {
+		return fmt.Errorf("failed to get context ID: %w", err)
+	}
+
+	if err := r.store.UpdateFileAssetContext(ctx, sqlc.UpdateFileAssetContextParams{
+		ID:            id,
+		FileContextID: contextID,
+	}); err != nil {
+		return fmt.Errorf("failed to update file asset context: %w", err)
+	}
+
+	return nil
+}
+
 // Helper methods for conversion and lookup
 func (r *dbRepository) getCategoryID(ctx context.Context, category file_manager.FileCategory) (int16, error) {
 	categories, err := r.store.GetFileCategories(ctx)
@@ -74,6 +74,9 @@ func (r *r2Repository) ensureBucket(ctx context.Context) error {
 }
 
 func (r *r2Repository) UploadObject(ctx context.Context, objectKey string, content io.Reader, size int64, contentType string) error {
+	ctx, span := r.startSpan(ctx, "PutObject", objectKey)
+	defer span.End()
+
 	_, err := r.client.PutObject(ctx, &s3.PutObjectInput{
 		Bucket:        aws.String(r.bucketName),
 		Key:           aws.String(objectKey),
@@ -83,6 +86,7 @@ func (r *r2Repository) UploadObject(ctx context.Context, objectKey string, conte
 	})
 
 	if err != nil {
+		recordError(span, err)
 		return fmt.Errorf("failed to upload object to R2: %w", err)
 	}
 
@@ -91,12 +95,16 @@ func (r *r2Repository) UploadObject(ctx context.Context, objectKey string, conte
 
 // DownloadObject downloads a file from R2
 func (r *r2Repository) DownloadObject(ctx context.Context, objectKey string) (io.ReadCloser, error) {
+	ctx, span := r.startSpan(ctx, "GetObject", objectKey)
+	defer span.End()
+
 	result, err := r.client.GetObject(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(r.bucketName),
 		Key:    aws.String(objectKey),
 	})
 
 	if err != nil {
+		recordError(span, err)
 		return nil, fmt.Errorf("failed to get object from R2: %w", err)
 	}
 
@@ -104,12 +112,16 @@ func (r *r2Repository) DownloadObject(ctx context.Context, objectKey string) (io
 }
 
 func (r *r2Repository) DeleteObject(ctx context.Context, objectKey string) error {
+	ctx, span := r.startSpan(ctx, "DeleteObject", objectKey)
+	defer span.End()
+
 	_, err := r.client.DeleteObject(ctx, &s3.DeleteObjectInput{
 		Bucket: aws.String(r.bucketName),
 		Key:    aws.String(objectKey),
 	})
 
 	if err != nil {
+		recordError(span, err)
 		return fmt.Errorf("failed to delete object from R2: %w", err)
 	}
 
@@ -138,6 +150,9 @@ func (r *r2Repository) GetPresignedURL(ctx context.Context, objectKey string, ex
 
 // ObjectExists checks if an object exists in R2
 func (r *r2Repository) ObjectExists(ctx context.Context, objectKey string) (bool, error) {
+	ctx, span := r.startSpan(ctx, "HeadObject", objectKey)
+	defer span.End()
+
 	_, err := r.client.HeadObject(ctx, &s3.HeadObjectInput{
 		Bucket: aws.String(r.bucketName),
 		Key:    aws.String(objectKey),
@@ -151,6 +166,7 @@ func (r *r2Repository) ObjectExists(ctx context.Context, objectKey string) (bool
 				return false, nil
 			}
 		}
+		recordError(span, err)
 		return false, fmt.Errorf("failed to check R2 object existence: %w", err)
 	}
 
@@ -0,0 +1,34 @@
+package infra
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/moasq/backend/pkg/file_manager"
+
+var tracer = otel.Tracer(tracerName)
+
+// startSpan starts a client span for one R2 (S3 API) operation. The span
+// only covers the request; a downloaded body is streamed after it ends.
+func (r *r2Repository) startSpan(ctx context.Context, operation, objectKey string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "R2 "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "aws-api"),
+			attribute.String("rpc.service", "S3"),
+			attribute.String("rpc.method", operation),
+			attribute.String("aws.s3.bucket", r.bucketName),
+			attribute.String("aws.s3.key", objectKey),
+		),
+	)
+}
+
+func recordError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
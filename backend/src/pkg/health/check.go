@@ -0,0 +1,60 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Status is the state of a single check or of the whole instance.
+type Status string
+
+const (
+	StatusUp       Status = "up"
+	StatusDegraded Status = "degraded"
+	StatusDown     Status = "down"
+)
+
+// Details carries check-specific diagnostics for the detailed report.
+type Details map[string]any
+
+// CheckFunc probes one dependency. Returning an error marks the check down;
+// wrap it with Degraded to report degraded instead.
+type CheckFunc func(ctx context.Context) (Details, error)
+
+// Check is a named dependency probe.
+type Check struct {
+	Name string
+	// Critical checks take the instance out of rotation when they are down.
+	// Non-critical checks are reported but never fail readiness, which suits
+	// dependencies every instance shares, such as third-party APIs.
+	Critical bool
+	// Timeout overrides the registry default.
+	Timeout time.Duration
+	Run     CheckFunc
+}
+
+type degradedError struct {
+	err error
+}
+
+func (e *degradedError) Error() string { return e.err.Error() }
+func (e *degradedError) Unwrap() error { return e.err }
+
+// Degraded reports a dependency that works but is impaired, such as a
+// saturated pool or an open circuit breaker.
+func Degraded(format string, args ...any) error {
+	return &degradedError{err: fmt.Errorf(format, args...)}
+}
+
+func statusOf(err error) Status {
+	if err == nil {
+		return StatusUp
+	}
+	var degraded *degradedError
+	if errors.As(err, &degraded) {
+		return StatusDegraded
+	}
+	return StatusDown
+}
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/moasq/backend/pkg/health"
+	"go.uber.org/dig"
+)
+
+// Init provides the health-check registry. Modules register their checks
+// into it when their dependencies are constructed.
+func Init(container *dig.Container) error {
+	providers := []any{
+		health.LoadConfig,
+		health.NewRegistry,
+	}
+
+	for _, provider := range providers {
+		if err := container.Provide(provider); err != nil {
+			return fmt.Errorf("failed to provide health dependency: %w", err)
+		}
+	}
+
+	return nil
+}
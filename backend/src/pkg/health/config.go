@@ -0,0 +1,49 @@
+package health
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Config configures the health-check registry and probe endpoints.
+type Config struct {
+	// CheckTimeout bounds checks that do not set their own timeout.
+	CheckTimeout time.Duration `mapstructure:"HEALTH_CHECK_TIMEOUT"`
+	// CacheTTL is how long a check result is reused before it runs again,
+	// so frequent probes do not hammer dependencies.
+	CacheTTL time.Duration `mapstructure:"HEALTH_CACHE_TTL"`
+	// AuthToken, when set, must be sent as a bearer token to get the
+	// detailed report. Without it details are only shown outside production.
+	AuthToken   string `mapstructure:"HEALTH_AUTH_TOKEN"`
+	Environment string `mapstructure:"ENV"`
+}
+
+// LoadConfig reads the health configuration from app.env or the environment.
+func LoadConfig() (*Config, error) {
+	var cfg Config
+
+	viper.SetConfigName("app")
+	viper.SetConfigType("env")
+	viper.AddConfigPath(".")
+	viper.AutomaticEnv()
+
+	viper.SetDefault("HEALTH_CHECK_TIMEOUT", "2s")
+	viper.SetDefault("HEALTH_CACHE_TTL", "5s")
+
+	_ = viper.ReadInConfig()
+
+	if err := viper.Unmarshal(&cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.CheckTimeout <= 0 {
+		return nil, fmt.Errorf("invalid HEALTH_CHECK_TIMEOUT %s: must be positive", cfg.CheckTimeout)
+	}
+	if cfg.CacheTTL < 0 {
+		return nil, fmt.Errorf("invalid HEALTH_CACHE_TTL %s: must not be negative", cfg.CacheTTL)
+	}
+
+	return &cfg, nil
+}
@@ -0,0 +1,67 @@
+package health
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// LivenessHandler reports that the process is running and serving HTTP. It
+// deliberately checks no dependencies: an unreachable database should take
+// the instance out of rotation (readiness), not get it restarted.
+func (r *Registry) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body := map[string]any{"status": StatusUp}
+		if r.authorized(req) {
+			body["uptime_seconds"] = int64(r.Uptime() / time.Second)
+		}
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// ReadinessHandler runs the registered checks and answers 503 when a
+// critical check is down or the server is draining. Authorized callers get the per-check report;
+// everyone else gets only the overall status.
+func (r *Registry) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if r.draining.Load() {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]any{
+				"status": StatusDown,
+				"error":  "shutting down",
+			})
+			return
+		}
+
+		report := r.Check(req.Context())
+
+		code := http.StatusOK
+		if report.Status == StatusDown {
+			code = http.StatusServiceUnavailable
+		}
+
+		if r.authorized(req) {
+			writeJSON(w, code, report)
+			return
+		}
+		writeJSON(w, code, map[string]any{"status": report.Status})
+	})
+}
+
+// authorized reports whether req may see the detailed report: it must carry
+// the configured bearer token, or, with no token configured, the server must
+// not be running in production.
+func (r *Registry) authorized(req *http.Request) bool {
+	if r.config.AuthToken == "" {
+		return r.config.Environment != "PROD"
+	}
+	expected := []byte("Bearer " + r.config.AuthToken)
+	return subtle.ConstantTimeCompare([]byte(req.Header.Get("Authorization")), expected) == 1
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
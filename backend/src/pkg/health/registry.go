@@ -0,0 +1,220 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Result is the outcome of one check run.
+type Result struct {
+	Status     Status    `json:"status"`
+	Critical   bool      `json:"critical"`
+	Error      string    `json:"error,omitempty"`
+	Details    Details   `json:"details,omitempty"`
+	DurationMS int64     `json:"duration_ms"`
+	CheckedAt  time.Time `json:"checked_at"`
+}
+
+// Report aggregates every registered check.
+type Report struct {
+	Status    Status            `json:"status"`
+	Checks    map[string]Result `json:"checks"`
+	CheckedAt time.Time         `json:"checked_at"`
+}
+
+// Registry holds the checks modules register and runs them for the probe
+// endpoints, caching each result for the configured TTL.
+type Registry struct {
+	config   *Config
+	started  time.Time
+	draining atomic.Bool
+
+	mu     sync.RWMutex
+	checks map[string]*entry
+}
+
+type entry struct {
+	check Check
+
+	// run serializes executions so concurrent probes share one result.
+	run    sync.Mutex
+	mu     sync.RWMutex
+	result *Result
+}
+
+func NewRegistry(cfg *Config) *Registry {
+	return &Registry{
+		config:  cfg,
+		started: time.Now(),
+		checks:  make(map[string]*entry),
+	}
+}
+
+// Register adds check. Names must be unique.
+func (r *Registry) Register(check Check) error {
+	if check.Name == "" || check.Run == nil {
+		return fmt.Errorf("health check requires a name and a run function")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.checks[check.Name]; exists {
+		return fmt.Errorf("health check %q already registered", check.Name)
+	}
+	r.checks[check.Name] = &entry{check: check}
+	return nil
+}
+
+// Check runs every registered check concurrently, reusing cached results
+// that are younger than the cache TTL.
+func (r *Registry) Check(ctx context.Context) Report {
+	r.mu.RLock()
+	entries := make([]*entry, 0, len(r.checks))
+	for _, e := range r.checks {
+		entries = append(entries, e)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].check.Name < entries[j].check.Name
+	})
+
+	results := make([]Result, len(entries))
+	var wg sync.WaitGroup
+	for i, e := range entries {
+		wg.Add(1)
+		go func(i int, e *entry) {
+			defer wg.Done()
+			results[i] = r.result(ctx, e)
+		}(i, e)
+	}
+	wg.Wait()
+
+	report := Report{
+		Status:    StatusUp,
+		Checks:    make(map[string]Result, len(entries)),
+		CheckedAt: time.Now().UTC(),
+	}
+	for i, e := range entries {
+		result := results[i]
+		report.Checks[e.check.Name] = result
+		report.Status = worse(report.Status, effectiveStatus(result))
+	}
+
+	return report
+}
+
+// SetDraining makes readiness fail from now on, so load balancers stop
+// routing new traffic while the server shuts down.
+func (r *Registry) SetDraining() {
+	r.draining.Store(true)
+}
+
+// Uptime reports how long the registry, and so the process, has been up.
+func (r *Registry) Uptime() time.Duration {
+	return time.Since(r.started)
+}
+
+func (r *Registry) result(ctx context.Context, e *entry) Result {
+	if cached, ok := r.cached(e); ok {
+		return cached
+	}
+
+	e.run.Lock()
+	defer e.run.Unlock()
+
+	// Another probe may have refreshed the result while we waited
+	if cached, ok := r.cached(e); ok {
+		return cached
+	}
+
+	result := r.run(ctx, e.check)
+
+	e.mu.Lock()
+	e.result = &result
+	e.mu.Unlock()
+
+	return result
+}
+
+func (r *Registry) cached(e *entry) (Result, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.result == nil || time.Since(e.result.CheckedAt) >= r.config.CacheTTL {
+		return Result{}, false
+	}
+	return *e.result, true
+}
+
+func (r *Registry) run(ctx context.Context, check Check) Result {
+	timeout := check.Timeout
+	if timeout <= 0 {
+		timeout = r.config.CheckTimeout
+	}
+
+	// Detach from the probe request so a client hanging up does not poison
+	// the cached result.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+
+	type outcome struct {
+		details Details
+		err     error
+	}
+	done := make(chan outcome, 1)
+	start := time.Now()
+
+	// Run in a goroutine so a check that ignores its context still cannot
+	// hold the probe past the timeout.
+	go func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				done <- outcome{err: fmt.Errorf("check panicked: %v", recovered)}
+			}
+		}()
+		details, err := check.Run(ctx)
+		done <- outcome{details: details, err: err}
+	}()
+
+	var o outcome
+	select {
+	case o = <-done:
+	case <-ctx.Done():
+		o.err = fmt.Errorf("check timed out after %s", timeout)
+	}
+
+	result := Result{
+		Status:     statusOf(o.err),
+		Critical:   check.Critical,
+		Details:    o.details,
+		DurationMS: time.Since(start).Milliseconds(),
+		CheckedAt:  time.Now().UTC(),
+	}
+	if o.err != nil {
+		result.Error = o.err.Error()
+	}
+	return result
+}
+
+// effectiveStatus caps non-critical failures at degraded so they never take
+// the instance out of rotation.
+func effectiveStatus(result Result) Status {
+	if result.Status == StatusDown && !result.Critical {
+		return StatusDegraded
+	}
+	return result.Status
+}
+
+func worse(a, b Status) Status {
+	rank := map[Status]int{StatusUp: 0, StatusDegraded: 1, StatusDown: 2}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
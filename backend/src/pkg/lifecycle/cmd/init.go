@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/moasq/backend/pkg/lifecycle"
+	"go.uber.org/dig"
+)
+
+// Init provides the lifecycle manager. Call it before every other module:
+// modules append hooks to it as their dependencies are constructed.
+func Init(container *dig.Container) error {
+	providers := []any{
+		lifecycle.LoadConfig,
+		lifecycle.NewManager,
+	}
+
+	for _, provider := range providers {
+		if err := container.Provide(provider); err != nil {
+			return fmt.Errorf("failed to provide lifecycle dependency: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package lifecycle
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Config bounds each shutdown phase. Their sum should stay below the
+// orchestrator's grace period (30s by default on Kubernetes) together with
+// the HTTP drain (SHUTDOWN_HTTP_TIMEOUT).
+type Config struct {
+	// DrainTimeout is how long shutdown waits for background tasks to finish.
+	DrainTimeout time.Duration `mapstructure:"SHUTDOWN_DRAIN_TIMEOUT"`
+	// CheckpointTimeout is how long tasks get to checkpoint after their
+	// context is canceled at the end of the drain.
+	CheckpointTimeout time.Duration `mapstructure:"SHUTDOWN_CHECKPOINT_TIMEOUT"`
+	// HookTimeout bounds hooks that do not set their own timeout.
+	HookTimeout time.Duration `mapstructure:"SHUTDOWN_HOOK_TIMEOUT"`
+}
+
+// LoadConfig reads the lifecycle configuration from app.env or the environment.
+func LoadConfig() (*Config, error) {
+	var cfg Config
+
+	viper.SetConfigName("app")
+	viper.SetConfigType("env")
+	viper.AddConfigPath(".")
+	viper.AutomaticEnv()
+
+	viper.SetDefault("SHUTDOWN_DRAIN_TIMEOUT", "10s")
+	viper.SetDefault("SHUTDOWN_CHECKPOINT_TIMEOUT", "3s")
+	viper.SetDefault("SHUTDOWN_HOOK_TIMEOUT", "5s")
+
+	_ = viper.ReadInConfig()
+
+	if err := viper.Unmarshal(&cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.DrainTimeout < 0 || cfg.CheckpointTimeout < 0 {
+		return nil, fmt.Errorf("invalid shutdown timeouts: drain %s, checkpoint %s must not be negative", cfg.DrainTimeout, cfg.CheckpointTimeout)
+	}
+	if cfg.HookTimeout <= 0 {
+		return nil, fmt.Errorf("invalid SHUTDOWN_HOOK_TIMEOUT %s: must be positive", cfg.HookTimeout)
+	}
+
+	return &cfg, nil
+}
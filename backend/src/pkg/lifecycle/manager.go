@@ -0,0 +1,227 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrStopping is returned by Go once shutdown has begun.
+var ErrStopping = errors.New("lifecycle: shutting down, not accepting new tasks")
+
+// Hook is a named pair of start and stop callbacks. Either may be nil.
+type Hook struct {
+	Name    string
+	OnStart func(ctx context.Context) error
+	OnStop  func(ctx context.Context) error
+	// Timeout bounds each callback; zero uses SHUTDOWN_HOOK_TIMEOUT.
+	Timeout time.Duration
+}
+
+// Manager runs start hooks in registration order, stops them in reverse,
+// and tracks background tasks so shutdown can drain them.
+//
+// Modules append hooks when their dependency is constructed. Since dig
+// builds dependencies before their dependents, reverse order stops a
+// dependent (a scheduler) before what it uses (the database pool).
+type Manager struct {
+	config *Config
+
+	mu      sync.Mutex
+	hooks   []Hook
+	started int
+	// active is set once Start has succeeded.
+	active bool
+
+	tasks       sync.WaitGroup
+	running     atomic.Int64
+	stopping    atomic.Bool
+	taskCtx     context.Context
+	cancelTasks context.CancelFunc
+}
+
+func NewManager(cfg *Config) *Manager {
+	taskCtx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		config:      cfg,
+		taskCtx:     taskCtx,
+		cancelTasks: cancel,
+	}
+}
+
+// Append registers hook. Hooks appended after Start have their OnStart run
+// immediately so late-constructed dependencies are still started.
+func (m *Manager) Append(hook Hook) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.stopping.Load() {
+		return ErrStopping
+	}
+
+	m.hooks = append(m.hooks, hook)
+	if !m.active {
+		return nil
+	}
+
+	if err := m.call(hook, "start", hook.OnStart); err != nil {
+		m.hooks = m.hooks[:len(m.hooks)-1]
+		return err
+	}
+	m.started++
+	return nil
+}
+
+// Start runs every OnStart in registration order. If one fails, the hooks
+// already started are stopped and the error is returned.
+func (m *Manager) Start() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for m.started < len(m.hooks) {
+		hook := m.hooks[m.started]
+		if err := m.call(hook, "start", hook.OnStart); err != nil {
+			stopErr := m.stopHooks()
+			return errors.Join(fmt.Errorf("start %s: %w", hook.Name, err), stopErr)
+		}
+		m.started++
+	}
+	m.active = true
+	return nil
+}
+
+// Go runs fn in a tracked goroutine. fn's context is canceled when the
+// drain timeout expires during shutdown; fn should then checkpoint its work
+// and return within the checkpoint timeout.
+func (m *Manager) Go(name string, fn func(ctx context.Context)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.stopping.Load() {
+		return ErrStopping
+	}
+
+	m.tasks.Add(1)
+	m.running.Add(1)
+	go func() {
+		defer m.tasks.Done()
+		defer m.running.Add(-1)
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				log.Printf("lifecycle: background task %s panicked: %v", name, recovered)
+			}
+		}()
+		fn(m.taskCtx)
+	}()
+	return nil
+}
+
+// Running returns the number of background tasks in flight.
+func (m *Manager) Running() int64 {
+	return m.running.Load()
+}
+
+// Stopping reports whether shutdown has begun.
+func (m *Manager) Stopping() bool {
+	return m.stopping.Load()
+}
+
+// Stop drains background tasks, then runs every OnStop in reverse
+// registration order. It returns an error if tasks had to be abandoned or a
+// hook failed, so the caller can exit non-zero.
+func (m *Manager) Stop() error {
+	m.mu.Lock()
+	m.stopping.Store(true)
+	m.mu.Unlock()
+
+	drainErr := m.drain()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.active = false
+	return errors.Join(drainErr, m.stopHooks())
+}
+
+// drain waits for tasks, cancels the stragglers, and gives them the
+// checkpoint timeout to wind down.
+func (m *Manager) drain() error {
+	done := make(chan struct{})
+	go func() {
+		m.tasks.Wait()
+		close(done)
+	}()
+
+	if running := m.Running(); running > 0 {
+		log.Printf("lifecycle: waiting up to %s for %d background task(s)", m.config.DrainTimeout, running)
+	}
+
+	select {
+	case <-done:
+		m.cancelTasks()
+		return nil
+	case <-time.After(m.config.DrainTimeout):
+	}
+
+	log.Printf("lifecycle: drain timeout reached, canceling %d background task(s)", m.Running())
+	m.cancelTasks()
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(m.config.CheckpointTimeout):
+		return fmt.Errorf("lifecycle: abandoned %d background task(s) after checkpoint timeout", m.Running())
+	}
+}
+
+// stopHooks stops started hooks in reverse order. Callers hold m.mu.
+func (m *Manager) stopHooks() error {
+	var errs []error
+	for m.started > 0 {
+		m.started--
+		hook := m.hooks[m.started]
+		if err := m.call(hook, "stop", hook.OnStop); err != nil {
+			errs = append(errs, fmt.Errorf("stop %s: %w", hook.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// call runs one callback with the hook's timeout. The callback runs in its
+// own goroutine so one that ignores its context cannot block the others.
+func (m *Manager) call(hook Hook, phase string, fn func(ctx context.Context) error) error {
+	if fn == nil {
+		return nil
+	}
+
+	timeout := hook.Timeout
+	if timeout <= 0 {
+		timeout = m.config.HookTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				done <- fmt.Errorf("panicked: %v", recovered)
+			}
+		}()
+		done <- fn(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			log.Printf("lifecycle: %s %s failed: %v", phase, hook.Name, err)
+		}
+		return err
+	case <-ctx.Done():
+		log.Printf("lifecycle: %s %s timed out after %s", phase, hook.Name, timeout)
+		return fmt.Errorf("timed out after %s", timeout)
+	}
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"go.uber.org/dig"
 
+	"github.com/moasq/backend/pkg/health"
 	"github.com/moasq/backend/pkg/llm/domain"
 	"github.com/moasq/backend/pkg/llm/infra"
 	loggerDomain "github.com/moasq/backend/pkg/logger/domain"
@@ -10,9 +11,20 @@ import (
 
 func Init(container *dig.Container) error {
 	// Register LLMClient (which includes LLMService)
-	if err := container.Provide(func(logger loggerDomain.Logger) (domain.LLMClient, error) {
+	if err := container.Provide(func(logger loggerDomain.Logger, registry *health.Registry) (domain.LLMClient, error) {
 		config := infra.NewLLMConfig()
-		return infra.NewOpenAIClient(config, logger)
+		client, err := infra.NewOpenAIClient(config, logger)
+		if err != nil {
+			return nil, err
+		}
+
+		if checker, ok := client.(interface{ HealthCheck() health.Check }); ok {
+			if err := registry.Register(checker.HealthCheck()); err != nil {
+				return nil, err
+			}
+		}
+
+		return client, nil
 	}); err != nil {
 		return err
 	}
@@ -7,4 +7,5 @@ var (
 	ErrProviderNotFound = errors.New("LLM provider not found")
 	ErrAPIError         = errors.New("LLM API error")
 	ErrTimeout          = errors.New("LLM request timeout")
+	ErrInvalidSchema    = errors.New("invalid JSON schema")
 )
\ No newline at end of file
@@ -0,0 +1,299 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Schema types
+const (
+	SchemaTypeObject  = "object"
+	SchemaTypeArray   = "array"
+	SchemaTypeString  = "string"
+	SchemaTypeNumber  = "number"
+	SchemaTypeInteger = "integer"
+	SchemaTypeBoolean = "boolean"
+)
+
+// String formats checked by Validate
+const (
+	SchemaFormatDate     = "date"      // 2006-01-02
+	SchemaFormatDateTime = "date-time" // RFC 3339
+)
+
+const (
+	// MaxSchemaDepth bounds how deeply objects and arrays may nest
+	MaxSchemaDepth = 5
+	// MaxSchemaProperties bounds the properties of a schema, counted at every level
+	MaxSchemaProperties = 100
+)
+
+// Schema is the subset of JSON Schema used for structured output: type,
+// properties, required, additionalProperties, items, enum, format, and the
+// numeric, length and item bounds.
+//
+// Properties that are not required may be missing or null.
+type Schema struct {
+	Type                 string             `json:"type"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+}
+
+// SchemaViolation is a place where a value does not follow a schema
+type SchemaViolation struct {
+	// Path locates the value, e.g. $.line_items[2].amount
+	Path    string
+	Message string
+}
+
+func (v SchemaViolation) String() string {
+	return v.Path + ": " + v.Message
+}
+
+// Check reports whether the schema uses only the supported keywords
+// correctly and stays within MaxSchemaDepth and MaxSchemaProperties.
+func (s *Schema) Check() error {
+	properties := 0
+	if err := s.check("$", 0, &properties); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSchema, err)
+	}
+	return nil
+}
+
+func (s *Schema) check(path string, depth int, properties *int) error {
+	if s == nil {
+		return fmt.Errorf("%s: schema is empty", path)
+	}
+	if depth > MaxSchemaDepth {
+		return fmt.Errorf("%s: nested deeper than %d levels", path, MaxSchemaDepth)
+	}
+
+	switch s.Type {
+	case SchemaTypeObject:
+		if len(s.Properties) == 0 {
+			return fmt.Errorf("%s: object has no properties", path)
+		}
+		*properties += len(s.Properties)
+		if *properties > MaxSchemaProperties {
+			return fmt.Errorf("%s: more than %d properties", path, MaxSchemaProperties)
+		}
+		for _, name := range s.Required {
+			if _, ok := s.Properties[name]; !ok {
+				return fmt.Errorf("%s: required property %q is not defined", path, name)
+			}
+		}
+		for _, name := range sortedKeys(s.Properties) {
+			if strings.TrimSpace(name) == "" {
+				return fmt.Errorf("%s: property name is empty", path)
+			}
+			if err := s.Properties[name].check(path+"."+name, depth+1, properties); err != nil {
+				return err
+			}
+		}
+	case SchemaTypeArray:
+		if s.Items == nil {
+			return fmt.Errorf("%s: array has no items schema", path)
+		}
+		if err := s.Items.check(path+"[]", depth+1, properties); err != nil {
+			return err
+		}
+	case SchemaTypeString, SchemaTypeNumber, SchemaTypeInteger, SchemaTypeBoolean:
+	default:
+		return fmt.Errorf("%s: unsupported type %q", path, s.Type)
+	}
+
+	if s.Format != "" && (s.Type != SchemaTypeString || (s.Format != SchemaFormatDate && s.Format != SchemaFormatDateTime)) {
+		return fmt.Errorf("%s: unsupported format %q", path, s.Format)
+	}
+	if s.Minimum != nil && s.Maximum != nil && *s.Minimum > *s.Maximum {
+		return fmt.Errorf("%s: minimum is greater than maximum", path)
+	}
+	if len(s.Enum) > 0 && (s.Type == SchemaTypeObject || s.Type == SchemaTypeArray) {
+		return fmt.Errorf("%s: enum is only supported on strings, numbers and booleans", path)
+	}
+	for _, v := range s.Enum {
+		if violation := s.validateType(path, normalizeNumber(v)); violation != nil {
+			return fmt.Errorf("%s: enum value %v is not a %s", path, v, s.Type)
+		}
+	}
+	return nil
+}
+
+// Validate checks a value decoded from JSON with encoding/json into an
+// interface value against the schema and returns every violation, or nil
+// when the value follows it.
+func (s *Schema) Validate(value any) []SchemaViolation {
+	var violations []SchemaViolation
+	s.validate("$", value, &violations)
+	return violations
+}
+
+func (s *Schema) validate(path string, value any, violations *[]SchemaViolation) {
+	if violation := s.validateType(path, value); violation != nil {
+		*violations = append(*violations, *violation)
+		return
+	}
+
+	add := func(format string, args ...any) {
+		*violations = append(*violations, SchemaViolation{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(v any) bool { return normalizeNumber(v) == value }) {
+		add("must be one of %s", formatEnum(s.Enum))
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		for _, name := range s.Required {
+			if v[name] == nil {
+				*violations = append(*violations, SchemaViolation{Path: path + "." + name, Message: "is required"})
+			}
+		}
+		for _, name := range sortedKeys(v) {
+			property, ok := s.Properties[name]
+			switch {
+			case !ok && s.AdditionalProperties != nil && !*s.AdditionalProperties:
+				*violations = append(*violations, SchemaViolation{Path: path + "." + name, Message: "is not allowed"})
+			case ok && v[name] != nil:
+				property.validate(path+"."+name, v[name], violations)
+			}
+		}
+	case []any:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			add("must have at least %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			add("must have at most %d items", *s.MaxItems)
+		}
+		for i, item := range v {
+			s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item, violations)
+		}
+	case string:
+		length := utf8.RuneCountInString(v)
+		if s.MinLength != nil && length < *s.MinLength {
+			add("must be at least %d characters", *s.MinLength)
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			add("must be at most %d characters", *s.MaxLength)
+		}
+		switch s.Format {
+		case SchemaFormatDate:
+			if _, err := time.Parse(time.DateOnly, v); err != nil {
+				add("must be a date in YYYY-MM-DD format")
+			}
+		case SchemaFormatDateTime:
+			if _, err := time.Parse(time.RFC3339, v); err != nil {
+				add("must be a date-time in RFC 3339 format")
+			}
+		}
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			add("must be at least %v", *s.Minimum)
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			add("must be at most %v", *s.Maximum)
+		}
+	}
+}
+
+// validateType reports a value whose JSON type does not match the schema
+func (s *Schema) validateType(path string, value any) *SchemaViolation {
+	var ok bool
+	switch s.Type {
+	case SchemaTypeObject:
+		_, ok = value.(map[string]any)
+	case SchemaTypeArray:
+		_, ok = value.([]any)
+	case SchemaTypeString:
+		_, ok = value.(string)
+	case SchemaTypeNumber:
+		_, ok = value.(float64)
+	case SchemaTypeInteger:
+		n, isNumber := value.(float64)
+		ok = isNumber && n == math.Trunc(n)
+	case SchemaTypeBoolean:
+		_, ok = value.(bool)
+	}
+	if ok {
+		return nil
+	}
+	return &SchemaViolation{Path: path, Message: fmt.Sprintf("must be %s, got %s", withArticle(s.Type), jsonTypeName(value))}
+}
+
+// normalizeNumber converts the integer enum values of schemas built in Go
+// to the float64 that encoding/json decodes numbers into
+func normalizeNumber(v any) any {
+	switch n := v.(type) {
+	case int:
+		return float64(n)
+	case int32:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case float32:
+		return float64(n)
+	}
+	return v
+}
+
+func jsonTypeName(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "an array"
+	case string:
+		return "a string"
+	case float64:
+		if v == math.Trunc(v) {
+			return "an integer"
+		}
+		return "a number"
+	case bool:
+		return "a boolean"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func withArticle(schemaType string) string {
+	switch schemaType {
+	case SchemaTypeObject, SchemaTypeArray, SchemaTypeInteger:
+		return "an " + schemaType
+	}
+	return "a " + schemaType
+}
+
+func formatEnum(values []any) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%q", fmt.Sprint(v))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -6,6 +6,17 @@ type CompletionRequest struct {
 	Prompt      string
 	MaxTokens   *int
 	Temperature *float32
+	// ResponseFormat asks for a JSON reply; nil asks for text
+	ResponseFormat *ResponseFormat
+}
+
+// ResponseFormat requests structured output: a JSON object that follows
+// Schema. Providers guide the model with the schema but do not guarantee
+// the reply follows it, so callers validate it with Schema.Validate.
+type ResponseFormat struct {
+	// Name identifies the schema to the provider: letters, digits, _ and -
+	Name   string
+	Schema *Schema
 }
 
 type CompletionResponse struct {
@@ -0,0 +1,31 @@
+package infra
+
+import (
+	"context"
+
+	"github.com/moasq/backend/pkg/health"
+)
+
+// HealthCheck reports the circuit breaker state. It is not critical: the
+// provider is shared by every instance, so an open breaker is no reason to
+// take this one out of rotation.
+func (c *OpenAIClient) HealthCheck() health.Check {
+	return health.Check{
+		Name: "llm",
+		Run: func(ctx context.Context) (health.Details, error) {
+			details := health.Details{"provider": "openai", "model": c.config.Model}
+			if c.circuitBreaker == nil {
+				details["circuit_breaker"] = "disabled"
+				return details, nil
+			}
+
+			stats := c.circuitBreaker.GetStats()
+			details["circuit_breaker"] = stats["state"]
+			details["failures"] = stats["failures"]
+			if stats["state"] == "open" {
+				return details, health.Degraded("circuit breaker is open")
+			}
+			return details, nil
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package infra
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/moasq/backend/pkg/llm/domain"
+	"github.com/moasq/backend/pkg/metrics"
+)
+
+// Models come from configuration, not from callers, so the model label is
+// bounded.
+var (
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "llm_request_duration_seconds",
+		Help:    "LLM API request latency by operation, model, and outcome.",
+		Buckets: metrics.ExternalCallBuckets,
+	}, []string{"provider", "operation", "model", "outcome"})
+
+	tokensUsed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llm_tokens_total",
+		Help: "Tokens consumed by LLM requests, as reported by the provider.",
+	}, []string{"provider", "operation", "model"})
+)
+
+func observeRequest(operation, model string, start time.Time, response *domain.CompletionResponse, err error) {
+	requestDuration.WithLabelValues("openai", operation, model, metrics.Outcome(err)).Observe(time.Since(start).Seconds())
+	if err == nil && response != nil && response.TokensUsed > 0 {
+		tokensUsed.WithLabelValues("openai", operation, model).Add(float64(response.TokensUsed))
+	}
+}
+
+// circuitBreakerStates lists every state so the state gauge always reports
+// all of them, with 1 on the current one.
+var circuitBreakerStates = []string{"closed", "half-open", "open"}
+
+// circuitBreakerCollector exports CircuitBreaker.GetStats at scrape time.
+type circuitBreakerCollector struct {
+	breaker   *CircuitBreaker
+	state     *prometheus.Desc
+	failures  *prometheus.Desc
+	successes *prometheus.Desc
+}
+
+func newCircuitBreakerCollector(breaker *CircuitBreaker, provider string) *circuitBreakerCollector {
+	labels := prometheus.Labels{"provider": provider}
+	return &circuitBreakerCollector{
+		breaker: breaker,
+		state: prometheus.NewDesc("llm_circuit_breaker_state",
+			"Current circuit breaker state (1 for the active state).", []string{"state"}, labels),
+		failures: prometheus.NewDesc("llm_circuit_breaker_failures",
+			"Consecutive failures counted toward opening the breaker.", nil, labels),
+		successes: prometheus.NewDesc("llm_circuit_breaker_successes_total",
+			"Successful requests recorded by the breaker.", nil, labels),
+	}
+}
+
+func (c *circuitBreakerCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.state
+	ch <- c.failures
+	ch <- c.successes
+}
+
+func (c *circuitBreakerCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.breaker.GetStats()
+
+	current, _ := stats["state"].(string)
+	for _, state := range circuitBreakerStates {
+		value := 0.0
+		if state == current {
+			value = 1
+		}
+		ch <- prometheus.MustNewConstMetric(c.state, prometheus.GaugeValue, value, state)
+	}
+
+	failures, _ := stats["failures"].(int64)
+	successes, _ := stats["successes"].(int64)
+	ch <- prometheus.MustNewConstMetric(c.failures, prometheus.GaugeValue, float64(failures))
+	ch <- prometheus.MustNewConstMetric(c.successes, prometheus.CounterValue, float64(successes))
+}
+
+// registerCircuitBreakerMetrics exports breaker for provider. A second client
+// for the same provider keeps the first registration.
+func registerCircuitBreakerMetrics(breaker *CircuitBreaker, provider string) error {
+	err := prometheus.Register(newCircuitBreakerCollector(breaker, provider))
+	var already prometheus.AlreadyRegisteredError
+	if errors.As(err, &already) {
+		return nil
+	}
+	return err
+}
@@ -137,6 +137,21 @@ type openAIRequest struct {
 	Temperature *float32        `json:"temperature,omitempty"`
 	Stop        []string        `json:"stop,omitempty"`
 	Stream      bool            `json:"stream,omitempty"`
+
+	ResponseFormat *openAIResponseFormat `json:"response_format,omitempty"`
+}
+
+type openAIResponseFormat struct {
+	Type       string            `json:"type"` // "json_schema"
+	JSONSchema *openAIJSONSchema `json:"json_schema,omitempty"`
+}
+
+type openAIJSONSchema struct {
+	Name   string         `json:"name"`
+	Schema *domain.Schema `json:"schema"`
+	// Strict mode requires every property to be required and rejects
+	// additional properties, which the schema subset allows
+	Strict bool `json:"strict"`
 }
 
 type ToolCall struct {
@@ -252,6 +267,12 @@ func NewOpenAIClient(config Config, logger loggerDomain.Logger) (domain.LLMClien
 			"max_failures":   maxFailures,
 			"reset_timeout":  resetTimeout,
 		})
+
+		if err := registerCircuitBreakerMetrics(circuitBreaker, "openai"); err != nil {
+			logger.Warn("Failed to register circuit breaker metrics", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
 	}
 
 	return &OpenAIClient{
@@ -305,8 +326,20 @@ func (c *OpenAIClient) Complete(ctx context.Context, request domain.CompletionRe
 		openAIReq.Temperature = &temperature
 	}
 
-	// Only set stop sequences for models that support them (GPT-5 models don't accept stop parameter)
-	if supportsStop(c.config.Model) {
+	// Only set stop sequences for models that support them (GPT-5 models don't accept stop parameter).
+	// Structured output has none: a blank line would cut off indented JSON.
+	if request.ResponseFormat != nil {
+		if request.ResponseFormat.Schema == nil {
+			return nil, fmt.Errorf("%w: response format has no schema", domain.ErrInvalidSchema)
+		}
+		openAIReq.ResponseFormat = &openAIResponseFormat{
+			Type: "json_schema",
+			JSONSchema: &openAIJSONSchema{
+				Name:   request.ResponseFormat.Name,
+				Schema: request.ResponseFormat.Schema,
+			},
+		}
+	} else if supportsStop(c.config.Model) {
 		openAIReq.Stop = []string{"\n\n", "\n---"}
 	}
 
@@ -323,8 +356,11 @@ func (c *OpenAIClient) Complete(ctx context.Context, request domain.CompletionRe
 		if supportsTemperature(c.config.Model) {
 			logData["temperature"] = temperature
 		}
-		if supportsStop(c.config.Model) {
-			logData["stop_sequences"] = []string{"\n\n", "\n---"}
+		if len(openAIReq.Stop) > 0 {
+			logData["stop_sequences"] = openAIReq.Stop
+		}
+		if openAIReq.ResponseFormat != nil {
+			logData["response_format"] = openAIReq.ResponseFormat.JSONSchema.Name
 		}
 		c.logger.Info("Starting OpenAI request", logData)
 
@@ -334,7 +370,7 @@ func (c *OpenAIClient) Complete(ctx context.Context, request domain.CompletionRe
 		} else {
 			debugMsg += " | Temperature: OMITTED"
 		}
-		if supportsStop(c.config.Model) {
+		if len(openAIReq.Stop) > 0 {
 			debugMsg += " | Stop: [\\n\\n, \\n---]"
 		} else {
 			debugMsg += " | Stop: OMITTED"
@@ -530,6 +566,15 @@ func (c *OpenAIClient) CompleteStream(ctx context.Context, request domain.Comple
 }
 
 func (c *OpenAIClient) makeRequest(ctx context.Context, request openAIRequest) (*domain.CompletionResponse, error) {
+	start := time.Now()
+	ctx, span := startSpan(ctx, "chat", request.Model)
+	response, err := c.sendRequest(ctx, request)
+	endSpan(span, response, err)
+	observeRequest("chat", request.Model, start, response, err)
+	return response, err
+}
+
+func (c *OpenAIClient) sendRequest(ctx context.Context, request openAIRequest) (*domain.CompletionResponse, error) {
 	jsonData, err := json.Marshal(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
@@ -670,6 +715,16 @@ func (c *OpenAIClient) GenerateEmbedding(ctx context.Context, text string, model
 		model = "text-embedding-3-small" // Default embedding model
 	}
 
+	start := time.Now()
+	ctx, span := startSpan(ctx, "embeddings", model)
+	embedding, err := c.generateEmbedding(ctx, text, model)
+	endSpan(span, nil, err)
+	observeRequest("embeddings", model, start, nil, err)
+	return embedding, err
+}
+
+func (c *OpenAIClient) generateEmbedding(ctx context.Context, text string, model string) ([]float64, error) {
+
 	embeddingReq := map[string]any{
 		"model": model,
 		"input": text,
@@ -759,6 +814,15 @@ type streamResponse struct {
 }
 
 func (c *OpenAIClient) makeStreamRequest(ctx context.Context, request openAIRequest, callback func(domain.StreamChunk) error) (*domain.CompletionResponse, error) {
+	start := time.Now()
+	ctx, span := startSpan(ctx, "chat", request.Model)
+	response, err := c.sendStreamRequest(ctx, request, callback)
+	endSpan(span, response, err)
+	observeRequest("chat", request.Model, start, response, err)
+	return response, err
+}
+
+func (c *OpenAIClient) sendStreamRequest(ctx context.Context, request openAIRequest, callback func(domain.StreamChunk) error) (*domain.CompletionResponse, error) {
 	jsonData, err := json.Marshal(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal stream request: %w", err)
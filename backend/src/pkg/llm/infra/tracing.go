@@ -0,0 +1,45 @@
+package infra
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/moasq/backend/pkg/llm/domain"
+)
+
+const tracerName = "github.com/moasq/backend/pkg/llm"
+
+var tracer = otel.Tracer(tracerName)
+
+// startSpan starts a client span for one OpenAI API call. Retries appear as
+// sibling spans under the caller's span.
+func startSpan(ctx context.Context, operation, model string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, operation+" "+model,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("gen_ai.system", "openai"),
+			attribute.String("gen_ai.operation.name", operation),
+			attribute.String("gen_ai.request.model", model),
+		),
+	)
+}
+
+func endSpan(span trace.Span, response *domain.CompletionResponse, err error) {
+	defer span.End()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	if response != nil {
+		span.SetAttributes(
+			attribute.String("gen_ai.response.model", response.Model),
+			attribute.Int("gen_ai.usage.total_tokens", response.TokensUsed),
+		)
+	}
+}
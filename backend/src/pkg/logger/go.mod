@@ -2,9 +2,9 @@ module github.com/moasq/backend/pkg/logger
 
 go 1.25
 
-
 require (
 	github.com/rs/zerolog v1.33.0
+	go.opentelemetry.io/otel/trace v1.38.0
 	go.uber.org/dig v1.19.0
 	gopkg.in/natefinch/lumberjack.v2 v2.2.1
 )
@@ -14,6 +14,6 @@ require (
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
-	github.com/stretchr/testify v1.11.1 // indirect
+	go.opentelemetry.io/otel v1.38.0 // indirect
 	golang.org/x/sys v0.31.0 // indirect
 )
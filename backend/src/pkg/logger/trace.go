@@ -0,0 +1,25 @@
+package logger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// WithTrace returns l with the trace_id and span_id of the span in ctx, so
+// log lines can be joined with traces. Returns l unchanged when ctx is not
+// traced.
+//
+// Example:
+//
+//	logger.WithTrace(ctx, s.logger).Info("document processed", logger.Fields{"document_id": id})
+func WithTrace(ctx context.Context, l Logger) Logger {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return l
+	}
+	return l.WithFields(Fields{
+		"trace_id": spanContext.TraceID().String(),
+		"span_id":  spanContext.SpanID().String(),
+	})
+}
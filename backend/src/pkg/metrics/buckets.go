@@ -0,0 +1,6 @@
+package metrics
+
+// ExternalCallBuckets are histogram buckets, in seconds, for calls to
+// third-party APIs such as LLM and OCR providers, which take from a few
+// hundred milliseconds to minutes.
+var ExternalCallBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 20, 30, 60, 120}
@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/moasq/backend/pkg/metrics"
+	"go.uber.org/dig"
+)
+
+// Init provides the metrics configuration and applies its label policy.
+// Call it before modules that record metrics.
+func Init(container *dig.Container) error {
+	if err := container.Provide(metrics.LoadConfig); err != nil {
+		return fmt.Errorf("failed to provide metrics config: %w", err)
+	}
+
+	if err := container.Invoke(metrics.Configure); err != nil {
+		return fmt.Errorf("failed to initialize metrics: %w", err)
+	}
+
+	return nil
+}
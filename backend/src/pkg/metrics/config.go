@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+// Config configures the Prometheus endpoint and optional per-organization
+// breakdowns.
+type Config struct {
+	Enabled bool   `mapstructure:"METRICS_ENABLED"`
+	Path    string `mapstructure:"METRICS_PATH"`
+	// AuthToken, when set, must be sent as a bearer token to scrape Path.
+	AuthToken string `mapstructure:"METRICS_AUTH_TOKEN"`
+	// OrganizationLabels adds an organization_id label to HTTP and quota
+	// metrics. Off by default: it multiplies series by the number of tenants.
+	OrganizationLabels bool `mapstructure:"METRICS_ORGANIZATION_LABELS"`
+	// OrganizationLabelLimit caps how many distinct organizations get their
+	// own series; the rest are reported as "other".
+	OrganizationLabelLimit int `mapstructure:"METRICS_ORGANIZATION_LABEL_LIMIT"`
+}
+
+// LoadConfig reads the metrics configuration from app.env or the environment.
+func LoadConfig() (*Config, error) {
+	var cfg Config
+
+	viper.SetConfigName("app")
+	viper.SetConfigType("env")
+	viper.AddConfigPath(".")
+	viper.AutomaticEnv()
+
+	viper.SetDefault("METRICS_ENABLED", true)
+	viper.SetDefault("METRICS_PATH", "/metrics")
+	viper.SetDefault("METRICS_ORGANIZATION_LABELS", false)
+	viper.SetDefault("METRICS_ORGANIZATION_LABEL_LIMIT", 100)
+
+	_ = viper.ReadInConfig()
+
+	if err := viper.Unmarshal(&cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.Enabled && (cfg.Path == "" || cfg.Path[0] != '/') {
+		return nil, fmt.Errorf("invalid METRICS_PATH %q: must start with /", cfg.Path)
+	}
+	if cfg.OrganizationLabelLimit < 1 {
+		return nil, fmt.Errorf("invalid METRICS_ORGANIZATION_LABEL_LIMIT %d: must be at least 1", cfg.OrganizationLabelLimit)
+	}
+
+	return &cfg, nil
+}
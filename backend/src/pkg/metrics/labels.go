@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"strconv"
+	"sync"
+)
+
+// Label values shared across packages.
+const (
+	OutcomeSuccess = "success"
+	OutcomeError   = "error"
+
+	// organizationOther groups organizations beyond the configured limit.
+	organizationOther = "other"
+)
+
+var organizations = &organizationLabels{}
+
+// organizationLabels decides the organization_id label value. Disabled, it
+// is always empty, which Prometheus treats as an absent label, so series
+// stay one per route or outcome.
+type organizationLabels struct {
+	mu      sync.RWMutex
+	enabled bool
+	limit   int
+	seen    map[int32]string
+}
+
+// Configure applies cfg to the package-level label policy.
+func Configure(cfg *Config) {
+	organizations.mu.Lock()
+	defer organizations.mu.Unlock()
+
+	organizations.enabled = cfg.OrganizationLabels
+	organizations.limit = cfg.OrganizationLabelLimit
+	organizations.seen = make(map[int32]string)
+}
+
+// OrganizationLabel returns the organization_id label value for id: empty
+// unless METRICS_ORGANIZATION_LABELS is on, and "other" once the label limit
+// is reached.
+func OrganizationLabel(id int32) string {
+	organizations.mu.RLock()
+	enabled := organizations.enabled
+	value, ok := organizations.seen[id]
+	organizations.mu.RUnlock()
+
+	if !enabled || id == 0 {
+		return ""
+	}
+	if ok {
+		return value
+	}
+
+	organizations.mu.Lock()
+	defer organizations.mu.Unlock()
+	if value, ok := organizations.seen[id]; ok {
+		return value
+	}
+	if len(organizations.seen) >= organizations.limit {
+		return organizationOther
+	}
+	value = strconv.FormatInt(int64(id), 10)
+	organizations.seen[id] = value
+	return value
+}
+
+// Outcome maps err to OutcomeSuccess or OutcomeError.
+func Outcome(err error) string {
+	if err != nil {
+		return OutcomeError
+	}
+	return OutcomeSuccess
+}
@@ -0,0 +1,27 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Webhook outcomes.
+const (
+	WebhookProcessed = "processed"
+	WebhookDuplicate = "duplicate"
+	WebhookIgnored   = "ignored"
+	WebhookInvalid   = "invalid"
+	WebhookRejected  = "rejected"
+	WebhookFailed    = "failed"
+)
+
+var webhookEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "webhook_events_total",
+	Help: "Inbound webhook deliveries by provider, event type, and outcome.",
+}, []string{"provider", "event_type", "outcome"})
+
+// ObserveWebhook counts one webhook delivery. eventType must come from a
+// fixed set; map anything unrecognized to "other" before calling.
+func ObserveWebhook(provider, eventType, outcome string) {
+	webhookEvents.WithLabelValues(provider, eventType, outcome).Inc()
+}
@@ -1,16 +1,114 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+
 	"go.uber.org/dig"
 
+	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/health"
+	"github.com/moasq/backend/pkg/lifecycle"
+	loggerDomain "github.com/moasq/backend/pkg/logger/domain"
 	"github.com/moasq/backend/pkg/ocr/domain"
 	"github.com/moasq/backend/pkg/ocr/infra"
-	loggerDomain "github.com/moasq/backend/pkg/logger/domain"
 )
 
 func Init(container *dig.Container) error {
-	return container.Provide(func(logger loggerDomain.Logger) (domain.OCRService, error) {
-		config := infra.NewOCRConfig()
+	if err := container.Provide(newOCRService); err != nil {
+		return err
+	}
+
+	if err := container.Provide(func(store adapters.OCRJobStore) domain.JobRepository {
+		return infra.NewJobRepository(store)
+	}); err != nil {
+		return err
+	}
+
+	if err := container.Provide(newAsyncOCRService); err != nil {
+		return err
+	}
+
+	// Build the async service now so its lifecycle hook registers before
+	// startup, even when no module has asked for it yet
+	return container.Invoke(func(domain.AsyncOCRService) {})
+}
+
+func newOCRService(logger loggerDomain.Logger, registry *health.Registry) (domain.OCRService, error) {
+	config := infra.NewOCRConfig()
+	if err := config.ValidateProviders(); err != nil {
+		return nil, fmt.Errorf("invalid OCR config: %w", err)
+	}
+	if err := config.ValidateJobs(); err != nil {
+		return nil, fmt.Errorf("invalid OCR config: %w", err)
+	}
+
+	providers := make([]infra.Provider, 0, len(config.Providers))
+	for _, name := range config.Providers {
+		service, err := newProvider(name, config, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OCR provider %s: %w", name, err)
+		}
+		providers = append(providers, infra.Provider{
+			Name:          name,
+			Service:       service,
+			MinConfidence: config.MinConfidence[name],
+		})
+	}
+
+	chain, err := infra.NewFailoverOCRService(providers, config, logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := registry.Register(chain.HealthCheck()); err != nil {
+		return nil, err
+	}
+
+	// Long PDFs reach the providers in page ranges
+	ocr := infra.NewChunkedOCRService(chain, config, logger)
+
+	if config.PDFTextLayer {
+		ocr = infra.NewTextLayerFirstOCRService(infra.NewPDFTextExtractor(logger), ocr, config, logger)
+	}
+
+	// DOCX, XLSX, CSV and plain text never reach a provider
+	return infra.NewLocalFormatsOCRService(ocr, logger), nil
+}
+
+func newAsyncOCRService(
+	ocr domain.OCRService,
+	jobs domain.JobRepository,
+	logger loggerDomain.Logger,
+	manager *lifecycle.Manager,
+) (domain.AsyncOCRService, error) {
+	config := infra.NewOCRConfig()
+	if err := config.ValidateJobs(); err != nil {
+		return nil, fmt.Errorf("invalid OCR config: %w", err)
+	}
+
+	service := infra.NewAsyncOCRService(ocr, jobs, config, logger)
+
+	// Fail jobs still running at shutdown so callers can resubmit them
+	if err := manager.Append(lifecycle.Hook{
+		Name: "ocr.jobs",
+		OnStart: func(context.Context) error {
+			service.Start()
+			return nil
+		},
+		OnStop:  service.Stop,
+		Timeout: service.StopTimeout(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func newProvider(name string, config infra.Config, logger loggerDomain.Logger) (domain.OCRService, error) {
+	switch name {
+	case infra.ProviderTesseract:
+		return infra.NewTesseractOCRClient(config, logger)
+	default:
 		return infra.NewMistralOCRClient(config, logger)
-	})
-}
\ No newline at end of file
+	}
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// Headers of a job callback. The signature is "sha256=" followed by the hex
+// HMAC-SHA256 of "{timestamp}.{body}", keyed with the job's CallbackSecret.
+const (
+	CallbackTimestampHeader = "X-OCR-Timestamp"
+	CallbackSignatureHeader = "X-OCR-Signature"
+)
+
+// DefaultCallbackTolerance bounds how old a callback timestamp may be before
+// VerifyCallbackSignature rejects it
+const DefaultCallbackTolerance = 5 * time.Minute
+
+// CallbackSignature returns the signature header value of a callback body
+// sent at timestamp, in Unix seconds
+func CallbackSignature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyCallbackSignature checks the headers of a job callback against the
+// secret returned by SubmitJob. Receivers written in Go can call it from
+// their handler; others recompute CallbackSignature.
+func VerifyCallbackSignature(secret, timestamp string, body []byte, signature string, tolerance time.Duration) error {
+	if secret == "" || timestamp == "" || signature == "" {
+		return fmt.Errorf("%w: missing callback signature", ErrInvalidInput)
+	}
+
+	sentAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: invalid callback timestamp", ErrInvalidInput)
+	}
+	if tolerance > 0 && math.Abs(float64(time.Since(time.Unix(sentAt, 0)))) > float64(tolerance) {
+		return fmt.Errorf("%w: callback timestamp outside tolerance", ErrInvalidInput)
+	}
+
+	if !hmac.Equal([]byte(CallbackSignature(secret, timestamp, body)), []byte(signature)) {
+		return fmt.Errorf("%w: callback signature mismatch", ErrInvalidInput)
+	}
+	return nil
+}
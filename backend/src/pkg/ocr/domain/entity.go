@@ -1,8 +1,71 @@
 package domain
 
+// Extractors that produce OCRResponse text
+const (
+	ExtractorPDFText   = "pdf_text"  // Embedded text layer of a PDF
+	ExtractorMistral   = "mistral"   // Mistral OCR
+	ExtractorTesseract = "tesseract" // Local Tesseract OCR
+	ExtractorDOCX      = "docx"      // Text and tables of a Word document
+	ExtractorXLSX      = "xlsx"      // Cells of an Excel workbook
+	ExtractorCSV       = "csv"       // Rows of a CSV file
+	ExtractorPlainText = "text"      // Plain text file
+)
+
+// MIME types of formats that carry their own text and are read without OCR
+const (
+	MimeTypeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	MimeTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	MimeTypeCSV  = "text/csv"
+	MimeTypeText = "text/plain"
+)
+
+// Block types
+const (
+	BlockTypeText  = "text"  // Text region reported by the provider
+	BlockTypeLine  = "line"  // Single line of text
+	BlockTypeImage = "image" // Embedded image or figure
+)
+
 // OCRResponse represents the result of OCR text extraction
 type OCRResponse struct {
-	Text       string  `json:"text"`       // Extracted text
-	Pages      int     `json:"pages"`      // Number of pages processed
-	Confidence float32 `json:"confidence"` // OCR confidence score (0.0 to 1.0)
-}
\ No newline at end of file
+	Text       string     `json:"text"`                 // Extracted text
+	Pages      int        `json:"pages"`                // Number of pages processed
+	Confidence float32    `json:"confidence"`           // OCR confidence score (0.0 to 1.0)
+	Extractor  string     `json:"extractor,omitempty"`  // Extractor that produced the text, e.g. "pdf_text" or "mistral"
+	PageTexts  []PageText `json:"page_texts,omitempty"` // Per-page text and layout, in page order
+}
+
+// PageText is the text and layout of a single page
+type PageText struct {
+	Number    int     `json:"number"`           // 1-based page number
+	Text      string  `json:"text"`             // Extracted text of the page
+	Extractor string  `json:"extractor"`        // Extractor that produced the page text
+	Width     float32 `json:"width,omitempty"`  // Page width in the extractor's unit (points or pixels), 0 when unknown
+	Height    float32 `json:"height,omitempty"` // Page height in the same unit
+	Blocks    []Block `json:"blocks,omitempty"` // Positioned regions of the page
+	Tables    []Table `json:"tables,omitempty"` // Tables found on the page
+}
+
+// BoundingBox locates a region relative to the page size: coordinates are
+// fractions (0.0 to 1.0) of the page width and height, with the origin at the
+// top-left corner, so they can be drawn over a page rendered at any size.
+type BoundingBox struct {
+	X      float32 `json:"x"`
+	Y      float32 `json:"y"`
+	Width  float32 `json:"width"`
+	Height float32 `json:"height"`
+}
+
+// Block is a positioned region of a page
+type Block struct {
+	Type       string      `json:"type"`                 // One of the BlockType constants
+	Text       string      `json:"text,omitempty"`       // Text of the region, empty for images
+	BBox       BoundingBox `json:"bbox"`                 // Position on the page
+	Confidence float32     `json:"confidence,omitempty"` // Recognition confidence (0.0 to 1.0), when reported
+}
+
+// Table is a table found on a page
+type Table struct {
+	BBox *BoundingBox `json:"bbox,omitempty"` // Position on the page, when known
+	Rows [][]string   `json:"rows"`           // Cell text, header row first
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// mimeTypes maps the file extensions the OCR pipeline reads to their MIME
+// type. Images and PDFs go to the providers; the other formats are read
+// locally.
+var mimeTypes = map[string]string{
+	".pdf":  "application/pdf",
+	".png":  "image/png",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".webp": "image/webp",
+	".tif":  "image/tiff",
+	".tiff": "image/tiff",
+	".docx": MimeTypeDOCX,
+	".xlsx": MimeTypeXLSX,
+	".csv":  MimeTypeCSV,
+	".txt":  MimeTypeText,
+}
+
+// MimeTypeForFileName returns the MIME type of a supported file by its
+// extension
+func MimeTypeForFileName(fileName string) (string, bool) {
+	mimeType, ok := mimeTypes[strings.ToLower(filepath.Ext(fileName))]
+	return mimeType, ok
+}
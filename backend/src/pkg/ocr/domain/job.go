@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// JobStatus represents the state of an asynchronous OCR job
+type JobStatus string
+
+const (
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusCanceled  JobStatus = "canceled"
+)
+
+// JobRequest describes a file to extract asynchronously
+type JobRequest struct {
+	// OrganizationID owns the job; only it can read or cancel the job
+	OrganizationID int32
+	Base64File     string
+	MimeType       string
+	// CallbackURL, when set, receives a POST with the Job as JSON once the
+	// job succeeds, fails or is canceled, signed with the job's
+	// CallbackSecret
+	CallbackURL string
+}
+
+// Job is an asynchronous OCR job. Result is set once the job has succeeded.
+// CallbackSecret signs the callback; it is only set on the job returned by
+// SubmitJob and is not stored.
+type Job struct {
+	ID             int32        `json:"id"`
+	OrganizationID int32        `json:"organization_id"`
+	Status         JobStatus    `json:"status"`
+	MimeType       string       `json:"mime_type"`
+	TotalPages     int          `json:"total_pages"`
+	CompletedPages int          `json:"completed_pages"`
+	CallbackURL    string       `json:"callback_url,omitempty"`
+	CallbackSecret string       `json:"callback_secret,omitempty"`
+	Result         *OCRResponse `json:"result,omitempty"`
+	Error          string       `json:"error,omitempty"`
+	StartedAt      *time.Time   `json:"started_at,omitempty"`
+	FinishedAt     *time.Time   `json:"finished_at,omitempty"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+// IsActive reports whether the job is still queued or running
+func (j *Job) IsActive() bool {
+	return j.Status == JobStatusQueued || j.Status == JobStatusRunning
+}
+
+// Err returns ErrAsyncJobFailed with the recorded error for a failed job,
+// and nil otherwise
+func (j *Job) Err() error {
+	if j.Status != JobStatusFailed {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrAsyncJobFailed, j.Error)
+}
+
+// JobRepository persists asynchronous OCR jobs. State changes report whether
+// the job was still in the expected state; false means it was canceled or
+// finished meanwhile. Every method but FailStale is scoped to the
+// organization that owns the job; another organization's job is not found.
+type JobRepository interface {
+	// Create returns ErrJobLimitReached when the organization already has
+	// orgLimit queued or running jobs
+	Create(ctx context.Context, orgID int32, mimeType, callbackURL string, orgLimit int) (*Job, error)
+	Get(ctx context.Context, orgID, id int32) (*Job, error)
+	Start(ctx context.Context, orgID, id int32, totalPages int) (bool, error)
+	UpdateProgress(ctx context.Context, orgID, id int32, completedPages int) (bool, error)
+	Complete(ctx context.Context, orgID, id int32, result *OCRResponse) (bool, error)
+	Fail(ctx context.Context, orgID, id int32, message string) (bool, error)
+	Cancel(ctx context.Context, orgID, id int32) (bool, error)
+	// FailStale fails active jobs of all organizations not updated within
+	// olderThan and returns how many were failed
+	FailStale(ctx context.Context, olderThan time.Duration, message string) (int64, error)
+}
@@ -5,4 +5,30 @@ import "context"
 // OCRService provides text extraction from files
 type OCRService interface {
 	ExtractText(ctx context.Context, base64File string, mimeType string) (*OCRResponse, error)
-}
\ No newline at end of file
+}
+
+// PageOCRService is implemented by providers that can process a subset of a
+// document's pages. Page numbers are 1-based.
+type PageOCRService interface {
+	ExtractPages(ctx context.Context, base64File string, mimeType string, pages []int) (*OCRResponse, error)
+}
+
+// AsyncOCRService extracts text in the background, for documents too large
+// to process within a request. Jobs are polled with GetJob or reported to the
+// request's callback URL.
+type AsyncOCRService interface {
+	// SubmitJob stores a job and starts processing it. With a callback URL,
+	// the returned job carries the secret its callback is signed with. It
+	// returns ErrJobLimitReached when the instance or the organization has
+	// too many jobs.
+	SubmitJob(ctx context.Context, req JobRequest) (*Job, error)
+
+	// GetJob returns the current state of a job of the organization, or
+	// ErrJobNotFound
+	GetJob(ctx context.Context, orgID, id int32) (*Job, error)
+
+	// CancelJob stops a queued or running job. Canceling a finished job is a
+	// no-op; an unknown job, or one of another organization, returns
+	// ErrJobNotFound.
+	CancelJob(ctx context.Context, orgID, id int32) error
+}
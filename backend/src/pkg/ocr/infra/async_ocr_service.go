@@ -0,0 +1,397 @@
+package infra
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	loggerDomain "github.com/moasq/backend/pkg/logger/domain"
+	"github.com/moasq/backend/pkg/ocr/domain"
+)
+
+// Cancel causes of a running job
+var (
+	errJobCanceled  = errors.New("OCR job canceled")
+	errJobTimedOut  = errors.New("OCR job timed out")
+	errShuttingDown = errors.New("OCR job interrupted by shutdown")
+)
+
+const (
+	// staleSweepInterval is how often jobs abandoned by a crashed instance
+	// are failed
+	staleSweepInterval = time.Minute
+	// recordTimeout bounds writing the final state of a job
+	recordTimeout = 5 * time.Second
+)
+
+// AsyncOCRService runs OCR jobs in the background of the instance that
+// accepted them. Job state lives in Postgres, so any instance can report or
+// cancel a job; a running job notices a cancel from another instance when it
+// next records progress. Jobs still running at shutdown are failed and have
+// to be resubmitted.
+//
+// At most JobConcurrency jobs are extracted at once per instance, and up to
+// JobQueueSize more wait for a slot; further submissions are rejected, as
+// are those of an organization with JobOrgLimit active jobs.
+type AsyncOCRService struct {
+	ocr    domain.OCRService
+	jobs   domain.JobRepository
+	client *http.Client
+	config Config
+	logger loggerDomain.Logger
+
+	// accepted holds a token per job this instance has taken on, running
+	// holds one per job being extracted
+	accepted chan struct{}
+	running  chan struct{}
+
+	mu       sync.Mutex
+	active   map[int32]context.CancelCauseFunc
+	stopping bool
+	stop     chan struct{}
+	tasks    sync.WaitGroup
+}
+
+func NewAsyncOCRService(ocr domain.OCRService, jobs domain.JobRepository, config Config, logger loggerDomain.Logger) *AsyncOCRService {
+	return &AsyncOCRService{
+		ocr:      ocr,
+		jobs:     jobs,
+		client:   newCallbackClient(config.JobCallbackTimeout),
+		config:   config,
+		logger:   logger,
+		accepted: make(chan struct{}, config.JobConcurrency+config.JobQueueSize),
+		running:  make(chan struct{}, config.JobConcurrency),
+		active:   make(map[int32]context.CancelCauseFunc),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Start launches the sweeper that fails jobs abandoned by crashed instances
+func (s *AsyncOCRService) Start() {
+	s.tasks.Add(1)
+	go func() {
+		defer s.tasks.Done()
+		s.sweep()
+	}()
+}
+
+// StopTimeout bounds Stop: interrupted jobs record their failure and post
+// their callback
+func (s *AsyncOCRService) StopTimeout() time.Duration {
+	return 2*recordTimeout + s.config.JobCallbackTimeout
+}
+
+// Stop rejects new jobs, cancels the waiting and running ones and waits for
+// them to record their failure
+func (s *AsyncOCRService) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	if s.stopping {
+		s.mu.Unlock()
+		return nil
+	}
+	s.stopping = true
+	close(s.stop)
+	if len(s.active) > 0 {
+		s.logger.Warn("Interrupting OCR jobs", map[string]any{"jobs": len(s.active)})
+	}
+	for _, cancel := range s.active {
+		cancel(errShuttingDown)
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.tasks.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("OCR jobs did not stop: %w", ctx.Err())
+	}
+}
+
+func (s *AsyncOCRService) SubmitJob(ctx context.Context, req domain.JobRequest) (*domain.Job, error) {
+	if req.OrganizationID == 0 || req.Base64File == "" || req.MimeType == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	var secret string
+	if req.CallbackURL != "" {
+		if err := validateCallbackURL(req.CallbackURL, s.config.JobCallbackAllowedHosts); err != nil {
+			return nil, err
+		}
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate callback secret: %w", err)
+		}
+		secret = hex.EncodeToString(key)
+	}
+
+	// Reserve room on this instance before storing the job
+	select {
+	case s.accepted <- struct{}{}:
+	default:
+		return nil, fmt.Errorf("%w: instance is at capacity", domain.ErrJobLimitReached)
+	}
+
+	job, err := s.jobs.Create(ctx, req.OrganizationID, req.MimeType, req.CallbackURL, s.config.JobOrgLimit)
+	if err != nil {
+		<-s.accepted
+		return nil, err
+	}
+	job.CallbackSecret = secret
+
+	jobCtx, cancel := context.WithCancelCause(context.Background())
+	s.mu.Lock()
+	if s.stopping {
+		s.mu.Unlock()
+		<-s.accepted
+		cancel(nil)
+		s.record(ctx, "fail", job.ID, func(ctx context.Context) (bool, error) {
+			return s.jobs.Fail(ctx, job.OrganizationID, job.ID, errShuttingDown.Error())
+		})
+		return nil, fmt.Errorf("%w: shutting down", domain.ErrProviderUnavailable)
+	}
+	s.active[job.ID] = cancel
+	s.tasks.Add(1)
+	s.mu.Unlock()
+
+	go func() {
+		defer s.tasks.Done()
+		defer func() { <-s.accepted }()
+		defer s.finish(job)
+		s.run(jobCtx, job, req)
+	}()
+
+	return job, nil
+}
+
+func (s *AsyncOCRService) GetJob(ctx context.Context, orgID, id int32) (*domain.Job, error) {
+	return s.jobs.Get(ctx, orgID, id)
+}
+
+func (s *AsyncOCRService) CancelJob(ctx context.Context, orgID, id int32) error {
+	canceled, err := s.jobs.Cancel(ctx, orgID, id)
+	if err != nil {
+		return err
+	}
+	if !canceled {
+		// Finished already, or unknown
+		_, err := s.jobs.Get(ctx, orgID, id)
+		return err
+	}
+
+	s.mu.Lock()
+	if cancel, ok := s.active[id]; ok {
+		cancel(errJobCanceled)
+	}
+	s.mu.Unlock()
+
+	return nil
+}
+
+// run waits for a free slot, extracts the file of a job and records the
+// outcome. Time spent waiting counts towards JobTimeout.
+func (s *AsyncOCRService) run(ctx context.Context, job *domain.Job, req domain.JobRequest) {
+	ctx, cancel := context.WithTimeoutCause(ctx, s.config.JobTimeout, errJobTimedOut)
+	defer cancel()
+
+	select {
+	case s.running <- struct{}{}:
+		defer func() { <-s.running }()
+	case <-ctx.Done():
+		s.failed(ctx, job, ctx.Err())
+		return
+	}
+
+	totalPages := s.countPages(req)
+	started, err := s.jobs.Start(ctx, job.OrganizationID, job.ID, totalPages)
+	if err != nil || !started {
+		// Not started means it was canceled while queued
+		s.failed(ctx, job, err)
+		return
+	}
+
+	var completed int
+	progress := func(pages int) error {
+		completed += pages
+		active, err := s.jobs.UpdateProgress(ctx, job.OrganizationID, job.ID, completed)
+		if err != nil {
+			// Progress is informational; keep going
+			s.logger.Warn("Failed to record OCR job progress", map[string]any{"job_id": job.ID, "error": err.Error()})
+			return nil
+		}
+		if !active {
+			return errJobCanceled
+		}
+		return nil
+	}
+
+	result, err := s.extract(ctx, req, totalPages, progress)
+	if err != nil {
+		s.failed(ctx, job, err)
+		return
+	}
+
+	s.record(ctx, "complete", job.ID, func(ctx context.Context) (bool, error) {
+		return s.jobs.Complete(ctx, job.OrganizationID, job.ID, result)
+	})
+}
+
+// extract splits PDFs longer than ChunkPages into page ranges when the OCR
+// service can select pages, and processes other files whole
+func (s *AsyncOCRService) extract(ctx context.Context, req domain.JobRequest, totalPages int, progress func(pages int) error) (*domain.OCRResponse, error) {
+	paged, ok := s.ocr.(domain.PageOCRService)
+	if !ok || s.config.ChunkPages <= 0 || totalPages <= s.config.ChunkPages {
+		return s.ocr.ExtractText(ctx, req.Base64File, req.MimeType)
+	}
+
+	chunks := splitPages(pageRange(1, totalPages), s.config.ChunkPages)
+	return extractInChunks(ctx, paged, req.Base64File, req.MimeType, chunks, s.config.ChunkConcurrency, progress)
+}
+
+// countPages returns the page count of a PDF, 1 for other files, and 0 when
+// the PDF cannot be read locally; the provider then gets it whole
+func (s *AsyncOCRService) countPages(req domain.JobRequest) int {
+	if req.MimeType != "application/pdf" {
+		return 1
+	}
+	data, err := base64.StdEncoding.DecodeString(req.Base64File)
+	if err != nil {
+		return 0
+	}
+	count, err := pdfPageCount(data)
+	if err != nil {
+		return 0
+	}
+	return count
+}
+
+// failed records why a job stopped. The cancel cause takes precedence over
+// the error it produced; canceled jobs are already recorded.
+func (s *AsyncOCRService) failed(ctx context.Context, job *domain.Job, err error) {
+	if cause := context.Cause(ctx); cause != nil {
+		err = cause
+	}
+	if err == nil || errors.Is(err, errJobCanceled) {
+		return
+	}
+
+	s.logger.Warn("OCR job failed", map[string]any{"job_id": job.ID, "error": err.Error()})
+	s.record(ctx, "fail", job.ID, func(ctx context.Context) (bool, error) {
+		return s.jobs.Fail(ctx, job.OrganizationID, job.ID, err.Error())
+	})
+}
+
+// record writes a final state change. It runs after the job context may have
+// been canceled, so it gets its own deadline.
+func (s *AsyncOCRService) record(ctx context.Context, op string, id int32, fn func(ctx context.Context) (bool, error)) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recordTimeout)
+	defer cancel()
+
+	if _, err := fn(ctx); err != nil {
+		s.logger.Error("Failed to record OCR job state", map[string]any{
+			"job_id":    id,
+			"operation": op,
+			"error":     err.Error(),
+		})
+	}
+}
+
+// finish releases a job and reports its final state to the callback URL
+func (s *AsyncOCRService) finish(job *domain.Job) {
+	s.mu.Lock()
+	if cancel, ok := s.active[job.ID]; ok {
+		cancel(nil)
+		delete(s.active, job.ID)
+	}
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), recordTimeout)
+	defer cancel()
+
+	final, err := s.jobs.Get(ctx, job.OrganizationID, job.ID)
+	if err != nil {
+		s.logger.Error("Failed to load finished OCR job", map[string]any{"job_id": job.ID, "error": err.Error()})
+		return
+	}
+	jobsFinished.WithLabelValues(string(final.Status)).Inc()
+
+	if final.CallbackURL != "" {
+		s.notify(final, job.CallbackSecret)
+	}
+}
+
+// notify posts the job to its callback URL, signed with the secret returned
+// on submit. The URL is checked again since the allowlist may have changed
+// after the job was submitted. Failures are logged; the job can still be
+// polled.
+func (s *AsyncOCRService) notify(job *domain.Job, secret string) {
+	if err := validateCallbackURL(job.CallbackURL, s.config.JobCallbackAllowedHosts); err != nil {
+		s.logger.Warn("OCR job callback not allowed", map[string]any{"job_id": job.ID, "error": err.Error()})
+		return
+	}
+
+	body, err := json.Marshal(job)
+	if err != nil {
+		s.logger.Error("Failed to marshal OCR job callback", map[string]any{"job_id": job.ID, "error": err.Error()})
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodPost, job.CallbackURL, bytes.NewReader(body))
+	if err != nil {
+		s.logger.Warn("Invalid OCR job callback URL", map[string]any{"job_id": job.ID, "error": err.Error()})
+		return
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(domain.CallbackTimestampHeader, timestamp)
+	req.Header.Set(domain.CallbackSignatureHeader, domain.CallbackSignature(secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.logger.Warn("OCR job callback failed", map[string]any{"job_id": job.ID, "error": err.Error()})
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		s.logger.Warn("OCR job callback rejected", map[string]any{"job_id": job.ID, "status": resp.StatusCode})
+	}
+}
+
+// sweep periodically fails jobs whose instance stopped updating them. A
+// live job updates its row at least once per JobTimeout, so the sweep
+// interval is added as a margin.
+func (s *AsyncOCRService) sweep() {
+	ticker := time.NewTicker(staleSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), recordTimeout)
+		failed, err := s.jobs.FailStale(ctx, s.config.JobTimeout+staleSweepInterval, "job stopped reporting progress")
+		cancel()
+		if err != nil {
+			s.logger.Error("Failed to sweep stale OCR jobs", map[string]any{"error": err.Error()})
+		} else if failed > 0 {
+			s.logger.Warn("Failed stale OCR jobs", map[string]any{"jobs": failed})
+		}
+
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package infra
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/moasq/backend/pkg/ocr/domain"
+)
+
+var errCallbackAddressBlocked = errors.New("callback address is not public")
+
+// blockedCallbackPrefixes are non-public ranges not covered by the netip
+// predicates checked in publicCallbackAddr
+var blockedCallbackPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "This" network
+	netip.MustParsePrefix("100.64.0.0/10"), // Carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"), // Benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),   // Reserved, including broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64, which can reach private IPv4
+}
+
+// validateCallbackURL accepts absolute http(s) URLs whose host is in the
+// allowlist. An empty allowlist disables callbacks.
+func validateCallbackURL(raw string, allowedHosts []string) error {
+	callback, err := url.Parse(raw)
+	if err != nil || (callback.Scheme != "http" && callback.Scheme != "https") || callback.Host == "" {
+		return fmt.Errorf("%w: callback URL must be an absolute http(s) URL", domain.ErrInvalidInput)
+	}
+	if len(allowedHosts) == 0 {
+		return fmt.Errorf("%w: callback URLs are disabled", domain.ErrInvalidInput)
+	}
+	if !callbackHostAllowed(callback.Hostname(), allowedHosts) {
+		return fmt.Errorf("%w: callback host %q is not allowed", domain.ErrInvalidInput, callback.Hostname())
+	}
+	return nil
+}
+
+// callbackHostAllowed matches a host against exact entries and "*.domain"
+// entries, which match subdomains but not the domain itself
+func callbackHostAllowed(host string, allowedHosts []string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for _, allowed := range allowedHosts {
+		if suffix, ok := strings.CutPrefix(allowed, "*"); ok {
+			if strings.HasSuffix(host, suffix) && len(host) > len(suffix) {
+				return true
+			}
+		} else if host == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// newCallbackClient returns a client that connects only to public addresses.
+// The check runs on the resolved address of each connection, so a hostname
+// that later resolves to an internal address is still refused. Redirects are
+// not followed, and proxies from the environment are ignored.
+func newCallbackClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("%w: %s", errCallbackAddressBlocked, address)
+			}
+			if !publicCallbackAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", errCallbackAddressBlocked, addrPort.Addr())
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   timeout,
+			ResponseHeaderTimeout: timeout,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// publicCallbackAddr reports whether addr is a globally routable unicast
+// address
+func publicCallbackAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range blockedCallbackPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,194 @@
+package infra
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	loggerDomain "github.com/moasq/backend/pkg/logger/domain"
+	"github.com/moasq/backend/pkg/ocr/domain"
+)
+
+// pagedOCRService is a provider that can process the whole document or a
+// subset of its pages
+type pagedOCRService interface {
+	domain.OCRService
+	domain.PageOCRService
+}
+
+// ChunkedOCRService splits PDFs longer than Config.ChunkPages into page
+// ranges, extracts the ranges in parallel and stitches the results back in
+// page order, so no provider request has to cover a whole long document.
+type ChunkedOCRService struct {
+	provider pagedOCRService
+	config   Config
+	logger   loggerDomain.Logger
+}
+
+// NewChunkedOCRService wraps provider in a ChunkedOCRService. Providers that
+// cannot select pages, and a ChunkPages of 0, are returned unchanged.
+func NewChunkedOCRService(provider domain.OCRService, config Config, logger loggerDomain.Logger) domain.OCRService {
+	paged, ok := provider.(pagedOCRService)
+	if !ok || config.ChunkPages <= 0 {
+		return provider
+	}
+	return &ChunkedOCRService{
+		provider: paged,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+func (s *ChunkedOCRService) ExtractText(ctx context.Context, base64File string, mimeType string) (*domain.OCRResponse, error) {
+	if mimeType != "application/pdf" {
+		return s.provider.ExtractText(ctx, base64File, mimeType)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(base64File)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid base64: %w", domain.ErrInvalidInput, err)
+	}
+	count, err := pdfPageCount(data)
+	if err != nil || count <= s.config.ChunkPages {
+		// Unreadable PDFs go to the provider whole; it may still handle them
+		return s.provider.ExtractText(ctx, base64File, mimeType)
+	}
+
+	return s.ExtractPages(ctx, base64File, mimeType, pageRange(1, count))
+}
+
+func (s *ChunkedOCRService) ExtractPages(ctx context.Context, base64File string, mimeType string, pages []int) (*domain.OCRResponse, error) {
+	if len(pages) <= s.config.ChunkPages {
+		return s.provider.ExtractPages(ctx, base64File, mimeType, pages)
+	}
+
+	chunks := splitPages(pages, s.config.ChunkPages)
+	s.logger.Info("Extracting pages in chunks", map[string]any{
+		"pages":  len(pages),
+		"chunks": len(chunks),
+	})
+
+	return extractInChunks(ctx, s.provider, base64File, mimeType, chunks, s.config.ChunkConcurrency, nil)
+}
+
+// extractInChunks extracts each chunk of pages with at most concurrency
+// requests in flight and stitches the results in chunk order. done, when not
+// nil, is called with the page count of each finished chunk; an error from
+// done stops the remaining chunks. The first failure cancels the rest.
+func extractInChunks(
+	ctx context.Context,
+	service domain.PageOCRService,
+	base64File, mimeType string,
+	chunks [][]int,
+	concurrency int,
+	done func(pages int) error,
+) (*domain.OCRResponse, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	results := make([]*domain.OCRResponse, len(chunks))
+	slots := make(chan struct{}, max(concurrency, 1))
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-slots }()
+
+			result, err := service.ExtractPages(ctx, base64File, mimeType, chunk)
+			if err != nil {
+				cancel(fmt.Errorf("failed to extract pages %d-%d: %w", chunk[0], chunk[len(chunk)-1], err))
+				return
+			}
+			results[i] = result
+
+			if done != nil {
+				mu.Lock()
+				err := done(len(chunk))
+				mu.Unlock()
+				if err != nil {
+					cancel(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+	return stitch(results), nil
+}
+
+// stitch joins the results of consecutive page ranges into one response.
+// Confidence is averaged over pages.
+func stitch(results []*domain.OCRResponse) *domain.OCRResponse {
+	var (
+		pageTexts  []domain.PageText
+		texts      []string
+		extractors []string
+		pages      int
+		weighted   float32
+	)
+	seen := make(map[string]bool)
+	for _, result := range results {
+		pages += result.Pages
+		weighted += result.Confidence * float32(result.Pages)
+		for _, extractor := range strings.Split(result.Extractor, "+") {
+			if extractor != "" && !seen[extractor] {
+				seen[extractor] = true
+				extractors = append(extractors, extractor)
+			}
+		}
+
+		if len(result.PageTexts) == 0 {
+			texts = append(texts, result.Text)
+			continue
+		}
+		for _, page := range result.PageTexts {
+			pageTexts = append(pageTexts, page)
+			texts = append(texts, page.Text)
+		}
+	}
+
+	var confidence float32
+	if pages > 0 {
+		confidence = weighted / float32(pages)
+	}
+
+	return &domain.OCRResponse{
+		Text:       strings.Join(texts, "\f"),
+		Pages:      pages,
+		Confidence: confidence,
+		Extractor:  strings.Join(extractors, "+"),
+		PageTexts:  pageTexts,
+	}
+}
+
+// splitPages splits pages into consecutive chunks of at most size pages
+func splitPages(pages []int, size int) [][]int {
+	chunks := make([][]int, 0, (len(pages)+size-1)/size)
+	for start := 0; start < len(pages); start += size {
+		chunks = append(chunks, pages[start:min(start+size, len(pages))])
+	}
+	return chunks
+}
+
+// pageRange returns the page numbers first to last, inclusive
+func pageRange(first, last int) []int {
+	pages := make([]int, 0, max(last-first+1, 0))
+	for i := first; i <= last; i++ {
+		pages = append(pages, i)
+	}
+	return pages
+}
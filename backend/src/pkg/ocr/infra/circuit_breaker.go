@@ -0,0 +1,113 @@
+package infra
+
+import (
+	"sync"
+	"time"
+)
+
+// Circuit breaker states
+const (
+	breakerClosed   = "closed"
+	breakerOpen     = "open"
+	breakerHalfOpen = "half-open"
+)
+
+// CircuitBreaker stops calling a provider after consecutive failures and
+// lets a single probe request through once resetTimeout has passed.
+type CircuitBreaker struct {
+	mu              sync.Mutex
+	provider        string
+	failureCount    int
+	lastFailureTime time.Time
+	state           string
+	probing         bool
+	maxFailures     int
+	resetTimeout    time.Duration
+}
+
+func NewCircuitBreaker(provider string, maxFailures int, resetTimeout time.Duration) *CircuitBreaker {
+	cb := &CircuitBreaker{
+		provider:     provider,
+		maxFailures:  maxFailures,
+		resetTimeout: resetTimeout,
+		state:        breakerClosed,
+	}
+	setBreakerState(provider, breakerClosed)
+	return cb
+}
+
+// CanExecute reports whether a request may be sent to the provider
+func (cb *CircuitBreaker) CanExecute() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case breakerClosed:
+		return true
+	case breakerOpen:
+		if time.Since(cb.lastFailureTime) < cb.resetTimeout {
+			return false
+		}
+		cb.setState(breakerHalfOpen)
+	}
+
+	// Half-open: allow one probe at a time
+	if cb.probing {
+		return false
+	}
+	cb.probing = true
+	return true
+}
+
+// RecordSuccess closes the breaker
+func (cb *CircuitBreaker) RecordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.failureCount = 0
+	cb.probing = false
+	cb.setState(breakerClosed)
+}
+
+// RecordFailure counts a provider failure and opens the breaker after
+// maxFailures in a row, or when the half-open probe fails.
+func (cb *CircuitBreaker) RecordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.failureCount++
+	cb.lastFailureTime = time.Now()
+	cb.probing = false
+	if cb.state == breakerHalfOpen || cb.failureCount >= cb.maxFailures {
+		cb.setState(breakerOpen)
+	}
+}
+
+// RecordSkipped releases a probe that ended without a provider verdict, e.g.
+// rejected input or a canceled request.
+func (cb *CircuitBreaker) RecordSkipped() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.probing = false
+}
+
+// GetStats returns circuit breaker statistics
+func (cb *CircuitBreaker) GetStats() map[string]interface{} {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	return map[string]interface{}{
+		"state":        cb.state,
+		"failures":     cb.failureCount,
+		"last_failure": cb.lastFailureTime,
+	}
+}
+
+func (cb *CircuitBreaker) setState(state string) {
+	if cb.state == state {
+		return
+	}
+	cb.state = state
+	setBreakerState(cb.provider, state)
+}
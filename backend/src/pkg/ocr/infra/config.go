@@ -4,12 +4,67 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+)
+
+// Supported OCR providers, in the names used by OCR_PROVIDERS
+const (
+	ProviderMistral   = "mistral"
+	ProviderTesseract = "tesseract"
 )
 
 type Config struct {
 	MistralAPIKey string
 	APIEndpoint   string
 	TimeoutSec    int
+
+	// PDFTextLayer reads the embedded text of PDFs locally and sends only
+	// pages without usable text to the OCR provider
+	PDFTextLayer bool
+	// PDFMinPageChars is the number of readable characters below which a
+	// page is treated as scanned
+	PDFMinPageChars int
+
+	// Providers lists the OCR providers in the order they are tried
+	Providers []string
+	// MinConfidence is the per-provider confidence below which the next
+	// provider is tried
+	MinConfidence map[string]float32
+	// CircuitBreakerMaxFailures is the number of consecutive failures after
+	// which a provider is skipped for CircuitBreakerResetTimeout
+	CircuitBreakerMaxFailures  int
+	CircuitBreakerResetTimeout time.Duration
+
+	TesseractPath     string
+	TesseractLanguage string
+	TesseractDPI      int
+	PDFToPPMPath      string
+
+	// ChunkPages is the largest page range sent to a provider in one request;
+	// longer PDFs are split. 0 disables splitting.
+	ChunkPages int
+	// ChunkConcurrency is the number of page ranges of one document
+	// extracted in parallel
+	ChunkConcurrency int
+	// JobTimeout bounds an asynchronous job. Jobs not updated for this long
+	// are failed, e.g. after their instance crashed.
+	JobTimeout time.Duration
+	// JobConcurrency is the number of asynchronous jobs extracted at once on
+	// an instance
+	JobConcurrency int
+	// JobQueueSize is the number of further jobs an instance accepts to wait
+	// for a free slot; their files are held in memory meanwhile
+	JobQueueSize int
+	// JobOrgLimit caps the queued and running jobs of one organization
+	// across all instances
+	JobOrgLimit int
+	// JobCallbackTimeout bounds the POST to a job's callback URL
+	JobCallbackTimeout time.Duration
+	// JobCallbackAllowedHosts lists the hosts callback URLs may point to,
+	// either exact or as "*.example.com" for subdomains. Empty disables
+	// callbacks.
+	JobCallbackAllowedHosts []string
 }
 
 func (c Config) Validate() error {
@@ -19,16 +74,130 @@ func (c Config) Validate() error {
 	if c.APIEndpoint == "" {
 		return fmt.Errorf("API endpoint is required")
 	}
+	if c.PDFMinPageChars < 0 {
+		return fmt.Errorf("OCR_PDF_MIN_PAGE_CHARS must not be negative")
+	}
+	return nil
+}
+
+// ValidateProviders checks the provider chain settings
+func (c Config) ValidateProviders() error {
+	if len(c.Providers) == 0 {
+		return fmt.Errorf("OCR_PROVIDERS must name at least one provider")
+	}
+	for _, provider := range c.Providers {
+		if provider != ProviderMistral && provider != ProviderTesseract {
+			return fmt.Errorf("unknown OCR provider %q in OCR_PROVIDERS", provider)
+		}
+		if confidence := c.MinConfidence[provider]; confidence < 0 || confidence > 1 {
+			return fmt.Errorf("minimum OCR confidence for %s must be between 0 and 1", provider)
+		}
+	}
+	if c.CircuitBreakerMaxFailures < 1 {
+		return fmt.Errorf("OCR_CIRCUIT_BREAKER_MAX_FAILURES must be at least 1")
+	}
+	if c.CircuitBreakerResetTimeout <= 0 {
+		return fmt.Errorf("OCR_CIRCUIT_BREAKER_RESET_TIMEOUT must be positive")
+	}
+	return nil
+}
+
+// ValidateJobs checks the chunking and asynchronous job settings
+func (c Config) ValidateJobs() error {
+	if c.ChunkPages < 0 {
+		return fmt.Errorf("OCR_CHUNK_PAGES must not be negative")
+	}
+	if c.ChunkConcurrency < 1 {
+		return fmt.Errorf("OCR_CHUNK_CONCURRENCY must be at least 1")
+	}
+	if c.JobTimeout <= 0 {
+		return fmt.Errorf("OCR_JOB_TIMEOUT must be positive")
+	}
+	if c.JobConcurrency < 1 {
+		return fmt.Errorf("OCR_JOB_CONCURRENCY must be at least 1")
+	}
+	if c.JobQueueSize < 0 {
+		return fmt.Errorf("OCR_JOB_QUEUE_SIZE must not be negative")
+	}
+	if c.JobOrgLimit < 1 {
+		return fmt.Errorf("OCR_JOB_ORG_LIMIT must be at least 1")
+	}
+	if c.JobCallbackTimeout <= 0 {
+		return fmt.Errorf("OCR_JOB_CALLBACK_TIMEOUT must be positive")
+	}
+	for _, host := range c.JobCallbackAllowedHosts {
+		name := strings.TrimPrefix(host, "*.")
+		if name == "" || strings.ContainsAny(name, "*/:@ ") {
+			return fmt.Errorf("invalid host %q in OCR_JOB_CALLBACK_ALLOWED_HOSTS", host)
+		}
+	}
 	return nil
 }
 
 func NewOCRConfig() Config {
 	timeoutSec, _ := strconv.Atoi(getEnvOrDefault("OCR_TIMEOUT_SEC", "120"))
+	pdfTextLayer, _ := strconv.ParseBool(getEnvOrDefault("OCR_PDF_TEXT_LAYER", "true"))
+	pdfMinPageChars, _ := strconv.Atoi(getEnvOrDefault("OCR_PDF_MIN_PAGE_CHARS", "50"))
+	maxFailures, _ := strconv.Atoi(getEnvOrDefault("OCR_CIRCUIT_BREAKER_MAX_FAILURES", "3"))
+	resetTimeout, _ := time.ParseDuration(getEnvOrDefault("OCR_CIRCUIT_BREAKER_RESET_TIMEOUT", "60s"))
+	tesseractDPI, _ := strconv.Atoi(getEnvOrDefault("TESSERACT_DPI", "300"))
+	chunkPages, _ := strconv.Atoi(getEnvOrDefault("OCR_CHUNK_PAGES", "20"))
+	chunkConcurrency, _ := strconv.Atoi(getEnvOrDefault("OCR_CHUNK_CONCURRENCY", "4"))
+	jobTimeout, _ := time.ParseDuration(getEnvOrDefault("OCR_JOB_TIMEOUT", "30m"))
+	jobConcurrency, _ := strconv.Atoi(getEnvOrDefault("OCR_JOB_CONCURRENCY", "2"))
+	jobQueueSize, _ := strconv.Atoi(getEnvOrDefault("OCR_JOB_QUEUE_SIZE", "8"))
+	jobOrgLimit, _ := strconv.Atoi(getEnvOrDefault("OCR_JOB_ORG_LIMIT", "4"))
+	callbackTimeout, _ := time.ParseDuration(getEnvOrDefault("OCR_JOB_CALLBACK_TIMEOUT", "10s"))
+
+	var callbackHosts []string
+	for _, host := range strings.Split(os.Getenv("OCR_JOB_CALLBACK_ALLOWED_HOSTS"), ",") {
+		host = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+		if host != "" {
+			callbackHosts = append(callbackHosts, host)
+		}
+	}
+
+	var providers []string
+	minConfidence := make(map[string]float32)
+	defaultConfidence := getEnvOrDefault("OCR_MIN_CONFIDENCE", "0.7")
+	for _, provider := range strings.Split(getEnvOrDefault("OCR_PROVIDERS", ProviderMistral), ",") {
+		provider = strings.ToLower(strings.TrimSpace(provider))
+		if provider == "" {
+			continue
+		}
+		providers = append(providers, provider)
+		// e.g. OCR_MIN_CONFIDENCE_TESSERACT
+		confidence, _ := strconv.ParseFloat(getEnvOrDefault("OCR_MIN_CONFIDENCE_"+strings.ToUpper(provider), defaultConfidence), 32)
+		minConfidence[provider] = float32(confidence)
+	}
 
 	return Config{
 		MistralAPIKey: os.Getenv("MISTRAL_API_KEY"),
 		APIEndpoint:   getEnvOrDefault("MISTRAL_OCR_ENDPOINT", "https://api.mistral.ai/v1/ocr"),
 		TimeoutSec:    timeoutSec,
+
+		PDFTextLayer:    pdfTextLayer,
+		PDFMinPageChars: pdfMinPageChars,
+
+		Providers:                  providers,
+		MinConfidence:              minConfidence,
+		CircuitBreakerMaxFailures:  maxFailures,
+		CircuitBreakerResetTimeout: resetTimeout,
+
+		TesseractPath:     getEnvOrDefault("TESSERACT_PATH", "tesseract"),
+		TesseractLanguage: getEnvOrDefault("TESSERACT_LANG", "eng"),
+		TesseractDPI:      tesseractDPI,
+		PDFToPPMPath:      getEnvOrDefault("PDFTOPPM_PATH", "pdftoppm"),
+
+		ChunkPages:         chunkPages,
+		ChunkConcurrency:   chunkConcurrency,
+		JobTimeout:         jobTimeout,
+		JobConcurrency:     jobConcurrency,
+		JobQueueSize:       jobQueueSize,
+		JobOrgLimit:        jobOrgLimit,
+		JobCallbackTimeout: callbackTimeout,
+
+		JobCallbackAllowedHosts: callbackHosts,
 	}
 }
 
@@ -37,4 +206,4 @@ func getEnvOrDefault(key, defaultValue string) string {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
@@ -0,0 +1,154 @@
+package infra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/moasq/backend/pkg/health"
+	loggerDomain "github.com/moasq/backend/pkg/logger/domain"
+	"github.com/moasq/backend/pkg/ocr/domain"
+)
+
+// Provider is one OCR backend in a FailoverOCRService chain
+type Provider struct {
+	Name    string
+	Service domain.OCRService
+	// MinConfidence is the confidence below which the next provider is
+	// tried. The best result is still returned when no provider reaches its
+	// threshold.
+	MinConfidence float32
+	breaker       *CircuitBreaker
+}
+
+// FailoverOCRService tries providers in order and moves on when one fails,
+// is unavailable (open circuit breaker, exhausted quota), or returns text
+// below its confidence threshold.
+type FailoverOCRService struct {
+	providers []Provider
+	logger    loggerDomain.Logger
+}
+
+func NewFailoverOCRService(providers []Provider, config Config, logger loggerDomain.Logger) (*FailoverOCRService, error) {
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("at least one OCR provider is required")
+	}
+
+	for i := range providers {
+		providers[i].breaker = NewCircuitBreaker(providers[i].Name, config.CircuitBreakerMaxFailures, config.CircuitBreakerResetTimeout)
+	}
+
+	return &FailoverOCRService{
+		providers: providers,
+		logger:    logger,
+	}, nil
+}
+
+func (f *FailoverOCRService) ExtractText(ctx context.Context, base64File string, mimeType string) (*domain.OCRResponse, error) {
+	return f.ExtractPages(ctx, base64File, mimeType, nil)
+}
+
+// ExtractPages runs OCR on the given 1-based pages, or on every page when
+// pages is empty. Providers without page selection read the whole document.
+func (f *FailoverOCRService) ExtractPages(ctx context.Context, base64File string, mimeType string, pages []int) (*domain.OCRResponse, error) {
+	var (
+		best *domain.OCRResponse
+		errs []error
+	)
+
+	for i := range f.providers {
+		provider := &f.providers[i]
+		if !provider.breaker.CanExecute() {
+			errs = append(errs, fmt.Errorf("%s: %w", provider.Name, domain.ErrProviderUnavailable))
+			continue
+		}
+
+		response, err := extractWith(ctx, provider.Service, base64File, mimeType, pages)
+		if err != nil {
+			f.recordFailure(ctx, provider, err)
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", provider.Name, err))
+			continue
+		}
+		provider.breaker.RecordSuccess()
+
+		if response.Confidence >= provider.MinConfidence {
+			return response, nil
+		}
+		f.logger.Warn("OCR confidence below provider threshold", map[string]any{
+			"provider":      provider.Name,
+			"confidence":    response.Confidence,
+			"min_threshold": provider.MinConfidence,
+		})
+		if best == nil || response.Confidence > best.Confidence {
+			best = response
+		}
+	}
+
+	if best != nil {
+		return best, nil
+	}
+
+	err := errors.Join(errs...)
+	if isRetryable(err) {
+		return nil, fmt.Errorf("%w: all OCR providers failed: %w", domain.ErrTransientError, err)
+	}
+	return nil, fmt.Errorf("all OCR providers failed: %w", err)
+}
+
+func extractWith(ctx context.Context, service domain.OCRService, base64File, mimeType string, pages []int) (*domain.OCRResponse, error) {
+	if paged, ok := service.(domain.PageOCRService); ok && len(pages) > 0 {
+		return paged.ExtractPages(ctx, base64File, mimeType, pages)
+	}
+	return service.ExtractText(ctx, base64File, mimeType)
+}
+
+// recordFailure counts provider faults against the breaker. Rejected input,
+// unsupported files, and canceled requests say nothing about the provider.
+func (f *FailoverOCRService) recordFailure(ctx context.Context, provider *Provider, err error) {
+	if ctx.Err() != nil || errors.Is(err, domain.ErrInvalidInput) || errors.Is(err, domain.ErrUnsupportedFile) {
+		provider.breaker.RecordSkipped()
+		return
+	}
+	provider.breaker.RecordFailure()
+
+	f.logger.Warn("OCR provider failed, trying next provider", map[string]any{
+		"provider": provider.Name,
+		"error":    err.Error(),
+	})
+}
+
+// isRetryable reports whether a later attempt may succeed: a provider was
+// down, rate limited, or out of quota rather than rejecting the document.
+func isRetryable(err error) bool {
+	return errors.Is(err, domain.ErrTransientError) ||
+		errors.Is(err, domain.ErrProviderUnavailable) ||
+		errors.Is(err, domain.ErrQuotaExceeded)
+}
+
+// HealthCheck reports the circuit breaker state of every provider. OCR is
+// degraded while any breaker is open. It is not critical: hosted providers
+// are shared by every instance.
+func (f *FailoverOCRService) HealthCheck() health.Check {
+	return health.Check{
+		Name: "ocr",
+		Run: func(ctx context.Context) (health.Details, error) {
+			details := health.Details{}
+			var open []string
+			for i := range f.providers {
+				provider := &f.providers[i]
+				stats := provider.breaker.GetStats()
+				details[provider.Name] = stats["state"]
+				if stats["state"] == breakerOpen {
+					open = append(open, provider.Name)
+				}
+			}
+			if len(open) > 0 {
+				return details, health.Degraded("circuit breaker open for %v", open)
+			}
+			return details, nil
+		},
+	}
+}
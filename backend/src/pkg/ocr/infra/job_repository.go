@@ -0,0 +1,145 @@
+package infra
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/moasq/backend/pkg/db/adapters"
+	"github.com/moasq/backend/pkg/db/postgres"
+	sqlc "github.com/moasq/backend/pkg/db/postgres/sqlc/gen"
+	"github.com/moasq/backend/pkg/ocr/domain"
+)
+
+type jobRepository struct {
+	store adapters.OCRJobStore
+}
+
+func NewJobRepository(store adapters.OCRJobStore) domain.JobRepository {
+	return &jobRepository{store: store}
+}
+
+func (r *jobRepository) Create(ctx context.Context, orgID int32, mimeType, callbackURL string, orgLimit int) (*domain.Job, error) {
+	result, err := r.store.CreateOCRJob(ctx, sqlc.CreateOCRJobParams{
+		OrganizationID: orgID,
+		MimeType:       mimeType,
+		CallbackUrl:    postgres.PgTextFromString(callbackURL),
+		OrgLimit:       int32(orgLimit),
+	})
+	if err != nil {
+		// No row means the organization is at its limit
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: organization has %d active jobs", domain.ErrJobLimitReached, orgLimit)
+		}
+		return nil, fmt.Errorf("failed to create OCR job: %w", err)
+	}
+
+	return r.mapToDomain(&result)
+}
+
+func (r *jobRepository) Get(ctx context.Context, orgID, id int32) (*domain.Job, error) {
+	result, err := r.store.GetOCRJob(ctx, sqlc.GetOCRJobParams{
+		ID:             id,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		if errors.Is(err, sqlc.ErrRecordNotFound) {
+			return nil, domain.ErrJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get OCR job: %w", err)
+	}
+
+	return r.mapToDomain(&result)
+}
+
+func (r *jobRepository) Start(ctx context.Context, orgID, id int32, totalPages int) (bool, error) {
+	return changed(r.store.StartOCRJob(ctx, sqlc.StartOCRJobParams{
+		TotalPages:     int32(totalPages),
+		ID:             id,
+		OrganizationID: orgID,
+	}))
+}
+
+func (r *jobRepository) UpdateProgress(ctx context.Context, orgID, id int32, completedPages int) (bool, error) {
+	return changed(r.store.UpdateOCRJobProgress(ctx, sqlc.UpdateOCRJobProgressParams{
+		CompletedPages: int32(completedPages),
+		ID:             id,
+		OrganizationID: orgID,
+	}))
+}
+
+func (r *jobRepository) Complete(ctx context.Context, orgID, id int32, result *domain.OCRResponse) (bool, error) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal OCR result: %w", err)
+	}
+
+	return changed(r.store.CompleteOCRJob(ctx, sqlc.CompleteOCRJobParams{
+		Result:         data,
+		ID:             id,
+		OrganizationID: orgID,
+	}))
+}
+
+func (r *jobRepository) Fail(ctx context.Context, orgID, id int32, message string) (bool, error) {
+	return changed(r.store.FailOCRJob(ctx, sqlc.FailOCRJobParams{
+		Error:          postgres.PgTextFromString(message),
+		ID:             id,
+		OrganizationID: orgID,
+	}))
+}
+
+func (r *jobRepository) Cancel(ctx context.Context, orgID, id int32) (bool, error) {
+	return changed(r.store.CancelOCRJob(ctx, sqlc.CancelOCRJobParams{
+		ID:             id,
+		OrganizationID: orgID,
+	}))
+}
+
+func (r *jobRepository) FailStale(ctx context.Context, olderThan time.Duration, message string) (int64, error) {
+	rows, err := r.store.FailStaleOCRJobs(ctx, sqlc.FailStaleOCRJobsParams{
+		Error:        postgres.PgTextFromString(message),
+		StaleSeconds: int32(math.Min(math.Ceil(olderThan.Seconds()), math.MaxInt32)),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to fail stale OCR jobs: %w", err)
+	}
+	return rows, nil
+}
+
+// mapToDomain maps a database job to a domain job
+func (r *jobRepository) mapToDomain(job *sqlc.OcrJob) (*domain.Job, error) {
+	var result *domain.OCRResponse
+	if len(job.Result) > 0 {
+		if err := json.Unmarshal(job.Result, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal OCR result: %w", err)
+		}
+	}
+
+	return &domain.Job{
+		ID:             job.ID,
+		OrganizationID: job.OrganizationID,
+		Status:         domain.JobStatus(job.Status),
+		MimeType:       job.MimeType,
+		TotalPages:     int(job.TotalPages),
+		CompletedPages: int(job.CompletedPages),
+		CallbackURL:    postgres.StringFromPgText(job.CallbackUrl),
+		Result:         result,
+		Error:          postgres.StringFromPgText(job.Error),
+		StartedAt:      postgres.TimeStampPtr(job.StartedAt),
+		FinishedAt:     postgres.TimeStampPtr(job.FinishedAt),
+		CreatedAt:      job.CreatedAt.Time,
+		UpdatedAt:      job.UpdatedAt.Time,
+	}, nil
+}
+
+// changed maps the affected row count of a conditional state change
+func changed(rows int64, err error) (bool, error) {
+	if err != nil {
+		return false, fmt.Errorf("failed to update OCR job: %w", err)
+	}
+	return rows > 0, nil
+}
@@ -0,0 +1,76 @@
+package infra
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/moasq/backend/pkg/ocr/domain"
+)
+
+// relativeBox converts a box in page units (origin top-left) to page-relative
+// coordinates, clamped to the page.
+func relativeBox(x, y, width, height, pageWidth, pageHeight float64) domain.BoundingBox {
+	if pageWidth <= 0 || pageHeight <= 0 {
+		return domain.BoundingBox{}
+	}
+
+	left := clamp(x / pageWidth)
+	top := clamp(y / pageHeight)
+	right := clamp((x + width) / pageWidth)
+	bottom := clamp((y + height) / pageHeight)
+
+	return domain.BoundingBox{
+		X:      float32(left),
+		Y:      float32(top),
+		Width:  float32(right - left),
+		Height: float32(bottom - top),
+	}
+}
+
+func clamp(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
+
+// tableSeparator matches the header separator row of a markdown table,
+// e.g. "|---|:---:|".
+var tableSeparator = regexp.MustCompile(`^\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?$`)
+
+// parseMarkdownTables extracts the pipe tables of a markdown page
+func parseMarkdownTables(markdown string) []domain.Table {
+	var tables []domain.Table
+	var rows [][]string
+
+	flush := func() {
+		// A single pipe line is not a table
+		if len(rows) > 1 {
+			tables = append(tables, domain.Table{Rows: rows})
+		}
+		rows = nil
+	}
+
+	for _, line := range strings.Split(markdown, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "|") {
+			flush()
+			continue
+		}
+		if tableSeparator.MatchString(line) {
+			continue
+		}
+
+		cells := strings.Split(strings.Trim(line, "|"), "|")
+		for i := range cells {
+			cells[i] = strings.TrimSpace(cells[i])
+		}
+		rows = append(rows, cells)
+	}
+	flush()
+
+	return tables
+}
@@ -0,0 +1,689 @@
+package infra
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	loggerDomain "github.com/moasq/backend/pkg/logger/domain"
+	"github.com/moasq/backend/pkg/ocr/domain"
+)
+
+// maxPartSize bounds the uncompressed size of one part of a DOCX or XLSX
+// file, so a small compressed upload cannot expand without limit
+const maxPartSize = 32 << 20
+
+var errPartTooLarge = fmt.Errorf("%w: document part exceeds %d bytes", domain.ErrUnsupportedFile, maxPartSize)
+
+const (
+	// maxColumns is the column count of a worksheet, A to XFD
+	maxColumns = 16384
+	// maxSheetCells bounds the rows times the widest row of a worksheet, the
+	// cells of its table once padded
+	maxSheetCells = 1 << 20
+)
+
+var errSheetTooLarge = fmt.Errorf("%w: worksheet exceeds %d cells", domain.ErrUnsupportedFile, maxSheetCells)
+
+// LocalFormatsOCRService reads formats that carry their own text (DOCX,
+// XLSX, CSV, plain text) locally and passes everything else, such as PDFs
+// and images, to the OCR service behind it. Tables are kept as markdown
+// tables so they survive chunking for retrieval.
+type LocalFormatsOCRService struct {
+	next   domain.OCRService
+	logger loggerDomain.Logger
+}
+
+func NewLocalFormatsOCRService(next domain.OCRService, logger loggerDomain.Logger) domain.OCRService {
+	return &LocalFormatsOCRService{
+		next:   next,
+		logger: logger,
+	}
+}
+
+func (s *LocalFormatsOCRService) ExtractText(ctx context.Context, base64File string, mimeType string) (*domain.OCRResponse, error) {
+	extractor, ok := localExtractor(mimeType)
+	if !ok {
+		return s.next.ExtractText(ctx, base64File, mimeType)
+	}
+	return s.extract(ctx, base64File, mimeType, extractor)
+}
+
+// ExtractPages passes page selection to the OCR service. Local formats have
+// no fixed pages and are always read whole.
+func (s *LocalFormatsOCRService) ExtractPages(ctx context.Context, base64File string, mimeType string, pages []int) (*domain.OCRResponse, error) {
+	if extractor, ok := localExtractor(mimeType); ok {
+		return s.extract(ctx, base64File, mimeType, extractor)
+	}
+	if paged, ok := s.next.(domain.PageOCRService); ok {
+		return paged.ExtractPages(ctx, base64File, mimeType, pages)
+	}
+	return s.next.ExtractText(ctx, base64File, mimeType)
+}
+
+func (s *LocalFormatsOCRService) extract(ctx context.Context, base64File, mimeType, extractor string) (*domain.OCRResponse, error) {
+	if base64File == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	data, err := base64.StdEncoding.DecodeString(base64File)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid base64: %w", domain.ErrInvalidInput, err)
+	}
+
+	start := time.Now()
+	_, span := startSpan(ctx, extractor, "local", mimeType)
+	pageTexts, err := readLocalFormat(data, mimeType)
+	endSpan(span, len(pageTexts), err)
+	observeRequest(extractor, start, len(pageTexts), err)
+	if err != nil {
+		return nil, err
+	}
+
+	texts := make([]string, len(pageTexts))
+	for i, page := range pageTexts {
+		page.Number = i + 1
+		page.Extractor = extractor
+		pageTexts[i] = page
+		texts[i] = page.Text
+	}
+	text := strings.Join(texts, "\f")
+
+	s.logger.Debug("Text read locally", map[string]any{
+		"extractor":   extractor,
+		"pages":       len(pageTexts),
+		"text_length": len(text),
+	})
+
+	// The text is exact; there is nothing to recognize
+	var confidence float32
+	if strings.TrimSpace(text) != "" {
+		confidence = 1.0
+	}
+
+	return &domain.OCRResponse{
+		Text:       text,
+		Pages:      len(pageTexts),
+		Confidence: confidence,
+		Extractor:  extractor,
+		PageTexts:  pageTexts,
+	}, nil
+}
+
+// localExtractor returns the extractor name of a format read locally
+func localExtractor(mimeType string) (string, bool) {
+	switch mimeType {
+	case domain.MimeTypeDOCX:
+		return domain.ExtractorDOCX, true
+	case domain.MimeTypeXLSX:
+		return domain.ExtractorXLSX, true
+	case domain.MimeTypeCSV:
+		return domain.ExtractorCSV, true
+	case domain.MimeTypeText:
+		return domain.ExtractorPlainText, true
+	default:
+		return "", false
+	}
+}
+
+// readLocalFormat returns the pages of a file: one per worksheet for XLSX,
+// a single page otherwise
+func readLocalFormat(data []byte, mimeType string) ([]domain.PageText, error) {
+	switch mimeType {
+	case domain.MimeTypeDOCX:
+		page, err := readDOCX(data)
+		return []domain.PageText{page}, err
+	case domain.MimeTypeXLSX:
+		return readXLSX(data)
+	case domain.MimeTypeCSV:
+		page, err := readCSV(data)
+		return []domain.PageText{page}, err
+	default:
+		return []domain.PageText{{Text: strings.TrimSpace(decodeText(data))}}, nil
+	}
+}
+
+// readDOCX returns the paragraphs of word/document.xml, with headings and
+// list items in markdown and top-level tables as markdown tables
+func readDOCX(data []byte) (domain.PageText, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return domain.PageText{}, fmt.Errorf("%w: failed to open DOCX: %w", domain.ErrUnsupportedFile, err)
+	}
+	part, err := openPart(archive, "word/document.xml")
+	if err != nil {
+		return domain.PageText{}, err
+	}
+	defer part.Close()
+
+	var (
+		blocks    []string
+		tables    []domain.Table
+		paragraph strings.Builder
+		prefix    string
+		depth     int // Table nesting; nested tables are flattened into their cell
+		rows      [][]string
+		row       []string
+		cell      []string
+		inText    bool
+	)
+	decoder := xml.NewDecoder(part)
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return domain.PageText{}, fmt.Errorf("%w: malformed DOCX: %w", domain.ErrUnsupportedFile, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "p":
+				paragraph.Reset()
+				prefix = ""
+			case "pStyle":
+				prefix = headingPrefix(attr(t, "val"))
+			case "numPr":
+				if prefix == "" {
+					prefix = "- "
+				}
+			case "t":
+				inText = true
+			case "tab":
+				paragraph.WriteString("\t")
+			case "br", "cr":
+				paragraph.WriteString("\n")
+			case "tbl":
+				depth++
+				if depth == 1 {
+					rows = nil
+				}
+			case "tr":
+				if depth == 1 {
+					row = nil
+				}
+			case "tc":
+				if depth == 1 {
+					cell = nil
+				}
+			}
+		case xml.CharData:
+			if inText {
+				paragraph.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				text := strings.TrimSpace(paragraph.String())
+				if text == "" {
+					continue
+				}
+				if depth > 0 {
+					cell = append(cell, text)
+				} else {
+					blocks = append(blocks, prefix+text)
+				}
+			case "tc":
+				if depth == 1 {
+					row = append(row, strings.Join(cell, " "))
+				}
+			case "tr":
+				if depth == 1 {
+					rows = append(rows, row)
+				}
+			case "tbl":
+				depth--
+				if depth == 0 && len(rows) > 0 {
+					blocks = append(blocks, markdownTable(rows))
+					tables = append(tables, domain.Table{Rows: normalizeRows(rows)})
+				}
+			}
+		}
+	}
+
+	return domain.PageText{
+		Text:   strings.Join(blocks, "\n\n"),
+		Tables: tables,
+	}, nil
+}
+
+// headingPrefix maps Word's built-in heading styles to markdown
+func headingPrefix(style string) string {
+	if style == "Title" {
+		return "# "
+	}
+	if level, err := strconv.Atoi(strings.TrimPrefix(style, "Heading")); err == nil && strings.HasPrefix(style, "Heading") {
+		return strings.Repeat("#", min(max(level, 1), 6)) + " "
+	}
+	return ""
+}
+
+// readXLSX returns one page per worksheet, in workbook order, with the used
+// cells as a markdown table. Cells hold their stored values: dates are
+// serial numbers and formulas their last computed result.
+func readXLSX(data []byte) ([]domain.PageText, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to open XLSX: %w", domain.ErrUnsupportedFile, err)
+	}
+
+	var workbook struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+			RID  string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	if err := decodePart(archive, "xl/workbook.xml", &workbook); err != nil {
+		return nil, err
+	}
+
+	var relationships struct {
+		Items []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if err := decodePart(archive, "xl/_rels/workbook.xml.rels", &relationships); err != nil {
+		return nil, err
+	}
+	targets := make(map[string]string, len(relationships.Items))
+	for _, rel := range relationships.Items {
+		if strings.HasPrefix(rel.Target, "/") {
+			targets[rel.ID] = strings.TrimPrefix(rel.Target, "/")
+		} else {
+			targets[rel.ID] = path.Join("xl", rel.Target)
+		}
+	}
+
+	shared, err := readSharedStrings(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := make([]domain.PageText, 0, len(workbook.Sheets))
+	for _, sheet := range workbook.Sheets {
+		target, ok := targets[sheet.RID]
+		if !ok {
+			continue
+		}
+		rows, err := readWorksheet(archive, target, shared)
+		if err != nil {
+			return nil, err
+		}
+
+		page := domain.PageText{Text: "## " + sheet.Name}
+		if len(rows) > 0 {
+			page.Text += "\n\n" + markdownTable(rows)
+			page.Tables = []domain.Table{{Rows: normalizeRows(rows)}}
+		}
+		pages = append(pages, page)
+	}
+
+	return pages, nil
+}
+
+// readSharedStrings returns the shared string table. Phonetic runs are
+// skipped.
+func readSharedStrings(archive *zip.Reader) ([]string, error) {
+	part, err := openPart(archive, "xl/sharedStrings.xml")
+	if errors.Is(err, domain.ErrNotFound) {
+		// Workbooks without text cells have no shared strings
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer part.Close()
+
+	var (
+		strs     []string
+		current  strings.Builder
+		inText   bool
+		phonetic bool
+	)
+	decoder := xml.NewDecoder(part)
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return strs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed XLSX shared strings: %w", domain.ErrUnsupportedFile, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "si":
+				current.Reset()
+			case "rPh":
+				phonetic = true
+			case "t":
+				inText = !phonetic
+			}
+		case xml.CharData:
+			if inText {
+				current.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "si":
+				strs = append(strs, current.String())
+			case "rPh":
+				phonetic = false
+			case "t":
+				inText = false
+			}
+		}
+	}
+}
+
+// readWorksheet returns the non-empty rows of a worksheet, with cells placed
+// in their column. Sheets that would exceed maxSheetCells once padded are
+// rejected before any padding.
+func readWorksheet(archive *zip.Reader, name string, shared []string) ([][]string, error) {
+	part, err := openPart(archive, name)
+	if err != nil {
+		return nil, err
+	}
+	defer part.Close()
+
+	var (
+		rows      [][]string
+		row       []string
+		width     int
+		column    int
+		cellType  string
+		value     strings.Builder
+		inValue   bool
+		inlineStr bool
+	)
+	decoder := xml.NewDecoder(part)
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed XLSX worksheet: %w", domain.ErrUnsupportedFile, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "row":
+				row = nil
+				column = 0
+			case "c":
+				if ref := attr(t, "r"); ref != "" {
+					if column, err = columnIndex(ref); err != nil {
+						return nil, err
+					}
+				}
+				cellType = attr(t, "t")
+				value.Reset()
+			case "v":
+				inValue = true
+			case "is":
+				inlineStr = true
+			case "t":
+				inValue = inlineStr
+			}
+		case xml.CharData:
+			if inValue {
+				value.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "v", "t":
+				inValue = false
+			case "is":
+				inlineStr = false
+			case "c":
+				if text := cellValue(cellType, value.String(), shared); text != "" {
+					if column >= maxColumns {
+						return nil, fmt.Errorf("%w: XLSX cell beyond column XFD", domain.ErrUnsupportedFile)
+					}
+					width = max(width, column+1)
+					if (len(rows)+1)*width > maxSheetCells {
+						return nil, errSheetTooLarge
+					}
+					for len(row) <= column {
+						row = append(row, "")
+					}
+					row[column] = text
+				}
+				column++
+			case "row":
+				if len(row) > 0 {
+					rows = append(rows, row)
+				}
+			}
+		}
+	}
+}
+
+// cellValue resolves the stored value of a cell by its type
+func cellValue(cellType, raw string, shared []string) string {
+	switch cellType {
+	case "s":
+		index, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || index < 0 || index >= len(shared) {
+			return ""
+		}
+		return strings.TrimSpace(shared[index])
+	case "b":
+		if strings.TrimSpace(raw) == "1" {
+			return "TRUE"
+		}
+		return "FALSE"
+	default:
+		return strings.TrimSpace(raw)
+	}
+}
+
+// columnIndex converts the column letters of a cell reference such as
+// "AB12" to a 0-based index. References without letters or past column
+// XFD are rejected.
+func columnIndex(ref string) (int, error) {
+	index, letters := 0, 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		// Three letters reach XFD; more would also overflow the index
+		if letters++; letters > 3 {
+			break
+		}
+		index = index*26 + int(r-'A'+1)
+	}
+	if letters == 0 || letters > 3 || index > maxColumns {
+		return 0, fmt.Errorf("%w: invalid XLSX cell reference %q", domain.ErrUnsupportedFile, ref)
+	}
+	return index - 1, nil
+}
+
+// readCSV returns the rows of a CSV file as a markdown table. The delimiter
+// is the most frequent of comma, semicolon and tab in the first line.
+func readCSV(data []byte) (domain.PageText, error) {
+	text := decodeText(data)
+
+	firstLine, _, _ := strings.Cut(text, "\n")
+	delimiter := ','
+	for _, candidate := range []rune{';', '\t'} {
+		if strings.Count(firstLine, string(candidate)) > strings.Count(firstLine, string(delimiter)) {
+			delimiter = candidate
+		}
+	}
+
+	reader := csv.NewReader(strings.NewReader(text))
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return domain.PageText{}, fmt.Errorf("%w: malformed CSV: %w", domain.ErrUnsupportedFile, err)
+	}
+	if len(records) == 0 {
+		return domain.PageText{}, nil
+	}
+
+	return domain.PageText{
+		Text:   markdownTable(records),
+		Tables: []domain.Table{{Rows: normalizeRows(records)}},
+	}, nil
+}
+
+// decodeText returns data as UTF-8 text without a byte order mark. Invalid
+// sequences are replaced.
+func decodeText(data []byte) string {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if utf8.Valid(data) {
+		return string(data)
+	}
+	return strings.ToValidUTF8(string(data), "�")
+}
+
+// markdownTable renders rows as a markdown table with the first row as the
+// header
+func markdownTable(rows [][]string) string {
+	rows = normalizeRows(rows)
+	if len(rows) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	writeRow := func(cells []string) {
+		b.WriteString("|")
+		for _, cell := range cells {
+			b.WriteString(" ")
+			b.WriteString(escapeCell(cell))
+			b.WriteString(" |")
+		}
+		b.WriteString("\n")
+	}
+
+	writeRow(rows[0])
+	b.WriteString("|")
+	for range rows[0] {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+	for _, row := range rows[1:] {
+		writeRow(row)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// normalizeRows pads rows to the same width and drops columns that are
+// empty in every row
+func normalizeRows(rows [][]string) [][]string {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+
+	used := make([]bool, width)
+	for _, row := range rows {
+		for i, cell := range row {
+			if strings.TrimSpace(cell) != "" {
+				used[i] = true
+			}
+		}
+	}
+
+	normalized := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		cells := make([]string, 0, width)
+		for i := 0; i < width; i++ {
+			if !used[i] {
+				continue
+			}
+			cell := ""
+			if i < len(row) {
+				cell = strings.TrimSpace(row[i])
+			}
+			cells = append(cells, cell)
+		}
+		if len(cells) > 0 {
+			normalized = append(normalized, cells)
+		}
+	}
+	return normalized
+}
+
+func escapeCell(cell string) string {
+	cell = strings.ReplaceAll(cell, "|", `\|`)
+	return strings.Join(strings.Fields(cell), " ")
+}
+
+func attr(element xml.StartElement, name string) string {
+	for _, a := range element.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// openPart opens a part of an OOXML package, bounded by maxPartSize.
+// A missing part returns ErrNotFound.
+func openPart(archive *zip.Reader, name string) (io.ReadCloser, error) {
+	file, err := archive.Open(name)
+	if err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("%w: truncated package", domain.ErrUnsupportedFile)
+		}
+		return nil, fmt.Errorf("%w: %s", domain.ErrNotFound, name)
+	}
+	return &cappedReader{ReadCloser: file, remaining: maxPartSize}, nil
+}
+
+func decodePart(archive *zip.Reader, name string, v any) error {
+	part, err := openPart(archive, name)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("%w: missing %s", domain.ErrUnsupportedFile, name)
+		}
+		return err
+	}
+	defer part.Close()
+
+	if err := xml.NewDecoder(part).Decode(v); err != nil {
+		return fmt.Errorf("%w: malformed %s: %w", domain.ErrUnsupportedFile, name, err)
+	}
+	return nil
+}
+
+// cappedReader fails once more than remaining bytes have been read
+type cappedReader struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (r *cappedReader) Read(p []byte) (int, error) {
+	if r.remaining <= 0 {
+		return 0, errPartTooLarge
+	}
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n, err := r.ReadCloser.Read(p)
+	r.remaining -= int64(n)
+	return n, err
+}
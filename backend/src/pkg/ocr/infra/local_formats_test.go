@@ -0,0 +1,116 @@
+package infra
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/moasq/backend/pkg/ocr/domain"
+)
+
+// buildXLSX returns a workbook with one sheet holding the given sheetData
+// XML
+func buildXLSX(t *testing.T, sheetData string) []byte {
+	t.Helper()
+
+	parts := map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+			`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<sheetData>` + sheetData + `</sheetData></worksheet>`,
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range parts {
+		part, err := archive.Create(name)
+		if err != nil {
+			t.Fatalf("create %s: %v", name, err)
+		}
+		if _, err := part.Write([]byte(content)); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatalf("close archive: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestColumnIndex(t *testing.T) {
+	tests := []struct {
+		ref     string
+		want    int
+		wantErr bool
+	}{
+		{ref: "A1", want: 0},
+		{ref: "Z9", want: 25},
+		{ref: "AB12", want: 27},
+		{ref: "XFD1", want: maxColumns - 1},
+		{ref: "XFE1", wantErr: true},
+		{ref: "AAAA1", wantErr: true},
+		{ref: "ZZZZZZZ1", wantErr: true},
+		{ref: "12", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := columnIndex(tt.ref)
+		if tt.wantErr {
+			if !errors.Is(err, domain.ErrUnsupportedFile) {
+				t.Errorf("columnIndex(%q) error = %v, want ErrUnsupportedFile", tt.ref, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("columnIndex(%q) = %d, %v, want %d", tt.ref, got, err, tt.want)
+		}
+	}
+}
+
+func TestReadXLSX(t *testing.T) {
+	data := buildXLSX(t, `<row r="1"><c r="A1" t="inlineStr"><is><t>Name</t></is></c><c r="C1"><v>42</v></c></row>`)
+
+	pages, err := readXLSX(data)
+	if err != nil {
+		t.Fatalf("readXLSX: %v", err)
+	}
+	if len(pages) != 1 || len(pages[0].Tables) != 1 {
+		t.Fatalf("got %d pages, want one page with one table", len(pages))
+	}
+	if got := pages[0].Tables[0].Rows; len(got) != 1 || strings.Join(got[0], "|") != "Name|42" {
+		t.Errorf("rows = %q, want [[Name 42]]", got)
+	}
+}
+
+func TestReadXLSXRejectsOversizedSheets(t *testing.T) {
+	// One cell per row in the last column: few cells, but a huge table once
+	// every row is padded to the same width
+	var wide strings.Builder
+	for i := 1; i <= maxSheetCells/maxColumns+1; i++ {
+		fmt.Fprintf(&wide, `<row r="%d"><c r="XFD%d"><v>1</v></c></row>`, i, i)
+	}
+
+	tests := []struct {
+		name      string
+		sheetData string
+	}{
+		{name: "reference past XFD", sheetData: `<row r="1"><c r="ZZZZZZZ1"><v>1</v></c></row>`},
+		{name: "unreferenced cell past XFD", sheetData: `<row r="1"><c r="XFD1"><v>1</v></c><c><v>2</v></c></row>`},
+		{name: "too many cells once padded", sheetData: wide.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readXLSX(buildXLSX(t, tt.sheetData))
+			if !errors.Is(err, domain.ErrUnsupportedFile) {
+				t.Errorf("readXLSX error = %v, want ErrUnsupportedFile", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package infra
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/moasq/backend/pkg/metrics"
+)
+
+var (
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ocr_request_duration_seconds",
+		Help:    "OCR provider request latency by provider and outcome.",
+		Buckets: metrics.ExternalCallBuckets,
+	}, []string{"provider", "outcome"})
+
+	pagesProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr_pages_total",
+		Help: "Pages returned by the OCR provider.",
+	}, []string{"provider"})
+
+	breakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ocr_circuit_breaker_state",
+		Help: "Current circuit breaker state per OCR provider (1 for the active state).",
+	}, []string{"provider", "state"})
+
+	jobsFinished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr_jobs_total",
+		Help: "Asynchronous OCR jobs finished, by final status.",
+	}, []string{"status"})
+)
+
+// breakerStates lists every state so the gauge always reports all of them,
+// with 1 on the current one.
+var breakerStates = []string{breakerClosed, breakerHalfOpen, breakerOpen}
+
+func observeRequest(provider string, start time.Time, pages int, err error) {
+	requestDuration.WithLabelValues(provider, metrics.Outcome(err)).Observe(time.Since(start).Seconds())
+	if err == nil {
+		pagesProcessed.WithLabelValues(provider).Add(float64(pages))
+	}
+}
+
+func setBreakerState(provider, current string) {
+	for _, state := range breakerStates {
+		value := 0.0
+		if state == current {
+			value = 1
+		}
+		breakerState.WithLabelValues(provider, state).Set(value)
+	}
+}
@@ -25,6 +25,7 @@ type MistralOCRRequest struct {
 	Model              string              `json:"model"`
 	Document           MistralDocument     `json:"document"`
 	IncludeImageBase64 bool                `json:"include_image_base64"`
+	Pages              []int               `json:"pages,omitempty"` // 0-based page indexes; all pages when empty
 }
 
 type MistralDocument struct {
@@ -38,14 +39,26 @@ type MistralOCRResponse struct {
 }
 
 type MistralPage struct {
-	Index    int                    `json:"index"`
-	Markdown string                 `json:"markdown"`
-	Images   []MistralImage        `json:"images,omitempty"`
-	Bboxes   []MistralBoundingBox  `json:"bboxes,omitempty"`
+	Index      int                  `json:"index"`
+	Markdown   string               `json:"markdown"`
+	Images     []MistralImage       `json:"images,omitempty"`
+	Bboxes     []MistralBoundingBox `json:"bboxes,omitempty"`
+	Dimensions *MistralDimensions   `json:"dimensions,omitempty"`
+}
+
+// MistralDimensions is the size of the rendered page, in pixels
+type MistralDimensions struct {
+	DPI    int     `json:"dpi"`
+	Width  float32 `json:"width"`
+	Height float32 `json:"height"`
 }
 
 type MistralImage struct {
-	Base64 string `json:"base64,omitempty"`
+	Base64       string  `json:"base64,omitempty"`
+	TopLeftX     float32 `json:"top_left_x"`
+	TopLeftY     float32 `json:"top_left_y"`
+	BottomRightX float32 `json:"bottom_right_x"`
+	BottomRightY float32 `json:"bottom_right_y"`
 }
 
 type MistralBoundingBox struct {
@@ -74,8 +87,15 @@ func NewMistralOCRClient(config Config, logger loggerDomain.Logger) (domain.OCRS
 
 
 func (m *MistralOCRClient) ExtractText(ctx context.Context, base64File string, mimeType string) (*domain.OCRResponse, error) {
+	return m.ExtractPages(ctx, base64File, mimeType, nil)
+}
+
+// ExtractPages runs OCR on the given 1-based pages of a PDF, or on every page
+// when pages is empty.
+func (m *MistralOCRClient) ExtractPages(ctx context.Context, base64File string, mimeType string, pages []int) (*domain.OCRResponse, error) {
 	m.logger.Info("Starting Mistral OCR extraction", map[string]any{
 		"mime_type": mimeType,
+		"pages":     len(pages),
 	})
 
 	// Validate file constraints
@@ -85,6 +105,9 @@ func (m *MistralOCRClient) ExtractText(ctx context.Context, base64File string, m
 
 	// Build Mistral API request
 	mistralRequest := m.buildMistralRequest(base64File, mimeType)
+	for _, page := range pages {
+		mistralRequest.Pages = append(mistralRequest.Pages, page-1)
+	}
 
 	// Make API call with retries
 	mistralResponse, err := m.callMistralAPI(ctx, mistralRequest)
@@ -164,6 +187,19 @@ func (m *MistralOCRClient) buildMistralRequest(base64File string, mimeType strin
 }
 
 func (m *MistralOCRClient) callMistralAPI(ctx context.Context, mistralRequest MistralOCRRequest) (*MistralOCRResponse, error) {
+	start := time.Now()
+	ctx, span := startSpan(ctx, "mistral", mistralRequest.Model, mistralRequest.Document.Type)
+	response, err := m.sendMistralRequest(ctx, mistralRequest)
+	pages := 0
+	if response != nil {
+		pages = len(response.Pages)
+	}
+	endSpan(span, pages, err)
+	observeRequest("mistral", start, pages, err)
+	return response, err
+}
+
+func (m *MistralOCRClient) sendMistralRequest(ctx context.Context, mistralRequest MistralOCRRequest) (*MistralOCRResponse, error) {
 	requestBody, err := json.Marshal(mistralRequest)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
@@ -179,7 +215,10 @@ func (m *MistralOCRClient) callMistralAPI(ctx context.Context, mistralRequest Mi
 
 	resp, err := m.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("HTTP request failed: %w", err)
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("HTTP request failed: %w", err)
+		}
+		return nil, fmt.Errorf("%w: HTTP request failed: %w", domain.ErrTransientError, err)
 	}
 	defer resp.Body.Close()
 
@@ -194,6 +233,13 @@ func (m *MistralOCRClient) callMistralAPI(ctx context.Context, mistralRequest Mi
 	if resp.StatusCode == http.StatusBadRequest {
 		return nil, domain.ErrInvalidInput
 	}
+	if resp.StatusCode == http.StatusPaymentRequired {
+		return nil, domain.ErrQuotaExceeded
+	}
+	// Rate limiting and server errors are worth retrying
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		return nil, fmt.Errorf("%w: API error (status %d): %s", domain.ErrTransientError, resp.StatusCode, resp.Status)
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, resp.Status)
 	}
@@ -210,11 +256,13 @@ func (m *MistralOCRClient) callMistralAPI(ctx context.Context, mistralRequest Mi
 func (m *MistralOCRClient) convertResponse(mistralResponse *MistralOCRResponse) *domain.OCRResponse {
 	// Concatenate all page markdown with form feed separators
 	var fullText strings.Builder
+	pageTexts := make([]domain.PageText, 0, len(mistralResponse.Pages))
 	for i, page := range mistralResponse.Pages {
 		if i > 0 {
 			fullText.WriteString("\f") // Page separator
 		}
 		fullText.WriteString(page.Markdown)
+		pageTexts = append(pageTexts, m.convertPage(page))
 	}
 
 	// Calculate confidence based on content quality
@@ -224,7 +272,42 @@ func (m *MistralOCRClient) convertResponse(mistralResponse *MistralOCRResponse)
 		Text:       fullText.String(),
 		Pages:      len(mistralResponse.Pages),
 		Confidence: confidence,
+		Extractor:  domain.ExtractorMistral,
+		PageTexts:  pageTexts,
+	}
+}
+
+// convertPage keeps the layout of a page: positioned text and images, and
+// the tables of its markdown.
+func (m *MistralOCRClient) convertPage(page MistralPage) domain.PageText {
+	pageText := domain.PageText{
+		Number:    page.Index + 1,
+		Text:      page.Markdown,
+		Extractor: domain.ExtractorMistral,
+		Tables:    parseMarkdownTables(page.Markdown),
 	}
+	if page.Dimensions == nil {
+		return pageText
+	}
+
+	width, height := float64(page.Dimensions.Width), float64(page.Dimensions.Height)
+	pageText.Width, pageText.Height = page.Dimensions.Width, page.Dimensions.Height
+	for _, box := range page.Bboxes {
+		pageText.Blocks = append(pageText.Blocks, domain.Block{
+			Type: domain.BlockTypeText,
+			Text: box.Text,
+			BBox: relativeBox(float64(box.X), float64(box.Y), float64(box.Width), float64(box.Height), width, height),
+		})
+	}
+	for _, image := range page.Images {
+		pageText.Blocks = append(pageText.Blocks, domain.Block{
+			Type: domain.BlockTypeImage,
+			BBox: relativeBox(float64(image.TopLeftX), float64(image.TopLeftY),
+				float64(image.BottomRightX-image.TopLeftX), float64(image.BottomRightY-image.TopLeftY), width, height),
+		})
+	}
+
+	return pageText
 }
 
 func (m *MistralOCRClient) calculateConfidence(text string, pages int) float32 {
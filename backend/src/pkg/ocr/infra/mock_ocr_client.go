@@ -83,6 +83,7 @@ Thank you for your business!`
 		Text:       mockText,
 		Pages:      pages,
 		Confidence: 0.95,
+		Extractor:  "mock",
 	}
 
 	m.logger.Info("Mock OCR extraction completed", map[string]any{
@@ -0,0 +1,226 @@
+package infra
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ledongthuc/pdf"
+
+	loggerDomain "github.com/moasq/backend/pkg/logger/domain"
+	"github.com/moasq/backend/pkg/ocr/domain"
+)
+
+// PDFTextExtractor reads the embedded text layer of digitally generated PDFs.
+// It runs locally and costs nothing, but returns little or no text for
+// scanned pages, which only contain images.
+type PDFTextExtractor struct {
+	logger loggerDomain.Logger
+}
+
+func NewPDFTextExtractor(logger loggerDomain.Logger) domain.OCRService {
+	return &PDFTextExtractor{logger: logger}
+}
+
+func (e *PDFTextExtractor) ExtractText(ctx context.Context, base64File string, mimeType string) (*domain.OCRResponse, error) {
+	return e.extract(ctx, base64File, mimeType, nil)
+}
+
+// ExtractPages reads the text layer of the given 1-based pages only
+func (e *PDFTextExtractor) ExtractPages(ctx context.Context, base64File string, mimeType string, pages []int) (*domain.OCRResponse, error) {
+	if len(pages) == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+	return e.extract(ctx, base64File, mimeType, pages)
+}
+
+// extract reads the given pages, or every page when pages is nil
+func (e *PDFTextExtractor) extract(ctx context.Context, base64File string, mimeType string, pages []int) (*domain.OCRResponse, error) {
+	if base64File == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if mimeType != "application/pdf" {
+		return nil, domain.ErrUnsupportedFile
+	}
+
+	data, err := base64.StdEncoding.DecodeString(base64File)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid base64: %w", domain.ErrInvalidInput, err)
+	}
+
+	start := time.Now()
+	_, span := startSpan(ctx, domain.ExtractorPDFText, "text-layer", "document_url")
+	pageTexts, err := readPDFText(data, pages)
+	endSpan(span, len(pageTexts), err)
+	observeRequest(domain.ExtractorPDFText, start, len(pageTexts), err)
+	if err != nil {
+		return nil, err
+	}
+
+	texts := make([]string, len(pageTexts))
+	for i, page := range pageTexts {
+		texts[i] = page.Text
+	}
+	text := strings.Join(texts, "\f")
+
+	// The text layer is exact where it exists; density checks decide
+	// whether it is usable.
+	var confidence float32
+	if strings.TrimSpace(text) != "" {
+		confidence = 1.0
+	}
+
+	e.logger.Debug("PDF text layer extracted", map[string]any{
+		"pages":       len(pageTexts),
+		"text_length": len(text),
+	})
+
+	return &domain.OCRResponse{
+		Text:       text,
+		Pages:      len(pageTexts),
+		Confidence: confidence,
+		Extractor:  domain.ExtractorPDFText,
+		PageTexts:  pageTexts,
+	}, nil
+}
+
+// readPDFText returns the text of the given pages, or of every page when
+// numbers is nil. Pages beyond the end of the document are skipped. The PDF
+// parser panics on some malformed files, so panics are turned into errors.
+func readPDFText(data []byte, numbers []int) (pages []domain.PageText, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			pages, err = nil, fmt.Errorf("%w: malformed PDF: %v", domain.ErrUnsupportedFile, r)
+		}
+	}()
+
+	reader, err := pdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to open PDF: %w", domain.ErrUnsupportedFile, err)
+	}
+
+	numPages := reader.NumPage()
+	if numbers == nil {
+		numbers = pageRange(1, numPages)
+	}
+
+	fonts := make(map[string]*pdf.Font)
+	pages = make([]domain.PageText, 0, len(numbers))
+	for _, i := range numbers {
+		if i < 1 || i > numPages {
+			continue
+		}
+		page := reader.Page(i)
+		pageText := domain.PageText{Number: i, Extractor: domain.ExtractorPDFText}
+		text := ""
+		if !page.V.IsNull() {
+			// Cache fonts so each charmap is parsed once
+			for _, name := range page.Fonts() {
+				if _, ok := fonts[name]; !ok {
+					font := page.Font(name)
+					fonts[name] = &font
+				}
+			}
+			if text, err = page.GetPlainText(fonts); err != nil {
+				return nil, fmt.Errorf("%w: failed to read page %d: %w", domain.ErrUnsupportedFile, i, err)
+			}
+			pageText.Width, pageText.Height, pageText.Blocks = pdfLines(page)
+		}
+		pageText.Text = strings.TrimSpace(text)
+		pages = append(pages, pageText)
+	}
+
+	return pages, nil
+}
+
+// pdfPageCount returns the number of pages of a PDF
+func pdfPageCount(data []byte) (count int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			count, err = 0, fmt.Errorf("%w: malformed PDF: %v", domain.ErrUnsupportedFile, r)
+		}
+	}()
+
+	reader, err := pdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return 0, fmt.Errorf("%w: failed to open PDF: %w", domain.ErrUnsupportedFile, err)
+	}
+	return reader.NumPage(), nil
+}
+
+// pdfLines groups the text runs of a page into positioned lines. PDF
+// coordinates start at the bottom-left corner, so y is flipped.
+func pdfLines(page pdf.Page) (width, height float32, lines []domain.Block) {
+	box := mediaBox(page)
+	x0, y0 := box.Index(0).Float64(), box.Index(1).Float64()
+	pageWidth, pageHeight := box.Index(2).Float64()-x0, box.Index(3).Float64()-y0
+	if pageWidth <= 0 || pageHeight <= 0 {
+		return 0, 0, nil
+	}
+
+	runs := page.Content().Text
+	sort.SliceStable(runs, func(i, j int) bool {
+		if math.Round(runs[i].Y) != math.Round(runs[j].Y) {
+			return runs[i].Y > runs[j].Y
+		}
+		return runs[i].X < runs[j].X
+	})
+
+	var (
+		text                     strings.Builder
+		baseline                 = math.NaN()
+		left, right, top, bottom float64
+	)
+	endLine := func() {
+		if line := strings.TrimSpace(text.String()); line != "" {
+			lines = append(lines, domain.Block{
+				Type: domain.BlockTypeLine,
+				Text: line,
+				BBox: relativeBox(left-x0, pageHeight-(top-y0), right-left, top-bottom, pageWidth, pageHeight),
+			})
+		}
+		text.Reset()
+	}
+
+	for _, run := range runs {
+		runWidth := run.W
+		if runWidth <= 0 {
+			// Standard fonts often come without a widths table
+			runWidth = float64(utf8.RuneCountInString(run.S)) * run.FontSize / 2
+		}
+		if math.Round(run.Y) != baseline {
+			endLine()
+			baseline = math.Round(run.Y)
+			left, right = run.X, run.X+runWidth
+			bottom, top = run.Y, run.Y+run.FontSize
+		} else {
+			// Runs are often single glyphs; a gap wider than a third of the
+			// font size is a word break.
+			if run.X-right > run.FontSize/3 {
+				text.WriteString(" ")
+			}
+			right = max(right, run.X+runWidth)
+			top = max(top, run.Y+run.FontSize)
+		}
+		text.WriteString(run.S)
+	}
+	endLine()
+
+	return float32(pageWidth), float32(pageHeight), lines
+}
+
+// mediaBox returns the page size, which pages may inherit from their parent
+func mediaBox(page pdf.Page) pdf.Value {
+	for v := page.V; !v.IsNull(); v = v.Key("Parent") {
+		if box := v.Key("MediaBox"); box.Len() == 4 {
+			return box
+		}
+	}
+	return pdf.Value{}
+}
@@ -0,0 +1,326 @@
+package infra
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	loggerDomain "github.com/moasq/backend/pkg/logger/domain"
+	"github.com/moasq/backend/pkg/ocr/domain"
+)
+
+// TesseractOCRClient runs OCR locally with the tesseract binary. PDF pages
+// are rasterized with pdftoppm (poppler-utils) first. It is slower and less
+// accurate than hosted OCR, but has no quota and no network dependency.
+type TesseractOCRClient struct {
+	config Config
+	logger loggerDomain.Logger
+}
+
+func NewTesseractOCRClient(config Config, logger loggerDomain.Logger) (domain.OCRService, error) {
+	if _, err := exec.LookPath(config.TesseractPath); err != nil {
+		return nil, fmt.Errorf("tesseract binary not found: %w", err)
+	}
+
+	return &TesseractOCRClient{
+		config: config,
+		logger: logger,
+	}, nil
+}
+
+func (t *TesseractOCRClient) ExtractText(ctx context.Context, base64File string, mimeType string) (*domain.OCRResponse, error) {
+	return t.ExtractPages(ctx, base64File, mimeType, nil)
+}
+
+// ExtractPages runs OCR on the given 1-based pages of a PDF, or on every page
+// when pages is empty.
+func (t *TesseractOCRClient) ExtractPages(ctx context.Context, base64File string, mimeType string, pages []int) (*domain.OCRResponse, error) {
+	if base64File == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if !t.isSupportedMimeType(mimeType) {
+		return nil, domain.ErrUnsupportedFile
+	}
+
+	data, err := base64.StdEncoding.DecodeString(base64File)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid base64: %w", domain.ErrInvalidInput, err)
+	}
+
+	start := time.Now()
+	ctx, span := startSpan(ctx, domain.ExtractorTesseract, t.config.TesseractLanguage, mimeType)
+	response, err := t.extract(ctx, data, mimeType, pages)
+	pageCount := 0
+	if response != nil {
+		pageCount = response.Pages
+	}
+	endSpan(span, pageCount, err)
+	observeRequest(domain.ExtractorTesseract, start, pageCount, err)
+	if err != nil {
+		return nil, err
+	}
+
+	t.logger.Info("Tesseract OCR extraction completed", map[string]any{
+		"pages":       response.Pages,
+		"text_length": len(response.Text),
+		"confidence":  response.Confidence,
+	})
+
+	return response, nil
+}
+
+func (t *TesseractOCRClient) extract(ctx context.Context, data []byte, mimeType string, pages []int) (*domain.OCRResponse, error) {
+	dir, err := os.MkdirTemp("", "ocr-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create work directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "input")
+	if err := os.WriteFile(input, data, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write input: %w", err)
+	}
+
+	images := map[int]string{1: input}
+	if mimeType == "application/pdf" {
+		if images, err = t.rasterize(ctx, dir, input, pages); err != nil {
+			return nil, err
+		}
+	}
+
+	numbers := make([]int, 0, len(images))
+	for number := range images {
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+
+	var (
+		texts      []string
+		pageTexts  []domain.PageText
+		confidence float64
+		words      int
+	)
+	for _, number := range numbers {
+		page, err := t.recognize(ctx, images[number])
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", number, err)
+		}
+		texts = append(texts, page.text)
+		pageTexts = append(pageTexts, domain.PageText{
+			Number:    number,
+			Text:      page.text,
+			Extractor: domain.ExtractorTesseract,
+			Width:     page.width,
+			Height:    page.height,
+			Blocks:    page.lines,
+		})
+		confidence += page.confidenceSum
+		words += page.words
+	}
+
+	response := &domain.OCRResponse{
+		Text:      strings.Join(texts, "\f"),
+		Pages:     len(pageTexts),
+		Extractor: domain.ExtractorTesseract,
+		PageTexts: pageTexts,
+	}
+	if words > 0 {
+		response.Confidence = float32(confidence / float64(words) / 100)
+	}
+	return response, nil
+}
+
+// rasterize renders PDF pages to PNG files, keyed by 1-based page number
+func (t *TesseractOCRClient) rasterize(ctx context.Context, dir, input string, pages []int) (map[int]string, error) {
+	dpi := strconv.Itoa(t.config.TesseractDPI)
+	images := make(map[int]string)
+
+	if len(pages) > 0 {
+		for _, page := range pages {
+			prefix := filepath.Join(dir, fmt.Sprintf("page-%d", page))
+			n := strconv.Itoa(page)
+			if err := t.run(ctx, nil, t.config.PDFToPPMPath, "-r", dpi, "-png", "-f", n, "-l", n, "-singlefile", input, prefix); err != nil {
+				return nil, fmt.Errorf("failed to rasterize page %d: %w", page, err)
+			}
+			images[page] = prefix + ".png"
+		}
+		return images, nil
+	}
+
+	if err := t.run(ctx, nil, t.config.PDFToPPMPath, "-r", dpi, "-png", input, filepath.Join(dir, "page")); err != nil {
+		return nil, fmt.Errorf("failed to rasterize PDF: %w", err)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "page-*.png"))
+	if err != nil {
+		return nil, err
+	}
+	// pdftoppm zero-pads page numbers to the width of the page count
+	for _, file := range files {
+		number, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(filepath.Base(file), "page-"), ".png"))
+		if err != nil {
+			continue
+		}
+		images[number] = file
+	}
+	if len(images) == 0 {
+		return nil, fmt.Errorf("%w: PDF has no pages", domain.ErrInvalidInput)
+	}
+	return images, nil
+}
+
+// tesseractPage is the recognized text of one image
+type tesseractPage struct {
+	text          string
+	width, height float32
+	lines         []domain.Block
+	confidenceSum float64
+	words         int
+}
+
+// tesseractLine accumulates the words of one line
+type tesseractLine struct {
+	text                     strings.Builder
+	left, top, right, bottom float64
+	confidenceSum            float64
+	words                    int
+}
+
+// recognize runs tesseract with TSV output, which carries a confidence per
+// word, and rebuilds the text line by line.
+func (t *TesseractOCRClient) recognize(ctx context.Context, image string) (*tesseractPage, error) {
+	var stdout bytes.Buffer
+	if err := t.run(ctx, &stdout, t.config.TesseractPath, image, "stdout", "-l", t.config.TesseractLanguage, "tsv"); err != nil {
+		return nil, err
+	}
+	return parseTesseractTSV(&stdout), nil
+}
+
+// parseTesseractTSV reads tesseract's TSV output: the page row (level 1)
+// for the image size and word rows (level 5) for text, positions, and
+// confidence. Columns: level page_num block_num par_num line_num word_num
+// left top width height conf text.
+func parseTesseractTSV(tsv *bytes.Buffer) *tesseractPage {
+	page := &tesseractPage{}
+	var text strings.Builder
+	var lastBlock, lastPar, lastLine string
+	var line *tesseractLine
+
+	endLine := func() {
+		if line != nil {
+			page.lines = append(page.lines, line.block(page.width, page.height))
+		}
+	}
+
+	scanner := bufio.NewScanner(tsv)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for first := true; scanner.Scan(); first = false {
+		fields := strings.Split(scanner.Text(), "\t")
+		if first || len(fields) < 12 {
+			continue
+		}
+		box := make([]float64, 4)
+		for i := range box {
+			box[i], _ = strconv.ParseFloat(fields[6+i], 64)
+		}
+		if fields[0] == "1" {
+			page.width, page.height = float32(box[2]), float32(box[3])
+			continue
+		}
+		if fields[0] != "5" {
+			continue
+		}
+		word := strings.TrimSpace(fields[11])
+		if word == "" {
+			continue
+		}
+
+		block, par, lineNum := fields[2], fields[3], fields[4]
+		newLine := true
+		switch {
+		case text.Len() == 0:
+		case block != lastBlock || par != lastPar:
+			text.WriteString("\n\n")
+		case lineNum != lastLine:
+			text.WriteString("\n")
+		default:
+			text.WriteString(" ")
+			newLine = false
+		}
+		text.WriteString(word)
+		lastBlock, lastPar, lastLine = block, par, lineNum
+
+		if newLine {
+			endLine()
+			line = &tesseractLine{left: box[0], top: box[1], right: box[0] + box[2], bottom: box[1] + box[3]}
+		} else {
+			line.text.WriteString(" ")
+			line.left, line.top = min(line.left, box[0]), min(line.top, box[1])
+			line.right, line.bottom = max(line.right, box[0]+box[2]), max(line.bottom, box[1]+box[3])
+		}
+		line.text.WriteString(word)
+
+		if conf, err := strconv.ParseFloat(fields[10], 64); err == nil && conf >= 0 {
+			page.confidenceSum += conf
+			page.words++
+			line.confidenceSum += conf
+			line.words++
+		}
+	}
+	endLine()
+
+	page.text = text.String()
+	return page
+}
+
+func (l *tesseractLine) block(pageWidth, pageHeight float32) domain.Block {
+	block := domain.Block{
+		Type: domain.BlockTypeLine,
+		Text: l.text.String(),
+		BBox: relativeBox(l.left, l.top, l.right-l.left, l.bottom-l.top, float64(pageWidth), float64(pageHeight)),
+	}
+	if l.words > 0 {
+		block.Confidence = float32(l.confidenceSum / float64(l.words) / 100)
+	}
+	return block
+}
+
+func (t *TesseractOCRClient) run(ctx context.Context, stdout *bytes.Buffer, name string, args ...string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(t.config.TimeoutSec)*time.Second)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	if stdout != nil {
+		cmd.Stdout = stdout
+	}
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("%s timed out: %w", filepath.Base(name), ctx.Err())
+		}
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return fmt.Errorf("%w: %s failed: %s", domain.ErrUnsupportedFile, filepath.Base(name), strings.TrimSpace(stderr.String()))
+		}
+		return fmt.Errorf("failed to run %s: %w", filepath.Base(name), err)
+	}
+	return nil
+}
+
+func (t *TesseractOCRClient) isSupportedMimeType(mimeType string) bool {
+	switch mimeType {
+	case "application/pdf", "image/jpeg", "image/jpg", "image/png", "image/tiff", "image/webp", "image/bmp", "image/gif":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package infra
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+
+	loggerDomain "github.com/moasq/backend/pkg/logger/domain"
+	"github.com/moasq/backend/pkg/ocr/domain"
+)
+
+// TextLayerFirstOCRService reads the embedded text layer of PDFs and sends
+// only the pages without usable text (scans, images of text, garbled
+// encodings) to the OCR provider. Other file types go straight to the
+// provider.
+type TextLayerFirstOCRService struct {
+	textLayer domain.OCRService
+	fallback  domain.OCRService
+	config    Config
+	logger    loggerDomain.Logger
+}
+
+func NewTextLayerFirstOCRService(textLayer, fallback domain.OCRService, config Config, logger loggerDomain.Logger) domain.OCRService {
+	return &TextLayerFirstOCRService{
+		textLayer: textLayer,
+		fallback:  fallback,
+		config:    config,
+		logger:    logger,
+	}
+}
+
+func (s *TextLayerFirstOCRService) ExtractText(ctx context.Context, base64File string, mimeType string) (*domain.OCRResponse, error) {
+	return s.extract(ctx, base64File, mimeType, nil)
+}
+
+// ExtractPages applies the same strategy to the given 1-based pages only
+func (s *TextLayerFirstOCRService) ExtractPages(ctx context.Context, base64File string, mimeType string, pages []int) (*domain.OCRResponse, error) {
+	if len(pages) == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+	return s.extract(ctx, base64File, mimeType, pages)
+}
+
+// extract processes the given pages, or the whole document when pages is nil
+func (s *TextLayerFirstOCRService) extract(ctx context.Context, base64File, mimeType string, pages []int) (*domain.OCRResponse, error) {
+	if mimeType != "application/pdf" {
+		return s.extractPages(ctx, base64File, mimeType, pages)
+	}
+
+	local, err := s.readTextLayer(ctx, base64File, mimeType, pages)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, err
+		}
+		// Unreadable for the local parser (encrypted, malformed); the
+		// provider may still handle it.
+		s.logger.Warn("PDF text layer unavailable, using OCR", map[string]any{"error": err.Error()})
+		return s.extractPages(ctx, base64File, mimeType, pages)
+	}
+
+	var scanned []int
+	for _, page := range local.PageTexts {
+		if !s.hasUsableText(page.Text) {
+			scanned = append(scanned, page.Number)
+		}
+	}
+	if len(scanned) == 0 && len(local.PageTexts) > 0 {
+		return local, nil
+	}
+	if len(scanned) == len(local.PageTexts) {
+		return s.extractPages(ctx, base64File, mimeType, pages)
+	}
+
+	s.logger.Info("Running OCR on pages without a text layer", map[string]any{
+		"pages":         local.Pages,
+		"scanned_pages": len(scanned),
+	})
+
+	ocr, err := s.extractPages(ctx, base64File, mimeType, scanned)
+	if err != nil {
+		return nil, err
+	}
+	if len(ocr.PageTexts) == 0 {
+		// No per-page output to merge; the provider read the whole document
+		return ocr, nil
+	}
+
+	return merge(local, ocr, len(scanned)), nil
+}
+
+// readTextLayer reads the text layer of the given pages, or of every page
+// when pages is nil
+func (s *TextLayerFirstOCRService) readTextLayer(ctx context.Context, base64File, mimeType string, pages []int) (*domain.OCRResponse, error) {
+	if paged, ok := s.textLayer.(domain.PageOCRService); ok && pages != nil {
+		return paged.ExtractPages(ctx, base64File, mimeType, pages)
+	}
+	return s.textLayer.ExtractText(ctx, base64File, mimeType)
+}
+
+// extractPages runs OCR on the given pages only, when pages is set and the
+// provider supports page selection, and on the whole document otherwise.
+func (s *TextLayerFirstOCRService) extractPages(ctx context.Context, base64File, mimeType string, pages []int) (*domain.OCRResponse, error) {
+	if paged, ok := s.fallback.(domain.PageOCRService); ok && pages != nil {
+		return paged.ExtractPages(ctx, base64File, mimeType, pages)
+	}
+	return s.fallback.ExtractText(ctx, base64File, mimeType)
+}
+
+// hasUsableText reports whether a page's text layer has enough readable
+// characters to skip OCR. Pages of replacement characters or symbols are
+// typically fonts without a Unicode mapping.
+func (s *TextLayerFirstOCRService) hasUsableText(text string) bool {
+	var readable, total int
+	for _, r := range text {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		total++
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsPunct(r) {
+			readable++
+		}
+	}
+	if readable < s.config.PDFMinPageChars {
+		return false
+	}
+	return float64(readable) >= 0.8*float64(total)
+}
+
+// merge replaces the scanned pages of the text layer result with the OCR
+// output for those pages.
+func merge(local, ocr *domain.OCRResponse, scanned int) *domain.OCRResponse {
+	ocrPages := make(map[int]domain.PageText, len(ocr.PageTexts))
+	for _, page := range ocr.PageTexts {
+		ocrPages[page.Number] = page
+	}
+
+	pageTexts := make([]domain.PageText, len(local.PageTexts))
+	texts := make([]string, len(local.PageTexts))
+	for i, page := range local.PageTexts {
+		if replacement, ok := ocrPages[page.Number]; ok {
+			page = replacement
+		}
+		pageTexts[i] = page
+		texts[i] = page.Text
+	}
+
+	// Text layer pages are exact; weight the provider's confidence by the
+	// share of pages it handled.
+	share := float32(scanned) / float32(len(local.PageTexts))
+	confidence := (1-share)*local.Confidence + share*ocr.Confidence
+
+	return &domain.OCRResponse{
+		Text:       strings.Join(texts, "\f"),
+		Pages:      len(pageTexts),
+		Confidence: confidence,
+		Extractor:  local.Extractor + "+" + ocr.Extractor,
+		PageTexts:  pageTexts,
+	}
+}
@@ -0,0 +1,37 @@
+package infra
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/moasq/backend/pkg/ocr"
+
+var tracer = otel.Tracer(tracerName)
+
+// startSpan starts a client span for one OCR provider call.
+func startSpan(ctx context.Context, provider, model, documentType string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "ocr "+provider,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("ocr.provider", provider),
+			attribute.String("ocr.model", model),
+			attribute.String("ocr.document.type", documentType),
+		),
+	)
+}
+
+func endSpan(span trace.Span, pages int, err error) {
+	defer span.End()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	span.SetAttributes(attribute.Int("ocr.pages", pages))
+}
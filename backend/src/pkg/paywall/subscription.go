@@ -111,6 +111,10 @@ type SubscriptionStatus struct {
 	// Common values: "active", "trialing", "past_due", "canceled", "unpaid"
 	Status string `json:"status"`
 
+	// Plan is the subscribed plan (e.g. "pro"), used to select plan-specific
+	// limits. Empty when the organization has no subscription.
+	Plan string `json:"plan,omitempty"`
+
 	// ExpiresAt is when the current billing period ends.
 	// After this time, the subscription may need renewal.
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/moasq/backend/pkg/health"
+	"github.com/moasq/backend/pkg/lifecycle"
 	"github.com/moasq/backend/pkg/redis"
 	"go.uber.org/dig"
 )
@@ -22,6 +25,24 @@ func provideRedisDependencies(container *dig.Container) error {
 	return nil
 }
 
-func provideRedisStore() (redis.Client, error) {
-	return redis.InitRedis()
+func provideRedisStore(registry *health.Registry, manager *lifecycle.Manager) (redis.Client, error) {
+	client, err := redis.InitRedis()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := registry.Register(redis.HealthCheck(client)); err != nil {
+		return nil, err
+	}
+
+	if err := manager.Append(lifecycle.Hook{
+		Name: "redis",
+		OnStop: func(context.Context) error {
+			return client.Close()
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return client, nil
 }
@@ -2,8 +2,9 @@ module github.com/moasq/backend/pkg/redis
 
 go 1.25
 
-
 require (
+	github.com/moasq/backend/pkg/health v0.0.0
+	github.com/moasq/backend/pkg/lifecycle v0.0.0
 	github.com/redis/go-redis/v9 v9.7.0
 	github.com/spf13/viper v1.19.0
 	go.uber.org/dig v1.19.0
@@ -35,3 +36,7 @@ require (
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/moasq/backend/pkg/health => ../health
+
+replace github.com/moasq/backend/pkg/lifecycle => ../lifecycle
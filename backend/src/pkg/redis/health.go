@@ -0,0 +1,19 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/moasq/backend/pkg/health"
+)
+
+// HealthCheck pings Redis. Rate limiting, idempotency, and auth caches all
+// depend on it, so it is critical for readiness.
+func HealthCheck(client Client) health.Check {
+	return health.Check{
+		Name:     "redis",
+		Critical: true,
+		Run: func(ctx context.Context) (health.Details, error) {
+			return nil, client.Ping(ctx)
+		},
+	}
+}
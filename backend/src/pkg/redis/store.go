@@ -10,4 +10,12 @@ type Client interface {
 	Get(ctx context.Context, key string) (string, error)
 	Delete(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
+	// SetNX sets key only if it does not exist yet and reports whether it was set.
+	SetNX(ctx context.Context, key string, value any, ttl time.Duration) (bool, error)
+	// Eval runs a Lua script atomically. Scripts must only touch keys.
+	Eval(ctx context.Context, script string, keys []string, args ...any) (any, error)
+	// Ping checks the connection to the server.
+	Ping(ctx context.Context) error
+	// Close releases the connection pool.
+	Close() error
 }
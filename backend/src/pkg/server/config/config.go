@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -20,6 +21,8 @@ type Config struct {
 
 	// Server settings
 	ServerAddress string `mapstructure:"SERVER_ADDRESS"`
+	// ShutdownTimeout is how long in-flight requests get to finish on shutdown
+	ShutdownTimeout time.Duration `mapstructure:"SHUTDOWN_HTTP_TIMEOUT"`
 
 	// Security settings (cannot be disabled in production)
 	EnableTLS   bool   `mapstructure:"ENABLE_TLS"`    // Must be true in production
@@ -94,6 +97,7 @@ func LoadConfig() (*Config, error) {
 	// Set default values
 	viper.SetDefault("ENV", "DEV")
 	viper.SetDefault("SERVER_ADDRESS", ":8080")
+	viper.SetDefault("SHUTDOWN_HTTP_TIMEOUT", "10s")
 	viper.SetDefault("RATE_LIMIT_PER_SECOND", 100)
 	viper.SetDefault("MAX_REQUEST_SIZE", 1024*1024*10) // 10MB
 	viper.SetDefault("LOG_LEVEL", "info")
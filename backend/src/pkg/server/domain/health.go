@@ -25,7 +25,12 @@ func (s *HTTPServer) setupHealthCheck() {
 	// Register health endpoint at both paths
 	s.router.GET("/health", healthHandler)
 	s.router.GET("/api/health", healthHandler)
-	s.logger.Info("Health check endpoints set up at /health and /api/health")
+
+	// Kubernetes probes: liveness never touches dependencies, readiness runs
+	// the checks modules registered and fails when a critical one is down
+	s.router.GET("/livez", gin.WrapH(s.health.LivenessHandler()))
+	s.router.GET("/readyz", gin.WrapH(s.health.ReadinessHandler()))
+	s.logger.Info("Health check endpoints set up at /health, /api/health, /livez, and /readyz")
 }
 
 func (s *HTTPServer) setupRootEndpoint() {
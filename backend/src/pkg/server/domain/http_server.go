@@ -2,20 +2,23 @@ package domain
 
 import (
 	"context"
+	"fmt"
 	"net/http"
-	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/moasq/backend/pkg/health"
+	pkgmetrics "github.com/moasq/backend/pkg/metrics"
 	config "github.com/moasq/backend/server/config"
 	"github.com/moasq/backend/server/logging"
+	"github.com/moasq/backend/server/metrics"
 	"github.com/moasq/backend/server/middleware"
 )
 
 type HTTPServer struct {
 	config           *config.Config
+	metricsConfig    *pkgmetrics.Config
+	health           *health.Registry
 	router           *gin.Engine
 	logger           *logging.Logger
 	securityLogger   *logging.SecurityLogger
@@ -28,6 +31,8 @@ func NewHTTPServer(
 	config *config.Config,
 	router *gin.Engine,
 	logger *logging.Logger,
+	metricsConfig *pkgmetrics.Config,
+	healthRegistry *health.Registry,
 ) Server {
 	if config.IsProd() {
 		gin.SetMode(gin.ReleaseMode)
@@ -37,6 +42,8 @@ func NewHTTPServer(
 
 	server := &HTTPServer{
 		config:           config,
+		metricsConfig:    metricsConfig,
+		health:           healthRegistry,
 		router:           router,
 		logger:           logger,
 		securityLogger:   logging.NewSecurityLogger(logger.SugaredLogger),
@@ -49,14 +56,25 @@ func NewHTTPServer(
 	return server
 }
 
-// Start initializes and starts the HTTP server
-func (s *HTTPServer) Start() error {
+// Run initializes the HTTP server and serves until ctx is canceled
+func (s *HTTPServer) Run(ctx context.Context) error {
 	srv := s.createHTTPServer()
 	s.setupHealthCheck()
 	s.setupRootEndpoint()
+	metrics.SetupPrometheus(s.router, s.metricsConfig)
 
-	go s.startServer(srv)
-	return s.handleGracefulShutdown(srv)
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.startServer(srv)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	return s.shutdown(srv)
 }
 
 func (s *HTTPServer) MiddlewareResolver() MiddlewareResolver {
@@ -97,7 +115,7 @@ func (s *HTTPServer) createHTTPServer() *http.Server {
 	}
 }
 
-func (s *HTTPServer) startServer(srv *http.Server) {
+func (s *HTTPServer) startServer(srv *http.Server) error {
 	s.logger.Info("Starting server on " + s.config.ServerAddress)
 	var err error
 
@@ -111,29 +129,30 @@ func (s *HTTPServer) startServer(srv *http.Server) {
 	}
 
 	if err != nil && err != http.ErrServerClosed {
-		s.logger.Fatal("Failed to start server", err)
+		return fmt.Errorf("failed to start server: %w", err)
 	}
+	return nil
 }
 
-func (s *HTTPServer) handleGracefulShutdown(srv *http.Server) error {
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+// shutdown fails readiness, stops accepting connections, and waits up to
+// the shutdown timeout for in-flight requests
+func (s *HTTPServer) shutdown(srv *http.Server) error {
 	s.logger.Info("Shutting down server...")
+	s.health.SetDraining()
 
 	// Stop IP Protection cleanup goroutine
 	if s.ipProtection != nil {
 		s.ipProtection.Stop()
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.ShutdownTimeout)
 	defer cancel()
 
 	if err := srv.Shutdown(ctx); err != nil {
-		s.logger.Fatal("Server forced to shutdown", err)
+		return fmt.Errorf("server forced to shutdown: %w", err)
 	}
 
-	s.logger.Info("Server exited gracefully")
+	s.logger.Info("Server stopped accepting requests")
 	return nil
 }
 
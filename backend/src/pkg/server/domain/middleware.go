@@ -3,6 +3,7 @@ package domain
 import (
 	"time"
 
+	"github.com/moasq/backend/server/metrics"
 	"github.com/moasq/backend/server/middleware"
 	"github.com/gin-gonic/gin"
 )
@@ -14,7 +15,9 @@ func (s *HTTPServer) setupMiddleware() {
 	requestTimeout := time.Duration(s.config.ExtractionTimeoutSeconds+10) * time.Second // Add 10s buffer
 	
 	s.router.Use(
+		middleware.Tracing(),
 		middleware.RequestID(),
+		metrics.Middleware(s.metricsConfig.Path),
 		ipProtection.Protect(),
 		middleware.RequestSanitization(s.config.GetSanitizationConfig()),
 		middleware.Recovery(s.logger),
@@ -39,8 +42,8 @@ func (s *HTTPServer) setupMiddleware() {
 
 func (s *HTTPServer) requestLoggingMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Skip health check logging in production
-		if s.config.IsProd() && c.Request.URL.Path == "/health" {
+		// Skip health check and probe logging in production
+		if s.config.IsProd() && isProbePath(c.Request.URL.Path) {
 			c.Next()
 			return
 		}
@@ -54,6 +57,7 @@ func (s *HTTPServer) requestLoggingMiddleware() gin.HandlerFunc {
 
 		s.logger.Infow("Request completed",
 			"request_id", requestID,
+			"trace_id", middleware.TraceID(c),
 			"status", c.Writer.Status(),
 			"method", c.Request.Method,
 			"path", path,
@@ -65,3 +69,12 @@ func (s *HTTPServer) requestLoggingMiddleware() gin.HandlerFunc {
 		)
 	}
 }
+
+// isProbePath reports whether path is a health or Kubernetes probe endpoint.
+func isProbePath(path string) bool {
+	switch path {
+	case "/health", "/livez", "/readyz":
+		return true
+	}
+	return false
+}
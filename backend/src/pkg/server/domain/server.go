@@ -1,6 +1,10 @@
 package domain
 
-import "github.com/gin-gonic/gin"
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
 
 // Constants for API versioning
 const (
@@ -19,7 +23,9 @@ type MiddlewareFunc func() gin.HandlerFunc
 // domain/server.go - Add to the Server interface
 // Server defines the interface for HTTP server operations
 type Server interface {
-	Start() error
+	// Run serves until ctx is canceled, then stops accepting connections and
+	// waits for in-flight requests. It returns early if the listener fails.
+	Run(ctx context.Context) error
 	RegisterRoutes(registrar RouteRegistrar, prefix string, version ...string)
 	RegisterNamedMiddleware(name string, middleware MiddlewareFunc)
 	MiddlewareResolver() MiddlewareResolver
@@ -0,0 +1,51 @@
+package idempotency
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Config configures Idempotency-Key handling.
+type Config struct {
+	// TTL is how long a completed response is kept for replay.
+	TTL time.Duration `mapstructure:"IDEMPOTENCY_TTL"`
+	// LockTimeout is how long a key stays in flight before a retry may run the
+	// request again. It must cover the slowest protected endpoint.
+	LockTimeout time.Duration `mapstructure:"IDEMPOTENCY_LOCK_TIMEOUT"`
+	// MaxResponseBytes caps the stored response. Larger responses are not
+	// stored and the key is released after the request.
+	MaxResponseBytes int `mapstructure:"IDEMPOTENCY_MAX_RESPONSE_BYTES"`
+}
+
+// LoadConfig reads the idempotency configuration from app.env or the environment.
+func LoadConfig() (*Config, error) {
+	var cfg Config
+
+	viper.SetConfigName("app")
+	viper.SetConfigType("env")
+	viper.AddConfigPath(".")
+	viper.AutomaticEnv()
+
+	viper.SetDefault("IDEMPOTENCY_TTL", "24h")
+	viper.SetDefault("IDEMPOTENCY_LOCK_TIMEOUT", "5m")
+	viper.SetDefault("IDEMPOTENCY_MAX_RESPONSE_BYTES", 1<<20)
+
+	if err := viper.ReadInConfig(); err == nil {
+		_ = err
+	}
+
+	if err := viper.Unmarshal(&cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.TTL <= 0 || cfg.LockTimeout <= 0 {
+		return nil, fmt.Errorf("IDEMPOTENCY_TTL and IDEMPOTENCY_LOCK_TIMEOUT must be positive")
+	}
+	if cfg.MaxResponseBytes <= 0 {
+		return nil, fmt.Errorf("IDEMPOTENCY_MAX_RESPONSE_BYTES must be positive")
+	}
+
+	return &cfg, nil
+}
@@ -0,0 +1,277 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/server/logging"
+)
+
+const (
+	// HeaderKey is the request header carrying the client's idempotency key.
+	HeaderKey = "Idempotency-Key"
+	// HeaderReplayed is set on responses replayed from a stored result.
+	HeaderReplayed = "Idempotent-Replayed"
+
+	keyPrefix    = "idempotency"
+	maxKeyLength = 255
+	storeTimeout = 2 * time.Second
+)
+
+// Middleware makes mutating requests safe to retry.
+//
+// It is registered as the "idempotency" named middleware. Requests that carry
+// an Idempotency-Key header are recorded per organization and route: the first
+// request runs, and retries with the same key receive the stored response.
+// A retry while the first request is still running gets 409, and reusing a key
+// with a different request body gets 422. Requests without the header are not
+// affected.
+//
+// Responses with status 5xx or 429 are not stored, so the client can retry
+// them with the same key.
+type Middleware struct {
+	config *Config
+	store  Store
+	logger *logging.Logger
+}
+
+// NewMiddleware creates the idempotency middleware.
+func NewMiddleware(cfg *Config, store Store, logger *logging.Logger) *Middleware {
+	return &Middleware{
+		config: cfg,
+		store:  store,
+		logger: logger,
+	}
+}
+
+// Handler returns the gin middleware.
+func (m *Middleware) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idempotencyKey := c.GetHeader(HeaderKey)
+		if idempotencyKey == "" || !isMutating(c.Request.Method) {
+			c.Next()
+			return
+		}
+		if len(idempotencyKey) > maxKeyLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("%s must be at most %d characters", HeaderKey, maxKeyLength),
+			})
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "request size limit exceeded"})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		route := c.FullPath()
+		key := m.storageKey(c, route, idempotencyKey)
+		record := &Record{
+			State:       StateInFlight,
+			Fingerprint: fingerprint(c.Request.Method, route, c.GetHeader("Content-Type"), body),
+			CreatedAt:   time.Now().UTC(),
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), storeTimeout)
+		existing, acquired, err := m.store.Acquire(ctx, key, record, m.config.LockTimeout)
+		cancel()
+		if err != nil {
+			// Without the store the request runs unprotected rather than failing
+			m.logger.Warnw("idempotency store unavailable", "error", err, "route", route)
+			c.Next()
+			return
+		}
+
+		if !acquired {
+			m.respondExisting(c, existing, record.Fingerprint)
+			return
+		}
+
+		m.process(c, key, record)
+	}
+}
+
+// respondExisting answers a request whose key was already used.
+func (m *Middleware) respondExisting(c *gin.Context, existing *Record, fingerprint string) {
+	switch {
+	case existing.Fingerprint != fingerprint:
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+			"error": fmt.Sprintf("%s was already used for a different request", HeaderKey),
+		})
+	case existing.State != StateCompleted:
+		c.Header("Retry-After", "1")
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+			"error": fmt.Sprintf("a request with this %s is still in progress", HeaderKey),
+		})
+	default:
+		c.Header(HeaderReplayed, "true")
+		c.Data(existing.StatusCode, existing.ContentType, existing.Body)
+		c.Abort()
+	}
+}
+
+// process runs the request and stores its response under key.
+func (m *Middleware) process(c *gin.Context, key string, record *Record) {
+	writer := &captureWriter{ResponseWriter: c.Writer, limit: m.config.MaxResponseBytes}
+	c.Writer = writer
+
+	completed := false
+	defer func() {
+		// Release the key when the handler panics so retries are not blocked
+		// until the lock times out
+		if !completed {
+			m.release(c, key)
+		}
+	}()
+
+	c.Next()
+	completed = true
+
+	status := writer.Status()
+	if status >= http.StatusInternalServerError || status == http.StatusTooManyRequests || writer.overflow {
+		m.release(c, key)
+		return
+	}
+
+	record.State = StateCompleted
+	record.StatusCode = status
+	record.ContentType = writer.Header().Get("Content-Type")
+	record.Body = writer.body.Bytes()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), storeTimeout)
+	defer cancel()
+	if err := m.store.Complete(ctx, key, record, m.config.TTL); err != nil {
+		m.logger.Warnw("failed to store idempotent response", "error", err, "route", c.FullPath())
+	}
+}
+
+func (m *Middleware) release(c *gin.Context, key string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), storeTimeout)
+	defer cancel()
+	if err := m.store.Release(ctx, key); err != nil {
+		m.logger.Warnw("failed to release idempotency key", "error", err, "route", c.FullPath())
+	}
+}
+
+// storageKey scopes the client's key to the organization and route so keys
+// from different tenants or endpoints never collide. Public routes share the
+// "public" scope; clients are expected to send random keys (e.g. UUIDs).
+func (m *Middleware) storageKey(c *gin.Context, route, idempotencyKey string) string {
+	scope := "public"
+	if orgID := auth.GetOrganizationID(c); orgID != 0 {
+		scope = "org:" + strconv.Itoa(int(orgID))
+	}
+	sum := sha256.Sum256([]byte(c.Request.Method + " " + route + "\n" + idempotencyKey))
+	return fmt.Sprintf("%s:%s:%s", keyPrefix, scope, hex.EncodeToString(sum[:]))
+}
+
+// fingerprint identifies a request by method, route, and body. Multipart
+// bodies are identified by their parts rather than their raw bytes, because
+// clients pick a new random boundary every time they send one.
+func fingerprint(method, route, contentType string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method + " " + route + "\n"))
+	if parts, ok := multipartFingerprint(contentType, body); ok {
+		h.Write([]byte(parts))
+	} else {
+		h.Write(body)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// multipartFingerprint lists the field names and values of a multipart body
+// with a SHA-256 of each file, sorted so part order does not matter. ok is
+// false for other bodies and for multipart bodies that cannot be parsed,
+// which are identified by their raw bytes instead.
+func multipartFingerprint(contentType string, body []byte) (string, bool) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") || params["boundary"] == "" {
+		return "", false
+	}
+
+	var entries []string
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", false
+		}
+
+		h := sha256.New()
+		if _, err := io.Copy(h, part); err != nil {
+			return "", false
+		}
+		sum := hex.EncodeToString(h.Sum(nil))
+		if fileName := part.FileName(); fileName != "" {
+			entries = append(entries, fmt.Sprintf("file %q %q %s", part.FormName(), fileName, sum))
+		} else {
+			entries = append(entries, fmt.Sprintf("field %q %s", part.FormName(), sum))
+		}
+	}
+
+	sort.Strings(entries)
+	return strings.Join(entries, "\n"), true
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// captureWriter copies the response body while writing it to the client.
+type captureWriter struct {
+	gin.ResponseWriter
+	body     bytes.Buffer
+	limit    int
+	overflow bool
+}
+
+func (w *captureWriter) Write(data []byte) (int, error) {
+	w.capture(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *captureWriter) WriteString(s string) (int, error) {
+	w.capture([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *captureWriter) capture(data []byte) {
+	if w.overflow {
+		return
+	}
+	if w.body.Len()+len(data) > w.limit {
+		w.overflow = true
+		w.body.Reset()
+		return
+	}
+	w.body.Write(data)
+}
@@ -0,0 +1,49 @@
+package idempotency
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moasq/backend/pkg/redis"
+	"github.com/moasq/backend/server/domain"
+	"github.com/moasq/backend/server/logging"
+	"go.uber.org/dig"
+)
+
+// SetupMiddleware wires the idempotency middleware into the DI container.
+//
+// # Prerequisites
+//
+// The following must be available in the container:
+//   - redis.Client
+//   - *logging.Logger
+func SetupMiddleware(container *dig.Container) error {
+	if err := container.Provide(LoadConfig); err != nil {
+		return fmt.Errorf("failed to provide idempotency config: %w", err)
+	}
+
+	if err := container.Provide(func(
+		cfg *Config,
+		client redis.Client,
+		logger *logging.Logger,
+	) *Middleware {
+		return NewMiddleware(cfg, NewRedisStore(client), logger)
+	}); err != nil {
+		return fmt.Errorf("failed to provide idempotency middleware: %w", err)
+	}
+
+	return nil
+}
+
+// RegisterNamedMiddlewares registers the "idempotency" named middleware with
+// the server. Add it to mutating routes after "org_context", so keys are
+// scoped to the organization:
+//
+//	group.POST("/upload", resolver.Get("idempotency"), handler.Upload)
+func RegisterNamedMiddlewares(container *dig.Container) error {
+	return container.Invoke(func(middleware *Middleware, server domain.Server) {
+		server.RegisterNamedMiddleware("idempotency", domain.MiddlewareFunc(func() gin.HandlerFunc {
+			return middleware.Handler()
+		}))
+	})
+}
@@ -0,0 +1,93 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/moasq/backend/pkg/redis"
+)
+
+// Record states.
+const (
+	StateInFlight  = "in_flight"
+	StateCompleted = "completed"
+)
+
+// Record is what is stored under an idempotency key.
+type Record struct {
+	State string `json:"state"`
+	// Fingerprint identifies the request (method, route, and body) that first
+	// used the key.
+	Fingerprint string    `json:"fingerprint"`
+	StatusCode  int       `json:"status_code,omitempty"`
+	ContentType string    `json:"content_type,omitempty"`
+	Body        []byte    `json:"body,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Store keeps idempotency records.
+type Store interface {
+	// Acquire stores record if key is unused. Otherwise it returns the
+	// existing record and false.
+	Acquire(ctx context.Context, key string, record *Record, ttl time.Duration) (*Record, bool, error)
+	// Complete replaces the in-flight record with the final response.
+	Complete(ctx context.Context, key string, record *Record, ttl time.Duration) error
+	// Release deletes the key so the request can be retried.
+	Release(ctx context.Context, key string) error
+}
+
+type redisStore struct {
+	client redis.Client
+}
+
+// NewRedisStore returns a Store backed by Redis.
+func NewRedisStore(client redis.Client) Store {
+	return &redisStore{client: client}
+}
+
+func (s *redisStore) Acquire(ctx context.Context, key string, record *Record, ttl time.Duration) (*Record, bool, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to encode idempotency record: %w", err)
+	}
+
+	ok, err := s.client.SetNX(ctx, key, data, ttl)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to acquire idempotency key: %w", err)
+	}
+	if ok {
+		return record, true, nil
+	}
+
+	// A key that expires between SETNX and GET surfaces as an error here
+	raw, err := s.client.Get(ctx, key)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to load idempotency key: %w", err)
+	}
+
+	var existing Record
+	if err := json.Unmarshal([]byte(raw), &existing); err != nil {
+		return nil, false, fmt.Errorf("failed to decode idempotency record: %w", err)
+	}
+	return &existing, false, nil
+}
+
+func (s *redisStore) Complete(ctx context.Context, key string, record *Record, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode idempotency record: %w", err)
+	}
+	if err := s.client.Set(ctx, key, data, ttl); err != nil {
+		return fmt.Errorf("failed to store idempotent response: %w", err)
+	}
+	return nil
+}
+
+func (s *redisStore) Release(ctx context.Context, key string) error {
+	if err := s.client.Delete(ctx, key); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
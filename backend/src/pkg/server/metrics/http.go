@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/moasq/backend/pkg/auth"
+	pkgmetrics "github.com/moasq/backend/pkg/metrics"
+)
+
+// unmatchedRoute labels requests that matched no route, so scanners probing
+// random paths cannot create new series.
+const unmatchedRoute = "unmatched"
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_server_requests_total",
+		Help: "HTTP requests by method, route template, and status code.",
+	}, []string{"method", "route", "status", "organization_id"})
+
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_server_request_duration_seconds",
+		Help:    "HTTP request latency by method and route template.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	httpInFlight = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "http_server_requests_in_flight",
+		Help: "HTTP requests currently being served.",
+	})
+)
+
+// Middleware records request rate, errors, and duration per route template.
+// The organization_id label is filled only when organization labels are
+// enabled and the request was authenticated into an organization.
+func Middleware(skipPath string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.URL.Path == skipPath {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		httpInFlight.Inc()
+		defer httpInFlight.Dec()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		method := methodLabel(c.Request.Method)
+
+		httpDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+		httpRequests.WithLabelValues(
+			method,
+			route,
+			strconv.Itoa(c.Writer.Status()),
+			pkgmetrics.OrganizationLabel(auth.GetOrganizationID(c)),
+		).Inc()
+	}
+}
+
+// methodLabel keeps the method label to the standard verbs.
+func methodLabel(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+		http.MethodPatch, http.MethodDelete, http.MethodOptions:
+		return method
+	default:
+		return "OTHER"
+	}
+}
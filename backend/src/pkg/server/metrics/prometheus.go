@@ -1,10 +1,35 @@
 package metrics
 
 import (
+	"crypto/subtle"
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	pkgmetrics "github.com/moasq/backend/pkg/metrics"
 )
 
-func SetupPrometheus(router *gin.Engine) {
-	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+// SetupPrometheus mounts the scrape endpoint for the default registry, which
+// every package registers its collectors with. When an auth token is
+// configured the endpoint requires it as a bearer token.
+func SetupPrometheus(router *gin.Engine, cfg *pkgmetrics.Config) {
+	if !cfg.Enabled {
+		return
+	}
+
+	handler := gin.WrapH(promhttp.Handler())
+	if cfg.AuthToken == "" {
+		router.GET(cfg.Path, handler)
+		return
+	}
+
+	expected := []byte("Bearer " + cfg.AuthToken)
+	router.GET(cfg.Path, func(c *gin.Context) {
+		if subtle.ConstantTimeCompare([]byte(c.GetHeader("Authorization")), expected) != 1 {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		handler(c)
+	})
 }
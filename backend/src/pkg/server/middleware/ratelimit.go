@@ -7,6 +7,9 @@ import (
 	"golang.org/x/time/rate"
 )
 
+// RateLimiter limits the whole replica to rateLimitPerSecond requests,
+// shared by every client. Per-tenant limits are enforced by the "rate_limit"
+// named middleware (server/ratelimit).
 func RateLimiter(rateLimitPerSecond int) gin.HandlerFunc {
 	limiter := rate.NewLimiter(rate.Limit(rateLimitPerSecond), rateLimitPerSecond)
 	return func(c *gin.Context) {
@@ -37,6 +37,7 @@ func Recovery(logger *logging.Logger) gin.HandlerFunc {
 					"error", err,
 					"stack", string(stack),
 					"request_id", GetRequestID(c),
+					"trace_id", TraceID(c),
 					"method", httpRequest.Method,
 					"path", httpRequest.URL.Path,
 					"query", httpRequest.URL.RawQuery,
@@ -3,6 +3,8 @@ package middleware
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -13,16 +15,22 @@ const (
 	RequestIDKey = "request_id"
 )
 
-// RequestID middleware ensures each request has a unique ID for tracing
+// RequestID middleware ensures each request has a unique ID for tracing.
+// Without a caller-supplied ID, the trace ID is used so responses can be
+// looked up in the tracing backend. Must run after Tracing.
 func RequestID() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Check if request already has an ID
 		requestID := c.GetHeader(RequestIDHeader)
 
-		// Generate new ID if none exists
+		// Fall back to the trace ID, then to a new ID
+		if requestID == "" {
+			requestID = TraceID(c)
+		}
 		if requestID == "" {
 			requestID = generateRequestID()
 		}
+		trace.SpanFromContext(c.Request.Context()).SetAttributes(attribute.String("http.request.id", requestID))
 
 		// Set ID in context and response header
 		c.Set(RequestIDKey, requestID)
@@ -0,0 +1,188 @@
+package ratelimit
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Client key strategies (RATE_LIMIT_KEY). Requests without the chosen
+// identity (e.g. unauthenticated routes) fall back to the client IP.
+const (
+	KeyByOrganization = "organization"
+	KeyByAccount      = "account"
+	KeyByIP           = "ip"
+	// KeyByHeaderPrefix keys by a request header, e.g. "header:X-API-Key".
+	// Only use it behind a gateway that authenticates the header.
+	KeyByHeaderPrefix = "header:"
+)
+
+// Limit allows Requests per Window, with bursts of up to Requests.
+type Limit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// Policy returns the limit in RateLimit-Policy form, e.g. "600;w=60".
+func (l Limit) Policy() string {
+	return fmt.Sprintf("%d;w=%d", l.Requests, int(l.Window.Seconds()))
+}
+
+// RouteRule applies Limit to routes starting with Prefix. Each rule counts
+// requests in its own bucket, separate from the default limit.
+type RouteRule struct {
+	Prefix string
+	Limit  Limit
+}
+
+// Config configures the distributed rate limiter.
+type Config struct {
+	Enabled bool
+	// Default applies to routes that match no rule.
+	Default Limit
+	// Routes are matched longest prefix first against the route pattern.
+	Routes []RouteRule
+	// PlanMultipliers scale limits for organizations on a plan (e.g. pro=2).
+	// Organizations without an active subscription use the unscaled limits.
+	PlanMultipliers map[string]float64
+	// Key is the client key strategy (KeyBy* values).
+	Key string
+	// FailOpen lets requests through when Redis is unavailable; otherwise
+	// they are rejected with 503.
+	FailOpen bool
+	// PlanCacheTTL is how long an organization's plan is cached in memory.
+	PlanCacheTTL time.Duration
+}
+
+// settings is the raw environment configuration.
+type settings struct {
+	Enabled      bool          `mapstructure:"RATE_LIMIT_ENABLED"`
+	Default      string        `mapstructure:"RATE_LIMIT_DEFAULT"`
+	Routes       string        `mapstructure:"RATE_LIMIT_ROUTES"`
+	Plans        string        `mapstructure:"RATE_LIMIT_PLANS"`
+	Key          string        `mapstructure:"RATE_LIMIT_KEY"`
+	FailOpen     bool          `mapstructure:"RATE_LIMIT_FAIL_OPEN"`
+	PlanCacheTTL time.Duration `mapstructure:"RATE_LIMIT_PLAN_CACHE_TTL"`
+}
+
+// LoadConfig reads the rate limit configuration from app.env or the environment.
+//
+//	RATE_LIMIT_DEFAULT=600/1m
+//	RATE_LIMIT_ROUTES=/api/example_cognitive=60/1m,/api/example_documents/upload=20/1m
+//	RATE_LIMIT_PLANS=free=0.5,pro=2,enterprise=10
+func LoadConfig() (*Config, error) {
+	var raw settings
+
+	viper.SetConfigName("app")
+	viper.SetConfigType("env")
+	viper.AddConfigPath(".")
+	viper.AutomaticEnv()
+
+	viper.SetDefault("RATE_LIMIT_ENABLED", true)
+	viper.SetDefault("RATE_LIMIT_DEFAULT", "600/1m")
+	viper.SetDefault("RATE_LIMIT_ROUTES", "")
+	viper.SetDefault("RATE_LIMIT_PLANS", "")
+	viper.SetDefault("RATE_LIMIT_KEY", KeyByOrganization)
+	viper.SetDefault("RATE_LIMIT_FAIL_OPEN", true)
+	viper.SetDefault("RATE_LIMIT_PLAN_CACHE_TTL", "1m")
+
+	if err := viper.ReadInConfig(); err == nil {
+		_ = err
+	}
+
+	if err := viper.Unmarshal(&raw); err != nil {
+		return nil, err
+	}
+
+	return raw.parse()
+}
+
+func (s settings) parse() (*Config, error) {
+	defaultLimit, err := parseLimit(s.Default)
+	if err != nil {
+		return nil, fmt.Errorf("invalid RATE_LIMIT_DEFAULT: %w", err)
+	}
+
+	cfg := &Config{
+		Enabled:         s.Enabled,
+		Default:         defaultLimit,
+		PlanMultipliers: make(map[string]float64),
+		Key:             strings.TrimSpace(s.Key),
+		FailOpen:        s.FailOpen,
+		PlanCacheTTL:    s.PlanCacheTTL,
+	}
+
+	switch {
+	case cfg.Key == KeyByOrganization, cfg.Key == KeyByAccount, cfg.Key == KeyByIP:
+	case strings.HasPrefix(cfg.Key, KeyByHeaderPrefix) && len(cfg.Key) > len(KeyByHeaderPrefix):
+	default:
+		return nil, fmt.Errorf("invalid RATE_LIMIT_KEY %q", cfg.Key)
+	}
+
+	for _, entry := range splitList(s.Routes) {
+		prefix, value, ok := strings.Cut(entry, "=")
+		if !ok || !strings.HasPrefix(prefix, "/") {
+			return nil, fmt.Errorf("invalid RATE_LIMIT_ROUTES entry %q", entry)
+		}
+		limit, err := parseLimit(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RATE_LIMIT_ROUTES entry %q: %w", entry, err)
+		}
+		cfg.Routes = append(cfg.Routes, RouteRule{Prefix: prefix, Limit: limit})
+	}
+	sort.SliceStable(cfg.Routes, func(i, j int) bool {
+		return len(cfg.Routes[i].Prefix) > len(cfg.Routes[j].Prefix)
+	})
+
+	for _, entry := range splitList(s.Plans) {
+		plan, value, ok := strings.Cut(entry, "=")
+		multiplier, err := strconv.ParseFloat(value, 64)
+		if !ok || err != nil || multiplier <= 0 {
+			return nil, fmt.Errorf("invalid RATE_LIMIT_PLANS entry %q", entry)
+		}
+		cfg.PlanMultipliers[strings.ToLower(strings.TrimSpace(plan))] = multiplier
+	}
+
+	return cfg, nil
+}
+
+// limitFor returns the bucket name and limit for a route.
+func (c *Config) limitFor(route string) (string, Limit) {
+	for _, rule := range c.Routes {
+		if strings.HasPrefix(route, rule.Prefix) {
+			return rule.Prefix, rule.Limit
+		}
+	}
+	return "default", c.Default
+}
+
+// parseLimit parses "<requests>/<window>", e.g. "600/1m" or "10/1s".
+func parseLimit(value string) (Limit, error) {
+	requests, window, ok := strings.Cut(strings.TrimSpace(value), "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("expected <requests>/<window>, got %q", value)
+	}
+	n, err := strconv.Atoi(requests)
+	if err != nil || n <= 0 {
+		return Limit{}, fmt.Errorf("invalid request count %q", requests)
+	}
+	d, err := time.ParseDuration(window)
+	if err != nil || d < time.Second {
+		return Limit{}, fmt.Errorf("invalid window %q: must be at least 1s", window)
+	}
+	return Limit{Requests: n, Window: d}, nil
+}
+
+func splitList(value string) []string {
+	var entries []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
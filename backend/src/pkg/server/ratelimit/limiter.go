@@ -0,0 +1,101 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/moasq/backend/pkg/redis"
+)
+
+// Result is the outcome of a rate limit check.
+type Result struct {
+	Allowed   bool
+	Limit     Limit
+	Remaining int
+	// RetryAfter is how long to wait before the next request is allowed.
+	// Zero when Allowed.
+	RetryAfter time.Duration
+	// ResetAfter is how long until the bucket is full again.
+	ResetAfter time.Duration
+}
+
+// Limiter checks requests against a shared rate limit.
+type Limiter interface {
+	// Allow consumes one request from key's bucket.
+	Allow(ctx context.Context, key string, limit Limit) (*Result, error)
+}
+
+// gcraScript implements the generic cell rate algorithm (a token bucket that
+// stores a single timestamp per key). It uses the Redis clock so replicas with
+// skewed clocks share the same view of every bucket.
+//
+// KEYS[1] bucket key; ARGV[1] emission interval (ms); ARGV[2] burst (requests).
+// Returns {allowed, remaining, retry_after_ms, reset_after_ms}.
+const gcraScript = `
+local interval = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local period = interval * burst
+
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local tat = tonumber(redis.call('GET', KEYS[1]))
+if not tat or tat < now then
+  tat = now
+end
+
+local new_tat = tat + interval
+local allow_at = new_tat - period
+if allow_at > now then
+  local remaining = math.floor((period - (tat - now)) / interval)
+  return {0, remaining, allow_at - now, tat - now}
+end
+
+redis.call('SET', KEYS[1], new_tat, 'PX', new_tat - now)
+local remaining = math.floor((period - (new_tat - now)) / interval)
+return {1, remaining, 0, new_tat - now}
+`
+
+type redisLimiter struct {
+	client redis.Client
+}
+
+// NewRedisLimiter returns a Limiter whose buckets live in Redis and are shared
+// by every replica.
+func NewRedisLimiter(client redis.Client) Limiter {
+	return &redisLimiter{client: client}
+}
+
+func (l *redisLimiter) Allow(ctx context.Context, key string, limit Limit) (*Result, error) {
+	interval := limit.Window.Milliseconds() / int64(limit.Requests)
+	if interval < 1 {
+		interval = 1
+	}
+
+	raw, err := l.client.Eval(ctx, gcraScript, []string{key}, interval, limit.Requests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate rate limit: %w", err)
+	}
+
+	values, ok := raw.([]any)
+	if !ok || len(values) != 4 {
+		return nil, fmt.Errorf("unexpected rate limit reply: %v", raw)
+	}
+	ints := make([]int64, len(values))
+	for i, v := range values {
+		n, ok := v.(int64)
+		if !ok {
+			return nil, fmt.Errorf("unexpected rate limit reply: %v", raw)
+		}
+		ints[i] = n
+	}
+
+	return &Result{
+		Allowed:    ints[0] == 1,
+		Limit:      limit,
+		Remaining:  int(max(ints[1], 0)),
+		RetryAfter: time.Duration(ints[2]) * time.Millisecond,
+		ResetAfter: time.Duration(ints[3]) * time.Millisecond,
+	}, nil
+}
@@ -0,0 +1,218 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moasq/backend/pkg/auth"
+	"github.com/moasq/backend/pkg/paywall"
+	"github.com/moasq/backend/server/logging"
+)
+
+const (
+	keyPrefix = "ratelimit"
+	// redisTimeout bounds each limiter round trip so a slow Redis fails the
+	// check (open or closed) instead of stalling the request.
+	redisTimeout = 100 * time.Millisecond
+	// warnInterval throttles "limiter unavailable" warnings while Redis is down.
+	warnInterval = 30 * time.Second
+)
+
+// Middleware enforces distributed rate limits.
+//
+// It is registered as the "rate_limit" named middleware and must run after
+// "org_context" so requests can be keyed by organization or account. Routes
+// without an organization context (public endpoints) are keyed by client IP.
+//
+// The in-process middleware.RateLimiter on the router remains as a per-replica
+// backstop; this middleware provides the per-tenant limits.
+type Middleware struct {
+	config   *Config
+	limiter  Limiter
+	plans    *planCache
+	logger   *logging.Logger
+	lastWarn atomic.Int64
+}
+
+// NewMiddleware creates the rate limit middleware. provider may be nil, in
+// which case plan multipliers only apply when an earlier paywall middleware
+// has already loaded the subscription status.
+func NewMiddleware(cfg *Config, limiter Limiter, provider paywall.SubscriptionStatusProvider, logger *logging.Logger) *Middleware {
+	return &Middleware{
+		config:  cfg,
+		limiter: limiter,
+		plans:   newPlanCache(provider, cfg.PlanCacheTTL),
+		logger:  logger,
+	}
+}
+
+// Handler returns the gin middleware.
+func (m *Middleware) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !m.config.Enabled || c.Request.Method == http.MethodOptions {
+			c.Next()
+			return
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = c.Request.URL.Path
+		}
+		bucket, limit := m.config.limitFor(route)
+		limit = m.scale(c, limit)
+		key := fmt.Sprintf("%s:%s:%s", keyPrefix, bucket, m.clientKey(c))
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), redisTimeout)
+		result, err := m.limiter.Allow(ctx, key, limit)
+		cancel()
+		if err != nil {
+			m.warn("rate limiter unavailable", err)
+			if m.config.FailOpen {
+				c.Next()
+				return
+			}
+			c.Header("Retry-After", "1")
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "rate limiter unavailable"})
+			return
+		}
+
+		c.Header("RateLimit-Limit", strconv.Itoa(limit.Requests))
+		c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(result.ResetAfter)))
+		c.Header("RateLimit-Policy", limit.Policy())
+
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(max(ceilSeconds(result.RetryAfter), 1)))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "rate limit exceeded"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// scale applies the organization's plan multiplier to limit.
+func (m *Middleware) scale(c *gin.Context, limit Limit) Limit {
+	if len(m.config.PlanMultipliers) == 0 {
+		return limit
+	}
+
+	status := paywall.GetSubscriptionStatus(c)
+	if status == nil {
+		if orgID := auth.GetOrganizationID(c); orgID != 0 {
+			status = m.plans.get(c.Request.Context(), orgID)
+		}
+	}
+	if status == nil || !status.IsActive {
+		return limit
+	}
+
+	multiplier, ok := m.config.PlanMultipliers[strings.ToLower(status.Plan)]
+	if !ok {
+		return limit
+	}
+	limit.Requests = max(1, int(math.Round(float64(limit.Requests)*multiplier)))
+	return limit
+}
+
+// clientKey identifies whose bucket the request counts against.
+func (m *Middleware) clientKey(c *gin.Context) string {
+	switch {
+	case m.config.Key == KeyByOrganization:
+		if orgID := auth.GetOrganizationID(c); orgID != 0 {
+			return "org:" + strconv.Itoa(int(orgID))
+		}
+	case m.config.Key == KeyByAccount:
+		if accountID := auth.GetAccountID(c); accountID != 0 {
+			return "account:" + strconv.Itoa(int(accountID))
+		}
+	case strings.HasPrefix(m.config.Key, KeyByHeaderPrefix):
+		if value := c.GetHeader(strings.TrimPrefix(m.config.Key, KeyByHeaderPrefix)); value != "" {
+			// Hash so credentials never end up in Redis keys
+			sum := sha256.Sum256([]byte(value))
+			return "header:" + hex.EncodeToString(sum[:16])
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
+
+func (m *Middleware) warn(msg string, err error) {
+	now := time.Now().UnixNano()
+	last := m.lastWarn.Load()
+	if now-last < int64(warnInterval) || !m.lastWarn.CompareAndSwap(last, now) {
+		return
+	}
+	m.logger.Warnw(msg, "error", err, "fail_open", m.config.FailOpen)
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// planCache caches subscription status per organization so plan lookups do
+// not add a database query to every request.
+type planCache struct {
+	provider paywall.SubscriptionStatusProvider
+	ttl      time.Duration
+	mu       sync.Mutex
+	entries  map[int32]planEntry
+}
+
+type planEntry struct {
+	status    *paywall.SubscriptionStatus
+	expiresAt time.Time
+}
+
+func newPlanCache(provider paywall.SubscriptionStatusProvider, ttl time.Duration) *planCache {
+	return &planCache{
+		provider: provider,
+		ttl:      ttl,
+		entries:  make(map[int32]planEntry),
+	}
+}
+
+// get returns the organization's subscription status, or nil when it cannot
+// be loaded (the unscaled limits apply).
+func (p *planCache) get(ctx context.Context, orgID int32) *paywall.SubscriptionStatus {
+	if p.provider == nil {
+		return nil
+	}
+
+	now := time.Now()
+	p.mu.Lock()
+	entry, ok := p.entries[orgID]
+	p.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.status
+	}
+
+	status, err := p.provider.GetSubscriptionStatus(ctx, orgID)
+	if err != nil {
+		status = nil
+	}
+
+	p.mu.Lock()
+	// Drop expired entries once the cache grows so it stays bounded by the
+	// number of recently active organizations
+	if len(p.entries) >= 10000 {
+		for id, e := range p.entries {
+			if now.After(e.expiresAt) {
+				delete(p.entries, id)
+			}
+		}
+	}
+	p.entries[orgID] = planEntry{status: status, expiresAt: now.Add(p.ttl)}
+	p.mu.Unlock()
+
+	return status
+}
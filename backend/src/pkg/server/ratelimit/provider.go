@@ -0,0 +1,51 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moasq/backend/pkg/paywall"
+	"github.com/moasq/backend/pkg/redis"
+	"github.com/moasq/backend/server/domain"
+	"github.com/moasq/backend/server/logging"
+	"go.uber.org/dig"
+)
+
+// SetupMiddleware wires the rate limit middleware into the DI container.
+//
+// # Prerequisites
+//
+// The following must be available in the container:
+//   - redis.Client
+//   - paywall.SubscriptionStatusProvider (for plan multipliers)
+//   - *logging.Logger
+func SetupMiddleware(container *dig.Container) error {
+	if err := container.Provide(LoadConfig); err != nil {
+		return fmt.Errorf("failed to provide rate limit config: %w", err)
+	}
+
+	if err := container.Provide(func(
+		cfg *Config,
+		client redis.Client,
+		provider paywall.SubscriptionStatusProvider,
+		logger *logging.Logger,
+	) *Middleware {
+		return NewMiddleware(cfg, NewRedisLimiter(client), provider, logger)
+	}); err != nil {
+		return fmt.Errorf("failed to provide rate limit middleware: %w", err)
+	}
+
+	return nil
+}
+
+// RegisterNamedMiddlewares registers the "rate_limit" named middleware with
+// the server. Use it after "org_context" in route groups:
+//
+//	group.Use(resolver.Get("auth"), resolver.Get("org_context"), resolver.Get("rate_limit"))
+func RegisterNamedMiddlewares(container *dig.Container) error {
+	return container.Invoke(func(middleware *Middleware, server domain.Server) {
+		server.RegisterNamedMiddleware("rate_limit", domain.MiddlewareFunc(func() gin.HandlerFunc {
+			return middleware.Handler()
+		}))
+	})
+}
@@ -28,6 +28,9 @@ type Config struct {
 	InviteRedirectURL          string        `mapstructure:"STYTCH_INVITE_REDIRECT_URL"`
 	LoginRedirectURL           string        `mapstructure:"STYTCH_LOGIN_REDIRECT_URL"`
 	APITimeout                 time.Duration `mapstructure:"STYTCH_API_TIMEOUT"`
+	ReconcileInterval          time.Duration `mapstructure:"STYTCH_RECONCILE_INTERVAL"`
+	ReconcileDryRun            bool          `mapstructure:"STYTCH_RECONCILE_DRY_RUN"`
+	WebhookSecret              string        `mapstructure:"STYTCH_WEBHOOK_SECRET"`
 }
 
 // LoadConfig hydrates the Stytch configuration from app.env + process environment.
@@ -43,6 +46,8 @@ func LoadConfig() (*Config, error) {
 	v.SetDefault("STYTCH_SESSION_DURATION_MINUTES", 1440) // 24 hours (previously 60 minutes)
 	v.SetDefault("STYTCH_API_TIMEOUT", "15s")
 	v.SetDefault("STYTCH_DISABLE_SESSION_VERIFICATION", false)
+	v.SetDefault("STYTCH_RECONCILE_INTERVAL", "1h") // 0 disables the scheduled reconciler
+	v.SetDefault("STYTCH_RECONCILE_DRY_RUN", false)
 
 	// Best-effort: ignore missing file, allow env-only usage
 	if err := v.ReadInConfig(); err != nil {
@@ -26,6 +26,12 @@ var (
 	ErrInvalidConfig = errors.New("stytch: invalid configuration")
 	// ErrDuplicateSlug indicates organization slug already exists.
 	ErrDuplicateSlug = errors.New("stytch: organization slug already exists")
+	// ErrWebhookHeadersMissing indicates a webhook delivery without signature headers.
+	ErrWebhookHeadersMissing = errors.New("stytch: webhook signature headers missing")
+	// ErrWebhookSignatureInvalid indicates a webhook payload failed signature verification.
+	ErrWebhookSignatureInvalid = errors.New("stytch: webhook signature invalid")
+	// ErrWebhookTimestampExpired indicates a webhook delivery outside the replay window.
+	ErrWebhookTimestampExpired = errors.New("stytch: webhook timestamp outside tolerance")
 )
 
 // IsDuplicateSlugError checks if the error is a duplicate organization slug error
@@ -0,0 +1,74 @@
+package stytch
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Webhook header names. Stytch delivers webhooks through Svix, which follows the
+// Standard Webhooks format.
+const (
+	WebhookIDHeader        = "svix-id"
+	WebhookTimestampHeader = "svix-timestamp"
+	WebhookSignatureHeader = "svix-signature"
+)
+
+// DefaultWebhookTolerance bounds how old a webhook timestamp may be before it is rejected.
+const DefaultWebhookTolerance = 5 * time.Minute
+
+// VerifyWebhookSignature validates a Stytch webhook delivery.
+//
+// The signed content is "{id}.{timestamp}.{body}" and the signature header carries one
+// or more space-separated "v1,<base64 hmac>" entries (several during secret rotation).
+// The secret is the "whsec_" prefixed value from the Stytch dashboard.
+func VerifyWebhookSignature(secret, webhookID, timestamp string, payload []byte, signatureHeader string, tolerance time.Duration) error {
+	if secret == "" {
+		return fmt.Errorf("webhook secret is not configured")
+	}
+	if webhookID == "" || timestamp == "" || signatureHeader == "" {
+		return ErrWebhookHeadersMissing
+	}
+
+	sentAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: invalid timestamp", ErrWebhookSignatureInvalid)
+	}
+	if tolerance > 0 {
+		drift := time.Since(time.Unix(sentAt, 0))
+		if math.Abs(float64(drift)) > float64(tolerance) {
+			return ErrWebhookTimestampExpired
+		}
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, "whsec_"))
+	if err != nil {
+		return fmt.Errorf("decode webhook secret: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(webhookID + "." + timestamp + "."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	for _, entry := range strings.Fields(signatureHeader) {
+		version, sig, found := strings.Cut(entry, ",")
+		if !found || version != "v1" {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(sig)
+		if err != nil {
+			continue
+		}
+		if hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+
+	return ErrWebhookSignatureInvalid
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/moasq/backend/pkg/lifecycle"
+	"github.com/moasq/backend/pkg/telemetry"
+	"go.uber.org/dig"
+)
+
+// Init provides the telemetry provider and installs it globally. Call it
+// before other modules so their first spans are exported.
+func Init(container *dig.Container) error {
+	providers := []any{
+		telemetry.LoadConfig,
+		telemetry.NewProvider,
+	}
+
+	for _, provider := range providers {
+		if err := container.Provide(provider); err != nil {
+			return fmt.Errorf("failed to provide telemetry dependency: %w", err)
+		}
+	}
+
+	// Resolve eagerly: constructing the provider installs it globally. Its
+	// stop hook is registered first, so spans are flushed after every other
+	// module has stopped.
+	if err := container.Invoke(func(provider *telemetry.Provider, manager *lifecycle.Manager) error {
+		return manager.Append(lifecycle.Hook{
+			Name:   "telemetry",
+			OnStop: provider.Shutdown,
+		})
+	}); err != nil {
+		return fmt.Errorf("failed to initialize telemetry: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package telemetry
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+// Trace exporters (OTEL_TRACES_EXPORTER).
+const (
+	ExporterNone   = "none"
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+)
+
+// Config configures tracing. Names follow the OpenTelemetry environment
+// variable conventions; the OTLP exporter also reads the standard
+// OTEL_EXPORTER_OTLP_* variables (endpoint, headers, TLS) itself.
+type Config struct {
+	Exporter    string  `mapstructure:"OTEL_TRACES_EXPORTER"`
+	ServiceName string  `mapstructure:"OTEL_SERVICE_NAME"`
+	SampleRatio float64 `mapstructure:"OTEL_TRACES_SAMPLER_ARG"`
+	// FilePath is where the file exporter writes spans, one JSON object per line.
+	FilePath    string `mapstructure:"OTEL_TRACES_FILE"`
+	Environment string `mapstructure:"ENV"`
+}
+
+// LoadConfig reads the telemetry configuration from app.env or the environment.
+func LoadConfig() (*Config, error) {
+	var cfg Config
+
+	viper.SetConfigName("app")
+	viper.SetConfigType("env")
+	viper.AddConfigPath(".")
+	viper.AutomaticEnv()
+
+	viper.SetDefault("OTEL_TRACES_EXPORTER", ExporterNone)
+	viper.SetDefault("OTEL_SERVICE_NAME", "backend")
+	viper.SetDefault("OTEL_TRACES_SAMPLER_ARG", 1.0)
+	viper.SetDefault("OTEL_TRACES_FILE", "traces.jsonl")
+
+	if err := viper.ReadInConfig(); err == nil {
+		_ = err
+	}
+
+	if err := viper.Unmarshal(&cfg); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Exporter {
+	case ExporterNone, ExporterOTLP, ExporterStdout, ExporterFile:
+	default:
+		return nil, fmt.Errorf("invalid OTEL_TRACES_EXPORTER %q: must be none, otlp, stdout, or file", cfg.Exporter)
+	}
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, fmt.Errorf("invalid OTEL_TRACES_SAMPLER_ARG %v: must be between 0 and 1", cfg.SampleRatio)
+	}
+
+	return &cfg, nil
+}
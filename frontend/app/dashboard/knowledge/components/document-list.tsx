@@ -170,7 +170,7 @@ function EmptyDocumentsState({ compact }: { compact?: boolean }) {
         No documents yet
       </h3>
       <p className="mt-1 max-w-sm text-center text-sm" style={{ color: "#6b7280" }}>
-        Upload your first PDF document to start building your knowledge base for
+        Upload your first document to start building your knowledge base for
         AI-powered search.
       </p>
     </div>
@@ -30,14 +30,14 @@ export function DocumentUpload({
       setSuccess(false);
 
       if (rejectedFiles.length > 0) {
-        setError("Only PDF files are accepted");
+        setError("Accepted files: PDF, JPEG, PNG, WebP, TIFF, DOCX, XLSX, CSV and TXT");
         return;
       }
 
       if (acceptedFiles.length > 0) {
         const file = acceptedFiles[0];
         setSelectedFile(file);
-        const nameWithoutExt = file.name.replace(/\.pdf$/i, "");
+        const nameWithoutExt = file.name.replace(/\.[^.]+$/, "");
         setTitle(nameWithoutExt);
       }
     },
@@ -48,6 +48,14 @@ export function DocumentUpload({
     onDrop,
     accept: {
       "application/pdf": [".pdf"],
+      "image/jpeg": [".jpg", ".jpeg"],
+      "image/png": [".png"],
+      "image/webp": [".webp"],
+      "image/tiff": [".tif", ".tiff"],
+      "application/vnd.openxmlformats-officedocument.wordprocessingml.document": [".docx"],
+      "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": [".xlsx"],
+      "text/csv": [".csv"],
+      "text/plain": [".txt"],
     },
     maxFiles: 1,
     disabled: isUploading,
@@ -122,7 +130,7 @@ export function DocumentUpload({
                     disabled={isUploading || !title.trim()}
                 >
                     {isUploading ? <Loader2 className="mr-2 h-4 w-4 animate-spin" /> : <Upload className="mr-2 h-4 w-4" />}
-                    {isUploading ? "Uploading..." : "Upload PDF"}
+                    {isUploading ? "Uploading..." : "Upload Document"}
                  </Button>
             </div>
         </div>
@@ -155,10 +163,10 @@ export function DocumentUpload({
           <Upload className="h-6 w-6 text-gray-400" />
         </div>
         <p className="text-sm font-medium text-gray-900 text-center">
-          {isDragActive ? "Drop PDF here" : "Click or drag PDF to upload"}
+          {isDragActive ? "Drop file here" : "Click or drag a document or photo to upload"}
         </p>
         <p className="mt-1 text-xs text-gray-500 text-center">
-            Max file size 10MB
+            PDF, DOCX, XLSX, CSV or TXT up to 2MB; JPEG, PNG, WebP or TIFF up to 8MB
         </p>
       </div>
     </div>